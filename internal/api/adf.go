@@ -0,0 +1,99 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// adfBlockTypes are the node types allowed directly inside a list item.
+var adfBlockTypes = map[string]bool{
+	"paragraph":   true,
+	"bulletList":  true,
+	"orderedList": true,
+	"codeBlock":   true,
+	"mediaSingle": true,
+	"mediaGroup":  true,
+	"taskList":    true,
+	"extension":   true,
+	"heading":     true,
+	"blockquote":  true,
+	"panel":       true,
+	"rule":        true,
+}
+
+// ValidateADF checks the structural invariants Jira enforces on ADF
+// documents, so a malformed document fails locally with the offending node
+// instead of as an opaque 400 from the API:
+//   - the root is a "doc" node with version 1
+//   - the code mark is only combined with link marks
+//   - lists contain only list items, and each list item holds block content
+//   - tables have rows, and each row has cells
+func ValidateADF(adf *ADF) error {
+	if adf == nil {
+		return nil
+	}
+	if adf.Type != "doc" {
+		return fmt.Errorf("invalid ADF: root node is %q, want \"doc\"", adf.Type)
+	}
+	if adf.Version != 1 {
+		return fmt.Errorf("invalid ADF: doc version is %d, want 1", adf.Version)
+	}
+	return validateADFContent(adf.Content, "doc")
+}
+
+func validateADFContent(nodes []ADFContent, parent string) error {
+	for i, node := range nodes {
+		path := fmt.Sprintf("%s > %s[%d]", parent, node.Type, i)
+		if err := validateADFNode(node, path); err != nil {
+			return err
+		}
+		if err := validateADFContent(node.Content, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateADFNode(node ADFContent, path string) error {
+	switch node.Type {
+	case "text":
+		if len(node.Marks) > 1 && hasCodeMark(node) {
+			var others []string
+			for _, m := range node.Marks {
+				if m.Type != "code" && m.Type != "link" {
+					others = append(others, m.Type)
+				}
+			}
+			if len(others) > 0 {
+				return fmt.Errorf("invalid ADF at %s: code mark cannot be combined with %s", path, strings.Join(others, ", "))
+			}
+		}
+	case "bulletList", "orderedList":
+		if len(node.Content) == 0 {
+			return fmt.Errorf("invalid ADF at %s: list has no items", path)
+		}
+		for _, child := range node.Content {
+			if child.Type != "listItem" {
+				return fmt.Errorf("invalid ADF at %s: list contains %q, want listItem", path, child.Type)
+			}
+		}
+	case "listItem":
+		if len(node.Content) == 0 {
+			return fmt.Errorf("invalid ADF at %s: list item has no content", path)
+		}
+		for _, child := range node.Content {
+			if !adfBlockTypes[child.Type] {
+				return fmt.Errorf("invalid ADF at %s: list item contains %q, want block content such as a paragraph", path, child.Type)
+			}
+		}
+	case "table":
+		if len(node.Content) == 0 {
+			return fmt.Errorf("invalid ADF at %s: table has no rows", path)
+		}
+	case "tableRow":
+		if len(node.Content) == 0 {
+			return fmt.Errorf("invalid ADF at %s: table row has no cells", path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateADF_MarkdownOutputIsValid(t *testing.T) {
+	md := "# Title\n\n**Bold with `code`** and [a link](https://example.com)\n\n" +
+		"- one\n  - nested\n- two\n\n1. first\n2. second\n\n" +
+		"| A | B |\n|---|---|\n| 1 | 2 |\n\n```go\nfmt.Println()\n```\n\n> quote\n\n---\n"
+	if err := ValidateADF(MarkdownToADF(md)); err != nil {
+		t.Errorf("ValidateADF(MarkdownToADF(...)) = %v, want nil", err)
+	}
+	if err := ValidateADF(nil); err != nil {
+		t.Errorf("ValidateADF(nil) = %v, want nil", err)
+	}
+}
+
+func TestValidateADF_Violations(t *testing.T) {
+	text := func(s string, marks ...string) ADFContent {
+		c := ADFContent{Type: "text", Text: s}
+		for _, m := range marks {
+			c.Marks = append(c.Marks, ADFMark{Type: m})
+		}
+		return c
+	}
+	para := func(content ...ADFContent) ADFContent {
+		return ADFContent{Type: "paragraph", Content: content}
+	}
+	doc := func(content ...ADFContent) *ADF {
+		return &ADF{Type: "doc", Version: 1, Content: content}
+	}
+
+	tests := []struct {
+		name    string
+		adf     *ADF
+		wantErr string
+	}{
+		{
+			name:    "root is not doc",
+			adf:     &ADF{Type: "paragraph", Version: 1},
+			wantErr: `root node is "paragraph"`,
+		},
+		{
+			name:    "wrong version",
+			adf:     &ADF{Type: "doc"},
+			wantErr: "doc version is 0, want 1",
+		},
+		{
+			name:    "code mark mixed with strong",
+			adf:     doc(para(text("x", "strong", "code"))),
+			wantErr: "doc > paragraph[0] > text[0]: code mark cannot be combined with strong",
+		},
+		{
+			name:    "empty list",
+			adf:     doc(ADFContent{Type: "bulletList"}),
+			wantErr: "doc > bulletList[0]: list has no items",
+		},
+		{
+			name:    "list with non-item child",
+			adf:     doc(ADFContent{Type: "orderedList", Content: []ADFContent{para(text("x"))}}),
+			wantErr: `list contains "paragraph"`,
+		},
+		{
+			name: "empty list item",
+			adf: doc(ADFContent{Type: "bulletList", Content: []ADFContent{
+				{Type: "listItem", Content: []ADFContent{para(text("ok"))}},
+				{Type: "listItem"},
+			}}),
+			wantErr: "doc > bulletList[0] > listItem[1]: list item has no content",
+		},
+		{
+			name: "list item with inline content",
+			adf: doc(ADFContent{Type: "bulletList", Content: []ADFContent{
+				{Type: "listItem", Content: []ADFContent{text("bare")}},
+			}}),
+			wantErr: `list item contains "text"`,
+		},
+		{
+			name:    "table without rows",
+			adf:     doc(ADFContent{Type: "table"}),
+			wantErr: "doc > table[0]: table has no rows",
+		},
+		{
+			name:    "table row without cells",
+			adf:     doc(ADFContent{Type: "table", Content: []ADFContent{{Type: "tableRow"}}}),
+			wantErr: "doc > table[0] > tableRow[0]: table row has no cells",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateADF(tt.adf)
+			if err == nil {
+				t.Fatalf("ValidateADF() = nil, want error containing %q", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateADF() = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateADF_CodeWithLinkIsValid(t *testing.T) {
+	adf := &ADF{Type: "doc", Version: 1, Content: []ADFContent{{
+		Type: "paragraph",
+		Content: []ADFContent{{
+			Type:  "text",
+			Text:  "pkg",
+			Marks: []ADFMark{{Type: "link", Attrs: &ADFAttrs{Href: "https://example.com"}}, {Type: "code"}},
+		}},
+	}}}
+	if err := ValidateADF(adf); err != nil {
+		t.Errorf("ValidateADF() = %v, want nil for code+link", err)
+	}
+}
@@ -25,12 +25,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
+	"math/rand/v2"
 	"mime/multipart"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/enthus-appdev/atl-cli/internal/auth"
@@ -45,7 +48,8 @@ const (
 	// DefaultTimeout is the default HTTP client timeout for API requests.
 	DefaultTimeout = 30 * time.Second
 
-	// Retry configuration for transient failures
+	// Retry configuration for transient failures. The backoff base and cap
+	// are defaults; see WithBackoff.
 	maxRetries     = 3
 	initialBackoff = 500 * time.Millisecond
 	maxBackoff     = 10 * time.Second
@@ -70,11 +74,20 @@ func isRetryableStatus(statusCode int) bool {
 }
 
 // calculateBackoff returns the backoff duration for the given attempt (0-indexed).
-// Uses exponential backoff: 500ms, 1s, 2s, capped at maxBackoff.
-func calculateBackoff(attempt int) time.Duration {
-	backoff := initialBackoff * (1 << attempt) // 2^attempt * initialBackoff
-	if backoff > maxBackoff {
-		backoff = maxBackoff
+// Uses exponential backoff: base * 2^attempt (500ms, 1s, 2s by default),
+// capped at limit. With jitter, the result is a random duration between 0
+// and that value ("full jitter"), so concurrent clients don't all retry at
+// the same moment.
+func calculateBackoff(attempt int, base, limit time.Duration, jitter bool) time.Duration {
+	backoff := base
+	for i := 0; i < attempt && backoff < limit; i++ {
+		backoff *= 2
+	}
+	if backoff > limit {
+		backoff = limit
+	}
+	if jitter && backoff > 0 {
+		backoff = time.Duration(rand.Int64N(int64(backoff) + 1))
 	}
 	return backoff
 }
@@ -84,8 +97,18 @@ type Client struct {
 	httpClient *http.Client
 	hostname   string
 	cloudID    string
+	browseBase string // Configured browse_base_url; empty means https://<hostname>
 	tokens     *auth.TokenSet
+	tokenMu    sync.Mutex // Guards tokens; commands may issue requests concurrently
 	config     *config.Config
+	logger     *log.Logger // Optional request log (see ATL_LOG_FILE)
+	rateLimit  *RateLimit  // Latest rate-limit headers (see LastRateLimit)
+	rateMu     sync.Mutex  // Guards rateLimit
+
+	// Retry backoff; zero values mean initialBackoff, maxBackoff, and jitter.
+	backoffBase time.Duration
+	backoffMax  time.Duration
+	noJitter    bool
 }
 
 // ClientOption configures the API client.
@@ -98,6 +121,35 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
+// WithBackoff sets the base and cap of the exponential retry backoff.
+// Zero keeps the default for that value.
+func WithBackoff(base, limit time.Duration) ClientOption {
+	return func(c *Client) {
+		c.backoffBase = base
+		c.backoffMax = limit
+	}
+}
+
+// WithoutJitter makes retry backoff deterministic.
+func WithoutJitter() ClientOption {
+	return func(c *Client) {
+		c.noJitter = true
+	}
+}
+
+// retryBackoff returns the delay before retry attempt+1 using the client's
+// backoff settings.
+func (c *Client) retryBackoff(attempt int) time.Duration {
+	base, limit := c.backoffBase, c.backoffMax
+	if base <= 0 {
+		base = initialBackoff
+	}
+	if limit <= 0 {
+		limit = maxBackoff
+	}
+	return calculateBackoff(attempt, base, limit, !c.noJitter)
+}
+
 // NewClient creates a new API client for the given hostname.
 func NewClient(hostname string, opts ...ClientOption) (*Client, error) {
 	cfg, err := config.Load()
@@ -110,7 +162,7 @@ func NewClient(hostname string, opts ...ClientOption) (*Client, error) {
 		return nil, fmt.Errorf("failed to get tokens: %w", err)
 	}
 	if tokens == nil {
-		return nil, fmt.Errorf("not authenticated. Run 'atl auth login' first")
+		return nil, &AuthError{Err: fmt.Errorf("not authenticated. Run 'atl auth login' first")}
 	}
 
 	hostConfig := cfg.GetHost(hostname)
@@ -122,8 +174,10 @@ func NewClient(hostname string, opts ...ClientOption) (*Client, error) {
 		httpClient: &http.Client{Timeout: DefaultTimeout},
 		hostname:   hostname,
 		cloudID:    hostConfig.CloudID,
+		browseBase: hostConfig.BrowseBaseURL,
 		tokens:     tokens,
 		config:     cfg,
+		logger:     fileLogger(),
 	}
 
 	for _, opt := range opts {
@@ -141,7 +195,7 @@ func NewClientFromConfig() (*Client, error) {
 	}
 
 	if cfg.CurrentHost == "" {
-		return nil, fmt.Errorf("no host configured. Run 'atl auth login' first")
+		return nil, &AuthError{Err: fmt.Errorf("no host configured. Run 'atl auth login' first")}
 	}
 
 	return NewClient(cfg.CurrentHost)
@@ -152,6 +206,25 @@ func (c *Client) Hostname() string {
 	return c.hostname
 }
 
+// BrowseBaseURL returns the base of user-facing issue URLs: the host's
+// browse_base_url if configured, otherwise https://<hostname>.
+func (c *Client) BrowseBaseURL() string {
+	if c.browseBase != "" {
+		return c.browseBase
+	}
+	return "https://" + c.hostname
+}
+
+// BrowseURL returns the user-facing URL of an issue.
+func (c *Client) BrowseURL(issueKey string) string {
+	return IssueBrowseURL(c.BrowseBaseURL(), issueKey)
+}
+
+// IssueBrowseURL returns the URL of an issue below a browse base URL.
+func IssueBrowseURL(base, issueKey string) string {
+	return base + "/browse/" + issueKey
+}
+
 // CloudID returns the cloud ID for the host.
 func (c *Client) CloudID() string {
 	return c.cloudID
@@ -184,9 +257,27 @@ func (c *Client) ConfluenceBaseURLV1() string {
 	return fmt.Sprintf("%s/ex/confluence/%s/wiki/rest/api", AtlassianAPIURL, c.cloudID)
 }
 
+// ProductURL returns the full URL for an arbitrary REST path of a product
+// ("jira" or "confluence"), e.g. "/rest/api/3/myself" or "/wiki/api/v2/spaces".
+// Used by 'atl api' for endpoints the CLI doesn't wrap.
+func (c *Client) ProductURL(product, path string) (string, error) {
+	switch product {
+	case "jira", "confluence":
+	default:
+		return "", fmt.Errorf("unknown product %q (expected jira or confluence)", product)
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return fmt.Sprintf("%s/ex/%s/%s%s", AtlassianAPIURL, product, c.cloudID, path), nil
+}
+
 // ensureValidToken checks if the access token is expired and refreshes it if needed.
 // This is called automatically before each request.
 func (c *Client) ensureValidToken(ctx context.Context) error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
 	if c.tokens == nil || !c.tokens.IsExpired() {
 		return nil
 	}
@@ -207,7 +298,7 @@ func (c *Client) ensureValidToken(ctx context.Context) error {
 	}
 
 	if clientID == "" || clientSecret == "" {
-		return fmt.Errorf("access token expired and cannot refresh: OAuth credentials not configured")
+		return &AuthError{Err: fmt.Errorf("access token expired and cannot refresh: OAuth credentials not configured")}
 	}
 
 	newTokens, err := auth.RefreshAccessToken(ctx, c.hostname, &auth.RefreshConfig{
@@ -215,17 +306,28 @@ func (c *Client) ensureValidToken(ctx context.Context) error {
 		ClientSecret: clientSecret,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to refresh expired token: %w", err)
+		return &AuthError{Err: fmt.Errorf("failed to refresh expired token: %w", err)}
 	}
 
 	c.tokens = newTokens
 	return nil
 }
 
+// accessToken returns the current access token.
+func (c *Client) accessToken() string {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.tokens.AccessToken
+}
+
 // Request makes an HTTP request to the API.
 // If the access token is expired, it will automatically attempt to refresh it.
 // Automatically retries on transient failures (429, 5xx) with exponential backoff.
 func (c *Client) Request(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// Ensure we have a valid token before making the request
 	if err := c.ensureValidToken(ctx); err != nil {
 		return err
@@ -244,7 +346,7 @@ func (c *Client) Request(ctx context.Context, method, path string, body interfac
 	var lastErr error
 	for attempt := 0; attempt <= maxRetries; attempt++ {
 		if attempt > 0 {
-			backoff := calculateBackoff(attempt - 1)
+			backoff := c.retryBackoff(attempt - 1)
 			debugLog("Retry %d/%d after %v", attempt, maxRetries, backoff)
 			select {
 			case <-ctx.Done():
@@ -263,7 +365,7 @@ func (c *Client) Request(ctx context.Context, method, path string, body interfac
 			return fmt.Errorf("failed to create request: %w", err)
 		}
 
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.tokens.AccessToken))
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.accessToken()))
 		req.Header.Set("Accept", "application/json")
 		if body != nil {
 			req.Header.Set("Content-Type", "application/json")
@@ -271,15 +373,22 @@ func (c *Client) Request(ctx context.Context, method, path string, body interfac
 
 		debugLog("%s %s", method, path)
 
+		start := time.Now()
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
 			debugLog("Request failed: %v", err)
+			c.logRequest(req, bodyBytes, 0, time.Since(start), err)
+			if ctx.Err() != nil {
+				return ctx.Err() // Canceled, don't retry
+			}
 			lastErr = fmt.Errorf("request failed: %w", err)
 			continue // Retry on network errors
 		}
 
 		respBody, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
+		c.logRequest(req, bodyBytes, resp.StatusCode, time.Since(start), nil)
+		c.recordRateLimit(resp)
 		if err != nil {
 			return fmt.Errorf("failed to read response: %w", err)
 		}
@@ -299,21 +408,13 @@ func (c *Client) Request(ctx context.Context, method, path string, body interfac
 		// Check if error is retryable
 		if isRetryableStatus(resp.StatusCode) && attempt < maxRetries {
 			debugLog("Retryable error %d, will retry", resp.StatusCode)
-			lastErr = &APIError{
-				StatusCode: resp.StatusCode,
-				Status:     resp.Status,
-				Body:       string(respBody),
-			}
+			lastErr = parseAPIError(resp.StatusCode, resp.Status, respBody)
 			continue
 		}
 
 		// Non-retryable error or max retries exceeded
 		debugLog("Error body: %s", string(respBody))
-		return &APIError{
-			StatusCode: resp.StatusCode,
-			Status:     resp.Status,
-			Body:       string(respBody),
-		}
+		return parseAPIError(resp.StatusCode, resp.Status, respBody)
 	}
 
 	// All retries exhausted
@@ -343,25 +444,31 @@ func (c *Client) Delete(ctx context.Context, path string) error {
 // PostMultipart makes a multipart/form-data POST request for file uploads.
 // The file at filePath is sent as the form field specified by fieldName.
 func (c *Client) PostMultipart(ctx context.Context, urlPath, fieldName, filePath string, result interface{}) error {
-	if err := c.ensureValidToken(ctx); err != nil {
-		return err
-	}
-
 	f, err := os.Open(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to open file: %w", err)
 	}
 	defer f.Close()
 
+	return c.PostMultipartReader(ctx, urlPath, fieldName, filepath.Base(filePath), f, result)
+}
+
+// PostMultipartReader is like PostMultipart but sends the content of r under
+// the given filename, for uploads that don't come from a local file.
+func (c *Client) PostMultipartReader(ctx context.Context, urlPath, fieldName, filename string, r io.Reader, result interface{}) error {
+	if err := c.ensureValidToken(ctx); err != nil {
+		return err
+	}
+
 	var buf bytes.Buffer
 	writer := multipart.NewWriter(&buf)
 
-	part, err := writer.CreateFormFile(fieldName, filepath.Base(filePath))
+	part, err := writer.CreateFormFile(fieldName, filename)
 	if err != nil {
 		return fmt.Errorf("failed to create form file: %w", err)
 	}
 
-	if _, err := io.Copy(part, f); err != nil {
+	if _, err := io.Copy(part, r); err != nil {
 		return fmt.Errorf("failed to write file content: %w", err)
 	}
 
@@ -374,18 +481,21 @@ func (c *Client) PostMultipart(ctx context.Context, urlPath, fieldName, filePath
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.tokens.AccessToken))
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.accessToken()))
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 	req.Header.Set("X-Atlassian-Token", "no-check")
 
-	debugLog("POST %s (multipart)", urlPath)
+	debugLog("POST %s (multipart, %s)", urlPath, filename)
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
+	c.logRequest(req, nil, statusOf(resp), time.Since(start), err)
 	if err != nil {
 		return fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	c.recordRateLimit(resp)
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -395,11 +505,8 @@ func (c *Client) PostMultipart(ctx context.Context, urlPath, fieldName, filePath
 	debugLog("Response: %d %s (%d bytes)", resp.StatusCode, resp.Status, len(respBody))
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return &APIError{
-			StatusCode: resp.StatusCode,
-			Status:     resp.Status,
-			Body:       string(respBody),
-		}
+		debugLog("Error body: %s", string(respBody))
+		return parseAPIError(resp.StatusCode, resp.Status, respBody)
 	}
 
 	if result != nil && len(respBody) > 0 {
@@ -423,21 +530,21 @@ func (c *Client) GetRaw(ctx context.Context, path string) ([]byte, string, error
 		return nil, "", fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.tokens.AccessToken))
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.accessToken()))
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
+	c.logRequest(req, nil, statusOf(resp), time.Since(start), err)
 	if err != nil {
 		return nil, "", fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	c.recordRateLimit(resp)
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, "", &APIError{
-			StatusCode: resp.StatusCode,
-			Status:     resp.Status,
-			Body:       string(body),
-		}
+		debugLog("Error body: %s", string(body))
+		return nil, "", parseAPIError(resp.StatusCode, resp.Status, body)
 	}
 
 	content, err := io.ReadAll(resp.Body)
@@ -449,17 +556,6 @@ func (c *Client) GetRaw(ctx context.Context, path string) ([]byte, string, error
 	return content, contentType, nil
 }
 
-// APIError represents an error response from the API.
-type APIError struct {
-	StatusCode int
-	Status     string
-	Body       string
-}
-
-func (e *APIError) Error() string {
-	return fmt.Sprintf("API error: %s (status %d): %s", e.Status, e.StatusCode, e.Body)
-}
-
 // BuildQueryString builds a URL query string from parameters.
 func BuildQueryString(params map[string]string) string {
 	if len(params) == 0 {
@@ -3,8 +3,11 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -327,6 +330,34 @@ func TestClientURLMethods(t *testing.T) {
 	}
 }
 
+func TestClientProductURL(t *testing.T) {
+	client := &Client{
+		cloudID: "test-cloud-id",
+	}
+
+	tests := []struct {
+		product string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{"jira", "/rest/api/3/myself", "https://api.atlassian.com/ex/jira/test-cloud-id/rest/api/3/myself", false},
+		{"confluence", "wiki/api/v2/spaces?limit=5", "https://api.atlassian.com/ex/confluence/test-cloud-id/wiki/api/v2/spaces?limit=5", false},
+		{"bitbucket", "/2.0/user", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := client.ProductURL(tt.product, tt.path)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ProductURL(%q, %q) error = %v, wantErr %v", tt.product, tt.path, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ProductURL(%q, %q) = %q, want %q", tt.product, tt.path, got, tt.want)
+		}
+	}
+}
+
 // TestClientAccessors tests the client accessor methods.
 func TestClientAccessors(t *testing.T) {
 	client := &Client{
@@ -343,6 +374,20 @@ func TestClientAccessors(t *testing.T) {
 	}
 }
 
+// TestClientBrowseURL tests that issue URLs use the configured browse base
+// URL and fall back to the hostname.
+func TestClientBrowseURL(t *testing.T) {
+	client := &Client{hostname: "example.atlassian.net"}
+	if got := client.BrowseURL("PROJ-1"); got != "https://example.atlassian.net/browse/PROJ-1" {
+		t.Errorf("BrowseURL() = %q, want the hostname based URL", got)
+	}
+
+	client.browseBase = "https://example.com/jira"
+	if got := client.BrowseURL("PROJ-1"); got != "https://example.com/jira/browse/PROJ-1" {
+		t.Errorf("BrowseURL() = %q, want the configured base", got)
+	}
+}
+
 // Helper function to check string containment
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsAt(s, substr, 0))
@@ -356,3 +401,157 @@ func containsAt(s, substr string, start int) bool {
 	}
 	return false
 }
+
+// rewriteTransport sends every request to a test server regardless of host.
+type rewriteTransport struct {
+	target string
+}
+
+func (t *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = "http"
+	req.URL.Host = strings.TrimPrefix(t.target, "http://")
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClient returns a client with a valid token whose requests all go to
+// server.
+func newTestClient(t *testing.T, server *httptest.Server) *Client {
+	t.Helper()
+	return &Client{
+		httpClient: &http.Client{Transport: &rewriteTransport{target: server.URL}},
+		cloudID:    "test-cloud",
+		tokens: &auth.TokenSet{
+			AccessToken: "test-token",
+			ExpiresAt:   time.Now().Add(time.Hour),
+		},
+	}
+}
+
+// TestRequestCanceledContextAbortsPagination simulates Ctrl-C during
+// 'atl issue list --all': once the context is canceled, the next page must
+// not be requested and the error must be context.Canceled.
+func TestRequestCanceledContextAbortsPagination(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		cancel() // User hits Ctrl-C while the first page is in flight
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issues":        []map[string]string{{"key": "TEST-1"}},
+			"nextPageToken": "page-2",
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+
+	var err error
+	token := ""
+	for page := 0; page < 5; page++ {
+		var result *SearchResult
+		result, err = jira.Search(ctx, SearchOptions{JQL: "project = TEST", NextPageToken: token})
+		if err != nil {
+			break
+		}
+		token = result.NextPageToken
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Search() error = %v, want context.Canceled", err)
+	}
+	if requests != 1 {
+		t.Errorf("server received %d requests, want 1", requests)
+	}
+}
+
+// TestClientPostMultipartReader tests uploading in-memory content.
+func TestClientPostMultipartReader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Atlassian-Token") != "no-check" {
+			t.Error("Request missing X-Atlassian-Token header")
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("FormFile() error = %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		var content strings.Builder
+		if _, err := io.Copy(&content, file); err != nil {
+			t.Errorf("reading upload: %v", err)
+		}
+		if header.Filename != "build.log" {
+			t.Errorf("filename = %q, want %q", header.Filename, "build.log")
+		}
+		if content.String() != "line 1\nline 2\n" {
+			t.Errorf("content = %q, want the reader's content", content.String())
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "10001", "filename": "build.log", "size": 14}]`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		httpClient: server.Client(),
+		tokens: &auth.TokenSet{
+			AccessToken: "test-token",
+			ExpiresAt:   time.Now().Add(time.Hour),
+		},
+	}
+
+	var attachments []*Attachment
+	err := client.PostMultipartReader(context.Background(), server.URL, "file", "build.log", strings.NewReader("line 1\nline 2\n"), &attachments)
+	if err != nil {
+		t.Fatalf("PostMultipartReader() error = %v", err)
+	}
+	if len(attachments) != 1 || attachments[0].ID != "10001" {
+		t.Errorf("attachments = %+v, want one attachment with ID 10001", attachments)
+	}
+}
+
+func TestCalculateBackoff(t *testing.T) {
+	// Without jitter the delays match the fixed schedule.
+	want := []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for attempt, w := range want {
+		if got := calculateBackoff(attempt, initialBackoff, maxBackoff, false); got != w {
+			t.Errorf("calculateBackoff(%d) without jitter = %v, want %v", attempt, got, w)
+		}
+	}
+
+	// With jitter every delay stays within [0, cap], even for attempts
+	// where 2^attempt would overflow.
+	limit := 3 * time.Second
+	for attempt := 0; attempt < 70; attempt++ {
+		for i := 0; i < 20; i++ {
+			got := calculateBackoff(attempt, 200*time.Millisecond, limit, true)
+			if got < 0 || got > limit {
+				t.Fatalf("calculateBackoff(%d) with jitter = %v, want within [0, %v]", attempt, got, limit)
+			}
+		}
+	}
+}
+
+func TestClientRetryBackoffOptions(t *testing.T) {
+	c := &Client{}
+	WithBackoff(100*time.Millisecond, 300*time.Millisecond)(c)
+	WithoutJitter()(c)
+
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond} {
+		if got := c.retryBackoff(attempt); got != want {
+			t.Errorf("retryBackoff(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+
+	// Zero values fall back to the defaults.
+	if got := (&Client{noJitter: true}).retryBackoff(0); got != initialBackoff {
+		t.Errorf("default retryBackoff(0) = %v, want %v", got, initialBackoff)
+	}
+}
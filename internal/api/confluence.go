@@ -2,9 +2,13 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
 )
 
 const (
@@ -54,8 +58,12 @@ func capLimit(limit, max int) int {
 //   - read:page:confluence, write:page:confluence
 //   - read:space:confluence
 //   - read:content:confluence, write:content:confluence
+//   - read:comment:confluence, write:comment:confluence
 type ConfluenceService struct {
 	client *Client
+
+	userMu      sync.Mutex
+	currentUser *ConfluenceUser // Cached by GetCurrentUser
 }
 
 // NewConfluenceService creates a new Confluence service.
@@ -119,6 +127,14 @@ type PageBody struct {
 	View           *BodyContent `json:"view,omitempty"`
 }
 
+// hasContent reports whether the storage or ADF body has a value.
+func (b *PageBody) hasContent() bool {
+	if b == nil {
+		return false
+	}
+	return (b.Storage != nil && b.Storage.Value != "") || (b.AtlasDocFormat != nil && b.AtlasDocFormat.Value != "")
+}
+
 // BodyContent represents body content in a specific format.
 type BodyContent struct {
 	Value          string `json:"value"`
@@ -138,18 +154,38 @@ type SpacesResponse struct {
 	Links   *PaginationLinks `json:"_links,omitempty"`
 }
 
+// NextCursor returns the cursor for the next page, or "" on the last page.
+func (r *SpacesResponse) NextCursor() string {
+	return r.Links.NextCursor()
+}
+
 // PagesResponse represents a paginated list of pages.
 type PagesResponse struct {
 	Results []*Page          `json:"results"`
 	Links   *PaginationLinks `json:"_links,omitempty"`
 }
 
+// NextCursor returns the cursor for the next page, or "" on the last page.
+func (r *PagesResponse) NextCursor() string {
+	return r.Links.NextCursor()
+}
+
 // PaginationLinks represents pagination links.
 type PaginationLinks struct {
 	Next string `json:"next,omitempty"`
 	Base string `json:"base,omitempty"`
 }
 
+// NextCursor returns the decoded cursor from the next link, or "" when there
+// is no next page. The value is what the cursor parameter expects; it is
+// re-encoded when passed back to a list call, so it round-trips exactly.
+func (l *PaginationLinks) NextCursor() string {
+	if l == nil || l.Next == "" {
+		return ""
+	}
+	return extractCursor(l.Next)
+}
+
 // baseURL returns the base URL for Confluence v2 API.
 func (s *ConfluenceService) baseURL() string {
 	return s.client.ConfluenceBaseURLV2()
@@ -164,9 +200,7 @@ func (s *ConfluenceService) GetSpaces(ctx context.Context, limit int, cursor str
 		params.Set("limit", strconv.Itoa(capLimit(limit, ConfluenceMaxLimit)))
 	}
 	params.Set("status", "current")
-	if cursor != "" {
-		params.Set("cursor", cursor)
-	}
+	setCursor(params, cursor)
 
 	var result SpacesResponse
 	if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
@@ -200,6 +234,34 @@ func (s *ConfluenceService) GetSpacesAll(ctx context.Context) ([]*Space, error)
 	return allSpaces, nil
 }
 
+// setCursor adds a pagination cursor to params. The cursor may be the
+// decoded value NextCursor returns, the URL-encoded form older versions
+// printed, or a whole next link; all are normalized to the decoded value so
+// it is encoded exactly once.
+func setCursor(params url.Values, cursor string) {
+	if cursor = normalizeCursor(cursor); cursor != "" {
+		params.Set("cursor", cursor)
+	}
+}
+
+// normalizeCursor returns the decoded cursor for any of the forms setCursor
+// accepts. Confluence cursors are base64, so a "%" means it is still encoded.
+func normalizeCursor(cursor string) string {
+	cursor = strings.TrimSpace(cursor)
+	if strings.Contains(cursor, "cursor=") {
+		if !strings.Contains(cursor, "?") {
+			cursor = "?" + cursor
+		}
+		return extractCursor(cursor)
+	}
+	if strings.Contains(cursor, "%") {
+		if decoded, err := url.QueryUnescape(cursor); err == nil {
+			return decoded
+		}
+	}
+	return cursor
+}
+
 // extractCursor extracts the cursor parameter from a pagination URL.
 func extractCursor(nextURL string) string {
 	parsed, err := url.Parse(nextURL)
@@ -255,9 +317,7 @@ func (s *ConfluenceService) GetPages(ctx context.Context, spaceID string, limit
 	} else {
 		params.Set("status", "current")
 	}
-	if cursor != "" {
-		params.Set("cursor", cursor)
-	}
+	setCursor(params, cursor)
 
 	var result PagesResponse
 	if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
@@ -267,6 +327,30 @@ func (s *ConfluenceService) GetPages(ctx context.Context, spaceID string, limit
 	return &result, nil
 }
 
+// GetPagesByTitle returns the current pages in a space whose title is
+// exactly title. Unlike title search, this is not a contains match.
+func (s *ConfluenceService) GetPagesByTitle(ctx context.Context, spaceID, title string) ([]*Page, error) {
+	path := fmt.Sprintf("%s/spaces/%s/pages", s.baseURL(), spaceID)
+
+	params := url.Values{}
+	params.Set("title", title)
+	params.Set("status", "current")
+	params.Set("limit", "25")
+
+	var result PagesResponse
+	if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
+		return nil, err
+	}
+
+	var pages []*Page
+	for _, p := range result.Results {
+		if p.Title == title {
+			pages = append(pages, p)
+		}
+	}
+	return pages, nil
+}
+
 // GetPagesAll gets all pages in a space.
 // status can be "current", "draft", "archived", or empty for current.
 func (s *ConfluenceService) GetPagesAll(ctx context.Context, spaceID string, status string) ([]*Page, error) {
@@ -295,16 +379,27 @@ func (s *ConfluenceService) GetPagesAll(ctx context.Context, spaceID string, sta
 // GetPage gets a page by ID.
 // Requests both storage and atlas_doc_format to handle both old and new editor pages.
 func (s *ConfluenceService) GetPage(ctx context.Context, pageID string) (*Page, error) {
+	return s.GetPageVersion(ctx, pageID, 0)
+}
+
+// GetPageVersion gets a page as it was at the given version number.
+// A version of 0 returns the current version.
+func (s *ConfluenceService) GetPageVersion(ctx context.Context, pageID string, version int) (*Page, error) {
 	path := fmt.Sprintf("%s/pages/%s", s.baseURL(), pageID)
 
 	// Try to get storage format first
 	params := url.Values{}
 	params.Set("body-format", "storage")
+	if version > 0 {
+		params.Set("version", strconv.Itoa(version))
+	}
 
 	var page Page
 	if err := s.client.Get(ctx, path+"?"+params.Encode(), &page); err != nil {
 		return nil, err
 	}
+	// An empty storage body (as opposed to a missing one) is an empty page
+	storageReturned := page.Body != nil && page.Body.Storage != nil
 
 	// If storage body is empty, try atlas_doc_format (new editor)
 	if page.Body == nil || page.Body.Storage == nil || page.Body.Storage.Value == "" {
@@ -315,9 +410,51 @@ func (s *ConfluenceService) GetPage(ctx context.Context, pageID string) (*Page,
 		}
 	}
 
+	// Some legacy pages only return their body from the v1 content API
+	if !page.Body.hasContent() {
+		if body, err := s.getPageBodyV1(ctx, pageID, version); err == nil && body.hasContent() {
+			page.Body = body
+		}
+	}
+
+	// Last resort: the rendered HTML, for pages that return no body at all
+	if !storageReturned && !page.Body.hasContent() {
+		params.Set("body-format", "view")
+		var viewPage Page
+		if err := s.client.Get(ctx, path+"?"+params.Encode(), &viewPage); err == nil && viewPage.Body != nil {
+			page.Body = viewPage.Body
+		}
+	}
+
 	return &page, nil
 }
 
+// contentV1 is the subset of a v1 content response used by getPageBodyV1.
+type contentV1 struct {
+	Body *struct {
+		Storage *BodyContent `json:"storage,omitempty"`
+	} `json:"body,omitempty"`
+}
+
+// getPageBodyV1 gets a page's storage body from the v1 content API.
+func (s *ConfluenceService) getPageBodyV1(ctx context.Context, pageID string, version int) (*PageBody, error) {
+	params := url.Values{}
+	params.Set("expand", "body.storage")
+	if version > 0 {
+		params.Set("version", strconv.Itoa(version))
+	}
+
+	var content contentV1
+	path := fmt.Sprintf("%s/content/%s?%s", s.baseURLV1(), pageID, params.Encode())
+	if err := s.client.Get(ctx, path, &content); err != nil {
+		return nil, err
+	}
+	if content.Body == nil {
+		return &PageBody{}, nil
+	}
+	return &PageBody{Storage: content.Body.Storage}, nil
+}
+
 // CreatePageRequest represents a request to create a page.
 type CreatePageRequest struct {
 	SpaceID  string `json:"spaceId"`
@@ -330,9 +467,23 @@ type CreatePageRequest struct {
 	} `json:"body"`
 }
 
+// Page body representations accepted when creating or updating a page.
+const (
+	RepresentationStorage = "storage"
+	RepresentationADF     = "atlas_doc_format" // value is the ADF document as a JSON string
+)
+
 // CreatePage creates a new page.
 // status can be "current" or "draft". Empty defaults to "current".
 func (s *ConfluenceService) CreatePage(ctx context.Context, spaceID, title, content string, parentID string, status string) (*Page, error) {
+	return s.createPage(ctx, spaceID, title, RepresentationStorage, content, parentID, status)
+}
+
+func (s *ConfluenceService) createPage(ctx context.Context, spaceID, title, representation, content, parentID, status string) (*Page, error) {
+	if err := s.client.RequireScopes(OpConfluencePageWrite); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("%s/pages", s.baseURL())
 
 	if status == "" {
@@ -345,7 +496,7 @@ func (s *ConfluenceService) CreatePage(ctx context.Context, spaceID, title, cont
 		ParentID: parentID,
 		Status:   status,
 	}
-	reqBody.Body.Representation = "storage"
+	reqBody.Body.Representation = representation
 	reqBody.Body.Value = content
 
 	var page Page
@@ -356,6 +507,242 @@ func (s *ConfluenceService) CreatePage(ctx context.Context, spaceID, title, cont
 	return &page, nil
 }
 
+// TextToStorage wraps plain text in storage format. Empty text becomes an
+// empty paragraph, since Confluence rejects an empty body.
+func TextToStorage(text string) string {
+	if text == "" {
+		return "<p></p>"
+	}
+	return "<p>" + text + "</p>"
+}
+
+// BlogPost represents a Confluence blog post.
+type BlogPost struct {
+	ID        string       `json:"id"`
+	Title     string       `json:"title"`
+	SpaceID   string       `json:"spaceId,omitempty"`
+	Status    string       `json:"status"`
+	AuthorID  string       `json:"authorId,omitempty"`
+	CreatedAt string       `json:"createdAt,omitempty"`
+	Version   *PageVersion `json:"version,omitempty"`
+	Body      *PageBody    `json:"body,omitempty"`
+	Links     *PageLinks   `json:"_links,omitempty"`
+}
+
+// BlogPostsResponse represents a paginated list of blog posts.
+type BlogPostsResponse struct {
+	Results []*BlogPost      `json:"results"`
+	Links   *PaginationLinks `json:"_links,omitempty"`
+}
+
+// NextCursor returns the cursor for the next page, or "" on the last page.
+func (r *BlogPostsResponse) NextCursor() string {
+	return r.Links.NextCursor()
+}
+
+// CreateBlogPostRequest represents a request to create a blog post.
+type CreateBlogPostRequest struct {
+	SpaceID string `json:"spaceId"`
+	Title   string `json:"title"`
+	Status  string `json:"status"`
+	Body    struct {
+		Representation string `json:"representation"`
+		Value          string `json:"value"`
+	} `json:"body"`
+}
+
+// CreateBlogPost publishes a new blog post in a space.
+// content must be in storage format.
+func (s *ConfluenceService) CreateBlogPost(ctx context.Context, spaceID, title, content string) (*BlogPost, error) {
+	path := fmt.Sprintf("%s/blogposts", s.baseURL())
+
+	reqBody := CreateBlogPostRequest{
+		SpaceID: spaceID,
+		Title:   title,
+		Status:  "current",
+	}
+	reqBody.Body.Representation = "storage"
+	reqBody.Body.Value = content
+
+	var post BlogPost
+	if err := s.client.Post(ctx, path, reqBody, &post); err != nil {
+		return nil, err
+	}
+
+	return &post, nil
+}
+
+// GetBlogPosts gets blog posts in a space, newest first.
+func (s *ConfluenceService) GetBlogPosts(ctx context.Context, spaceID string, limit int, cursor string) (*BlogPostsResponse, error) {
+	path := fmt.Sprintf("%s/spaces/%s/blogposts", s.baseURL(), spaceID)
+
+	params := url.Values{}
+	params.Set("sort", "-created-date")
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(capLimit(limit, ConfluenceMaxLimit)))
+	}
+	setCursor(params, cursor)
+
+	var result BlogPostsResponse
+	if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// PageComment represents a footer or inline comment on a page.
+type PageComment struct {
+	ID      string       `json:"id"`
+	Status  string       `json:"status"`
+	PageID  string       `json:"pageId,omitempty"`
+	Version *PageVersion `json:"version,omitempty"`
+	Body    *PageBody    `json:"body,omitempty"`
+	// ResolutionStatus is only set on inline comments: open, resolved,
+	// reopened, or dangling (the highlighted text was removed).
+	ResolutionStatus string                   `json:"resolutionStatus,omitempty"`
+	Properties       *InlineCommentProperties `json:"properties,omitempty"`
+}
+
+// InlineCommentProperties holds the page text an inline comment is attached to.
+type InlineCommentProperties struct {
+	OriginalSelection string `json:"inlineOriginalSelection,omitempty"`
+}
+
+// Text returns the comment body as Markdown text.
+func (c *PageComment) Text() string {
+	if c.Body == nil || c.Body.AtlasDocFormat == nil || c.Body.AtlasDocFormat.Value == "" {
+		return ""
+	}
+	var doc ADF
+	if err := json.Unmarshal([]byte(c.Body.AtlasDocFormat.Value), &doc); err != nil {
+		return ""
+	}
+	return ADFToText(&doc)
+}
+
+// PageCommentsResponse represents a paginated list of page comments.
+type PageCommentsResponse struct {
+	Results []*PageComment   `json:"results"`
+	Links   *PaginationLinks `json:"_links,omitempty"`
+}
+
+// GetPageFooterComments gets all footer comments on a page.
+func (s *ConfluenceService) GetPageFooterComments(ctx context.Context, pageID string) ([]*PageComment, error) {
+	return s.getPageComments(ctx, pageID, "footer-comments")
+}
+
+// GetPageInlineComments gets all inline comments on a page.
+func (s *ConfluenceService) GetPageInlineComments(ctx context.Context, pageID string) ([]*PageComment, error) {
+	return s.getPageComments(ctx, pageID, "inline-comments")
+}
+
+func (s *ConfluenceService) getPageComments(ctx context.Context, pageID, kind string) ([]*PageComment, error) {
+	path := fmt.Sprintf("%s/pages/%s/%s", s.baseURL(), pageID, kind)
+
+	var allComments []*PageComment
+	cursor := ""
+	for {
+		params := url.Values{}
+		params.Set("body-format", "atlas_doc_format")
+		params.Set("limit", "100")
+		setCursor(params, cursor)
+
+		var result PageCommentsResponse
+		if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
+			return nil, err
+		}
+		allComments = append(allComments, result.Results...)
+
+		if result.Links == nil || result.Links.Next == "" {
+			break
+		}
+		cursor = extractCursor(result.Links.Next)
+		if cursor == "" {
+			break
+		}
+	}
+
+	return allComments, nil
+}
+
+// AddPageFooterCommentRequest represents a request to add a footer comment.
+type AddPageFooterCommentRequest struct {
+	PageID string      `json:"pageId"`
+	Body   BodyContent `json:"body"`
+}
+
+// AddPageFooterComment adds a footer comment to a page.
+// body is Markdown text and is converted to ADF like Jira comments.
+func (s *ConfluenceService) AddPageFooterComment(ctx context.Context, pageID, body string) (*PageComment, error) {
+	path := fmt.Sprintf("%s/footer-comments", s.baseURL())
+
+	doc, err := json.Marshal(TextToADF(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode comment body: %w", err)
+	}
+
+	req := AddPageFooterCommentRequest{
+		PageID: pageID,
+		Body: BodyContent{
+			Representation: "atlas_doc_format",
+			Value:          string(doc),
+		},
+	}
+
+	var comment PageComment
+	if err := s.client.Post(ctx, path, req, &comment); err != nil {
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+// PageAttachment represents a file attached to a page.
+type PageAttachment struct {
+	ID        string       `json:"id"`
+	Status    string       `json:"status"`
+	Title     string       `json:"title"`
+	MediaType string       `json:"mediaType,omitempty"`
+	FileSize  int64        `json:"fileSize,omitempty"`
+	Version   *PageVersion `json:"version,omitempty"`
+}
+
+// PageAttachmentsResponse represents a paginated list of page attachments.
+type PageAttachmentsResponse struct {
+	Results []*PageAttachment `json:"results"`
+	Links   *PaginationLinks  `json:"_links,omitempty"`
+}
+
+// GetPageAttachments gets all attachments on a page.
+func (s *ConfluenceService) GetPageAttachments(ctx context.Context, pageID string) ([]*PageAttachment, error) {
+	path := fmt.Sprintf("%s/pages/%s/attachments", s.baseURL(), pageID)
+
+	var allAttachments []*PageAttachment
+	cursor := ""
+	for {
+		params := url.Values{}
+		params.Set("limit", "100")
+		setCursor(params, cursor)
+
+		var result PageAttachmentsResponse
+		if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
+			return nil, err
+		}
+		allAttachments = append(allAttachments, result.Results...)
+
+		if result.Links == nil || result.Links.Next == "" {
+			break
+		}
+		cursor = extractCursor(result.Links.Next)
+		if cursor == "" {
+			break
+		}
+	}
+
+	return allAttachments, nil
+}
+
 // UpdatePageRequest represents a request to update a page.
 type UpdatePageRequest struct {
 	ID      string `json:"id"`
@@ -373,6 +760,14 @@ type UpdatePageRequest struct {
 
 // UpdatePage updates an existing page.
 func (s *ConfluenceService) UpdatePage(ctx context.Context, pageID, title, content string, version int, message string) (*Page, error) {
+	return s.updatePage(ctx, pageID, title, RepresentationStorage, content, version, message)
+}
+
+func (s *ConfluenceService) updatePage(ctx context.Context, pageID, title, representation, content string, version int, message string) (*Page, error) {
+	if err := s.client.RequireScopes(OpConfluencePageWrite); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("%s/pages/%s", s.baseURL(), pageID)
 
 	reqBody := UpdatePageRequest{
@@ -382,7 +777,7 @@ func (s *ConfluenceService) UpdatePage(ctx context.Context, pageID, title, conte
 	}
 	reqBody.Version.Number = version + 1
 	reqBody.Version.Message = message
-	reqBody.Body.Representation = "storage"
+	reqBody.Body.Representation = representation
 	reqBody.Body.Value = content
 
 	var page Page
@@ -597,6 +992,13 @@ type ConfluenceSearchResult struct {
 // ConfluenceSearchResponse represents a paginated search response.
 type ConfluenceSearchResponse struct {
 	Results []*ConfluenceSearchResult `json:"results"`
+	Links   *PaginationLinks          `json:"_links,omitempty"`
+}
+
+// NextCursor returns the cursor for the next page of results, or "" on the
+// last page. Pass it back to SearchWithCQL to continue.
+func (r *ConfluenceSearchResponse) NextCursor() string {
+	return r.Links.NextCursor()
 }
 
 // SearchWithCQL searches for content using CQL (Confluence Query Language).
@@ -609,6 +1011,10 @@ type ConfluenceSearchResponse struct {
 // Uses v1 API because search endpoint doesn't exist in v2 (as of Dec 2024).
 // Requires OAuth scope: search:confluence (classic scope).
 func (s *ConfluenceService) SearchWithCQL(ctx context.Context, cql string, limit int, cursor string) (*ConfluenceSearchResponse, error) {
+	if err := s.client.RequireScopes(OpConfluenceSearch); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("%s/search", s.baseURLV1())
 
 	params := url.Values{}
@@ -616,9 +1022,7 @@ func (s *ConfluenceService) SearchWithCQL(ctx context.Context, cql string, limit
 	if limit > 0 {
 		params.Set("limit", strconv.Itoa(capLimit(limit, ConfluenceMaxLimit)))
 	}
-	if cursor != "" {
-		params.Set("start", cursor)
-	}
+	setCursor(params, cursor)
 
 	var v1Result ConfluenceSearchResponseV1
 	if err := s.client.Get(ctx, path+"?"+params.Encode(), &v1Result); err != nil {
@@ -628,6 +1032,7 @@ func (s *ConfluenceService) SearchWithCQL(ctx context.Context, cql string, limit
 	// Convert v1 response to normalized format
 	result := &ConfluenceSearchResponse{
 		Results: make([]*ConfluenceSearchResult, 0, len(v1Result.Results)),
+		Links:   v1Result.Links,
 	}
 	for _, r := range v1Result.Results {
 		spaceKey := ""
@@ -649,6 +1054,7 @@ func (s *ConfluenceService) SearchWithCQL(ctx context.Context, cql string, limit
 
 // SearchByTitle searches for pages by title using CQL contains match.
 func (s *ConfluenceService) SearchByTitle(ctx context.Context, title string, spaceKey string, limit int) (*ConfluenceSearchResponse, error) {
+	title = strings.ReplaceAll(title, `"`, `\"`)
 	var cql string
 	if spaceKey != "" {
 		cql = fmt.Sprintf("type = page AND space = \"%s\" AND title ~ \"%s\"", spaceKey, title)
@@ -659,6 +1065,77 @@ func (s *ConfluenceService) SearchByTitle(ctx context.Context, title string, spa
 	return s.SearchWithCQL(ctx, cql, limit, "")
 }
 
+// UpsertPageOptions describes a page to create or update by title.
+type UpsertPageOptions struct {
+	SpaceKey       string
+	Title          string
+	ParentID       string // Only used when the page is created
+	Body           string
+	Representation string // RepresentationStorage (default) or RepresentationADF
+	Message        string // Version message when the page is updated
+}
+
+// UpsertPageResult is the page written by UpsertPage.
+type UpsertPageResult struct {
+	Page    *Page
+	Created bool
+}
+
+// UpsertPage updates the page with exactly the given title in the space, or
+// creates it if there is none. Updates bump the page's current version, so
+// running the same upsert twice is safe.
+func (s *ConfluenceService) UpsertPage(ctx context.Context, opts UpsertPageOptions) (*UpsertPageResult, error) {
+	representation := opts.Representation
+	if representation == "" {
+		representation = RepresentationStorage
+	}
+
+	space, err := s.GetSpaceByKey(ctx, opts.SpaceKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get space: %w", err)
+	}
+	found, err := s.GetPagesByTitle(ctx, space.ID, opts.Title)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up page: %w", err)
+	}
+	if len(found) > 1 {
+		ids := make([]string, len(found))
+		for i, p := range found {
+			ids[i] = p.ID
+		}
+		return nil, fmt.Errorf("%d pages titled %q in space %s (IDs %s); update one by ID instead", len(found), opts.Title, opts.SpaceKey, strings.Join(ids, ", "))
+	}
+
+	if len(found) == 0 {
+		if err := s.CheckCanCreatePage(ctx, space.ID, opts.SpaceKey); err != nil {
+			return nil, err
+		}
+		page, err := s.createPage(ctx, space.ID, opts.Title, representation, opts.Body, opts.ParentID, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create page: %w", err)
+		}
+		return &UpsertPageResult{Page: page, Created: true}, nil
+	}
+
+	pageID := found[0].ID
+	if err := s.CheckCanUpdatePage(ctx, pageID); err != nil {
+		return nil, err
+	}
+	current, err := s.GetPage(ctx, pageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get page: %w", err)
+	}
+	version := 0
+	if current.Version != nil {
+		version = current.Version.Number
+	}
+	page, err := s.updatePage(ctx, pageID, opts.Title, representation, opts.Body, version, opts.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update page: %w", err)
+	}
+	return &UpsertPageResult{Page: page}, nil
+}
+
 // PageChild represents a child or descendant page.
 type PageChild struct {
 	ID            string `json:"id"`
@@ -684,9 +1161,7 @@ func (s *ConfluenceService) GetPageChildren(ctx context.Context, pageID string,
 	if limit > 0 {
 		params.Set("limit", strconv.Itoa(capLimit(limit, ConfluenceMaxLimit)))
 	}
-	if cursor != "" {
-		params.Set("cursor", cursor)
-	}
+	setCursor(params, cursor)
 
 	var result ChildrenResponse
 	if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
@@ -704,9 +1179,7 @@ func (s *ConfluenceService) GetPageDescendants(ctx context.Context, pageID strin
 	if limit > 0 {
 		params.Set("limit", strconv.Itoa(capLimit(limit, ConfluenceMaxLimit)))
 	}
-	if cursor != "" {
-		params.Set("cursor", cursor)
-	}
+	setCursor(params, cursor)
 
 	var result ChildrenResponse
 	if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
@@ -876,3 +1349,115 @@ func (s *ConfluenceService) UpdateTemplate(ctx context.Context, templateID, name
 
 	return &template, nil
 }
+
+// ContentProperty is an arbitrary JSON value stored on a page under a key.
+type ContentProperty struct {
+	ID      string          `json:"id,omitempty"`
+	Key     string          `json:"key"`
+	Value   json.RawMessage `json:"value"`
+	Version *PageVersion    `json:"version,omitempty"`
+}
+
+// ContentPropertiesResponse represents a paginated list of content properties.
+type ContentPropertiesResponse struct {
+	Results []*ContentProperty `json:"results"`
+	Links   *PaginationLinks   `json:"_links,omitempty"`
+}
+
+// ErrPropertyNotFound is returned when a page has no property with the given key.
+var ErrPropertyNotFound = errors.New("property not found")
+
+// GetPageProperties lists all properties on a page.
+func (s *ConfluenceService) GetPageProperties(ctx context.Context, pageID string) ([]*ContentProperty, error) {
+	return s.getPageProperties(ctx, pageID, "")
+}
+
+func (s *ConfluenceService) getPageProperties(ctx context.Context, pageID, key string) ([]*ContentProperty, error) {
+	path := fmt.Sprintf("%s/pages/%s/properties", s.baseURL(), pageID)
+
+	var allProperties []*ContentProperty
+	cursor := ""
+	for {
+		params := url.Values{}
+		params.Set("limit", "100")
+		if key != "" {
+			params.Set("key", key)
+		}
+		setCursor(params, cursor)
+
+		var result ContentPropertiesResponse
+		if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
+			return nil, err
+		}
+		allProperties = append(allProperties, result.Results...)
+
+		if result.Links == nil || result.Links.Next == "" {
+			break
+		}
+		cursor = extractCursor(result.Links.Next)
+		if cursor == "" {
+			break
+		}
+	}
+
+	return allProperties, nil
+}
+
+// GetPageProperty gets a property from a page by key.
+// Returns ErrPropertyNotFound if the page has no such property.
+func (s *ConfluenceService) GetPageProperty(ctx context.Context, pageID, key string) (*ContentProperty, error) {
+	properties, err := s.getPageProperties(ctx, pageID, key)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range properties {
+		if p.Key == key {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrPropertyNotFound, key)
+}
+
+// SetPageProperty creates or replaces a property on a page.
+// The v2 API addresses properties by ID, so an existing property is looked up
+// first and updated with the next version number.
+func (s *ConfluenceService) SetPageProperty(ctx context.Context, pageID, key string, value json.RawMessage) (*ContentProperty, error) {
+	existing, err := s.GetPageProperty(ctx, pageID, key)
+	if err != nil && !errors.Is(err, ErrPropertyNotFound) {
+		return nil, err
+	}
+
+	req := &ContentProperty{Key: key, Value: value}
+	var result ContentProperty
+
+	if existing == nil {
+		path := fmt.Sprintf("%s/pages/%s/properties", s.baseURL(), pageID)
+		if err := s.client.Post(ctx, path, req, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+
+	version := 1
+	if existing.Version != nil {
+		version = existing.Version.Number + 1
+	}
+	req.Version = &PageVersion{Number: version}
+
+	path := fmt.Sprintf("%s/pages/%s/properties/%s", s.baseURL(), pageID, existing.ID)
+	if err := s.client.Put(ctx, path, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeletePageProperty removes a property from a page by key.
+func (s *ConfluenceService) DeletePageProperty(ctx context.Context, pageID, key string) error {
+	existing, err := s.GetPageProperty(ctx, pageID, key)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("%s/pages/%s/properties/%s", s.baseURL(), pageID, existing.ID)
+	return s.client.Delete(ctx, path)
+}
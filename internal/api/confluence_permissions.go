@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// ConfluenceUser is a Confluence user as returned by the v1 user API.
+type ConfluenceUser struct {
+	AccountID   string `json:"accountId"`
+	AccountType string `json:"accountType,omitempty"`
+	Email       string `json:"email,omitempty"`
+	PublicName  string `json:"publicName,omitempty"`
+	DisplayName string `json:"displayName"`
+}
+
+// GetCurrentUser gets the user the token belongs to. The result is cached
+// on the service, so repeated checks in one command make a single request.
+func (s *ConfluenceService) GetCurrentUser(ctx context.Context) (*ConfluenceUser, error) {
+	s.userMu.Lock()
+	defer s.userMu.Unlock()
+
+	if s.currentUser != nil {
+		return s.currentUser, nil
+	}
+
+	path := fmt.Sprintf("%s/user/current", s.baseURLV1())
+
+	var user ConfluenceUser
+	if err := s.client.Get(ctx, path, &user); err != nil {
+		return nil, err
+	}
+
+	s.currentUser = &user
+	return &user, nil
+}
+
+// PermittedOperation is an operation the current user may perform on a
+// space or page, e.g. {create, page} or {update, page}.
+type PermittedOperation struct {
+	Operation  string `json:"operation"`
+	TargetType string `json:"targetType"`
+}
+
+type permittedOperationsResponse struct {
+	Operations []*PermittedOperation `json:"operations"`
+}
+
+// GetSpaceOperations lists what the current user may do in a space.
+func (s *ConfluenceService) GetSpaceOperations(ctx context.Context, spaceID string) ([]*PermittedOperation, error) {
+	return s.getOperations(ctx, fmt.Sprintf("%s/spaces/%s/operations", s.baseURL(), spaceID))
+}
+
+// GetPageOperations lists what the current user may do with a page, taking
+// page restrictions into account.
+func (s *ConfluenceService) GetPageOperations(ctx context.Context, pageID string) ([]*PermittedOperation, error) {
+	return s.getOperations(ctx, fmt.Sprintf("%s/pages/%s/operations", s.baseURL(), pageID))
+}
+
+func (s *ConfluenceService) getOperations(ctx context.Context, path string) ([]*PermittedOperation, error) {
+	var result permittedOperationsResponse
+	if err := s.client.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+	return result.Operations, nil
+}
+
+// PermissionError is returned by the write pre-checks when the current user
+// is not allowed to make the change, instead of a bare 403 from the write.
+type PermissionError struct {
+	User   string // Display name; empty if it could not be looked up
+	Action string // e.g. "create pages in space DOCS"
+	Hint   string
+}
+
+func (e *PermissionError) Error() string {
+	who := "you"
+	if e.User != "" {
+		who = fmt.Sprintf("you (%s)", e.User)
+	}
+	msg := fmt.Sprintf("%s lack permission to %s", who, e.Action)
+	if e.Hint != "" {
+		msg += "\n\n" + e.Hint
+	}
+	return msg
+}
+
+// CheckCanCreatePage returns a *PermissionError if the current user cannot
+// add pages to the space. If the permissions cannot be read (e.g. the token
+// lacks the scope to list them), nil is returned and the write itself
+// reports any problem.
+func (s *ConfluenceService) CheckCanCreatePage(ctx context.Context, spaceID, spaceKey string) error {
+	ops, err := s.GetSpaceOperations(ctx, spaceID)
+	if err != nil || hasOperation(ops, "create", "page") {
+		return nil
+	}
+	return &PermissionError{
+		User:   s.currentUserName(ctx),
+		Action: "create pages in space " + spaceKey,
+		Hint:   fmt.Sprintf("Ask a space admin of %s for the 'Add pages' permission", spaceKey),
+	}
+}
+
+// CheckCanUpdatePage returns a *PermissionError if the current user cannot
+// edit the page, either for lack of space permission or because the page is
+// restricted. Like CheckCanCreatePage, unreadable permissions are not an
+// error.
+func (s *ConfluenceService) CheckCanUpdatePage(ctx context.Context, pageID string) error {
+	ops, err := s.GetPageOperations(ctx, pageID)
+	if err != nil || hasOperation(ops, "update", "page") {
+		return nil
+	}
+	return &PermissionError{
+		User:   s.currentUserName(ctx),
+		Action: "edit page " + pageID,
+		Hint:   "The page may be restricted; ask its owner or a space admin for edit access",
+	}
+}
+
+// currentUserName returns the current user's display name for error
+// messages, or "" if it cannot be looked up.
+func (s *ConfluenceService) currentUserName(ctx context.Context) string {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return ""
+	}
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	return user.PublicName
+}
+
+func hasOperation(ops []*PermittedOperation, operation, targetType string) bool {
+	for _, op := range ops {
+		if op.Operation == operation && op.TargetType == targetType {
+			return true
+		}
+	}
+	return false
+}
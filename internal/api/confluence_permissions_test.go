@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newPermissionsTestService(t *testing.T, handler http.HandlerFunc) *ConfluenceService {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := newTestClient(t, server)
+	return NewConfluenceService(client)
+}
+
+func TestGetCurrentUserCached(t *testing.T) {
+	calls := 0
+	confluence := newPermissionsTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ex/confluence/test-cloud/wiki/rest/api/user/current" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"accountId":"abc","displayName":"Jane Doe","email":"jane@example.com"}`))
+	})
+
+	for range 2 {
+		user, err := confluence.GetCurrentUser(context.Background())
+		if err != nil {
+			t.Fatalf("GetCurrentUser() error = %v", err)
+		}
+		if user.AccountID != "abc" || user.DisplayName != "Jane Doe" {
+			t.Errorf("GetCurrentUser() = %+v", user)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("requests = %d, want 1 (cached)", calls)
+	}
+}
+
+func TestCheckCanCreatePage(t *testing.T) {
+	const v2 = "/ex/confluence/test-cloud/wiki/api/v2"
+
+	tests := []struct {
+		name       string
+		operations string
+		status     int
+		wantErr    string
+	}{
+		{
+			name:       "allowed",
+			operations: `{"operations":[{"operation":"read","targetType":"space"},{"operation":"create","targetType":"page"}]}`,
+			status:     http.StatusOK,
+		},
+		{
+			name:       "read only",
+			operations: `{"operations":[{"operation":"read","targetType":"space"},{"operation":"create","targetType":"comment"}]}`,
+			status:     http.StatusOK,
+			wantErr:    "you (Jane Doe) lack permission to create pages in space DOCS",
+		},
+		{
+			name:       "permissions not readable",
+			operations: `{"errors":[{"title":"Forbidden"}]}`,
+			status:     http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confluence := newPermissionsTestService(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case v2 + "/spaces/100/operations":
+					w.WriteHeader(tt.status)
+					w.Write([]byte(tt.operations))
+				case "/ex/confluence/test-cloud/wiki/rest/api/user/current":
+					w.Write([]byte(`{"accountId":"abc","displayName":"Jane Doe"}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			err := confluence.CheckCanCreatePage(context.Background(), "100", "DOCS")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("CheckCanCreatePage() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var permErr *PermissionError
+			if !errors.As(err, &permErr) {
+				t.Fatalf("CheckCanCreatePage() error = %v, want *PermissionError", err)
+			}
+			if !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want prefix %q", err.Error(), tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), "'Add pages' permission") {
+				t.Errorf("error = %q, want a hint about the Add pages permission", err.Error())
+			}
+		})
+	}
+}
+
+func TestCheckCanUpdatePageWithoutUser(t *testing.T) {
+	confluence := newPermissionsTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/ex/confluence/test-cloud/wiki/api/v2/pages/42/operations":
+			w.Write([]byte(`{"operations":[{"operation":"read","targetType":"page"}]}`))
+		default:
+			// The user lookup fails; the error still explains the problem.
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+
+	err := confluence.CheckCanUpdatePage(context.Background(), "42")
+	if err == nil || !strings.HasPrefix(err.Error(), "you lack permission to edit page 42") {
+		t.Errorf("CheckCanUpdatePage() error = %v, want permission error for page 42", err)
+	}
+}
@@ -3,8 +3,12 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -334,6 +338,58 @@ func TestPagesResponse(t *testing.T) {
 	}
 }
 
+// TestNextCursor tests that list responses expose the decoded cursor, so
+// passing it back to a list call encodes it exactly once.
+func TestNextCursor(t *testing.T) {
+	tests := []struct {
+		name  string
+		links *PaginationLinks
+		want  string
+	}{
+		{name: "nil links", links: nil, want: ""},
+		{name: "last page", links: &PaginationLinks{}, want: ""},
+		{name: "plain cursor", links: &PaginationLinks{Next: "/wiki/api/v2/pages?cursor=abc123&limit=25"}, want: "abc123"},
+		{name: "encoded cursor", links: &PaginationLinks{Next: "/wiki/api/v2/pages?cursor=abc%2B123%3D%3D&limit=25"}, want: "abc+123=="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (&SpacesResponse{Links: tt.links}).NextCursor(); got != tt.want {
+				t.Errorf("SpacesResponse.NextCursor() = %q, want %q", got, tt.want)
+			}
+			if got := (&PagesResponse{Links: tt.links}).NextCursor(); got != tt.want {
+				t.Errorf("PagesResponse.NextCursor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNormalizeCursor tests that every accepted --cursor form reaches the
+// API encoded exactly once.
+func TestNormalizeCursor(t *testing.T) {
+	tests := []struct {
+		cursor string
+		want   string
+	}{
+		{"", ""},
+		{"abc+123==", "abc+123=="},
+		{"abc%2B123%3D%3D", "abc+123=="},
+		{"/wiki/api/v2/pages?cursor=abc%2B123%3D%3D&limit=25", "abc+123=="},
+		{"cursor=abc%2B123%3D%3D", "abc+123=="},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeCursor(tt.cursor); got != tt.want {
+			t.Errorf("normalizeCursor(%q) = %q, want %q", tt.cursor, got, tt.want)
+		}
+		params := url.Values{}
+		setCursor(params, tt.cursor)
+		if got := params.Get("cursor"); got != tt.want {
+			t.Errorf("setCursor(%q) = %q, want %q", tt.cursor, got, tt.want)
+		}
+	}
+}
+
 // TestSpaceDescription tests the SpaceDescription structure.
 func TestSpaceDescription(t *testing.T) {
 	desc := &SpaceDescription{
@@ -369,3 +425,417 @@ func TestPageBodyFormats(t *testing.T) {
 		t.Errorf("PageBody.View.Representation = %q, want %q", body.View.Representation, "view")
 	}
 }
+
+// TestBlogPosts tests creating and listing blog posts.
+func TestBlogPosts(t *testing.T) {
+	var created CreateBlogPostRequest
+	var listQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/ex/confluence/test-cloud/wiki/api/v2/blogposts":
+			json.NewDecoder(r.Body).Decode(&created)
+			json.NewEncoder(w).Encode(BlogPost{ID: "42", Title: created.Title, SpaceID: created.SpaceID, Status: "current"})
+		case r.Method == http.MethodGet && r.URL.Path == "/ex/confluence/test-cloud/wiki/api/v2/spaces/123/blogposts":
+			listQuery = r.URL.RawQuery
+			json.NewEncoder(w).Encode(BlogPostsResponse{
+				Results: []*BlogPost{{ID: "42", Title: "Release notes", CreatedAt: "2024-03-01T09:00:00.000Z"}},
+				Links:   &PaginationLinks{Next: "/wiki/api/v2/spaces/123/blogposts?cursor=abc"},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	confluence := NewConfluenceService(client)
+	ctx := context.Background()
+
+	post, err := confluence.CreateBlogPost(ctx, "123", "Release notes", TextToStorage("Hello"))
+	if err != nil {
+		t.Fatalf("CreateBlogPost() error = %v", err)
+	}
+	if post.ID != "42" {
+		t.Errorf("CreateBlogPost() ID = %q, want %q", post.ID, "42")
+	}
+	if created.SpaceID != "123" || created.Status != "current" || created.Body.Representation != "storage" || created.Body.Value != "<p>Hello</p>" {
+		t.Errorf("request body = %+v, want storage body in space 123", created)
+	}
+
+	result, err := confluence.GetBlogPosts(ctx, "123", 10, "")
+	if err != nil {
+		t.Fatalf("GetBlogPosts() error = %v", err)
+	}
+	if len(result.Results) != 1 || result.Results[0].CreatedAt == "" {
+		t.Errorf("GetBlogPosts() results = %+v, want one post with createdAt", result.Results)
+	}
+	if result.NextCursor() != "abc" {
+		t.Errorf("NextCursor() = %q, want %q", result.NextCursor(), "abc")
+	}
+	if listQuery != "limit=10&sort=-created-date" {
+		t.Errorf("list query = %q, want %q", listQuery, "limit=10&sort=-created-date")
+	}
+}
+
+// TestPageComments tests listing and adding page comments.
+func TestPageComments(t *testing.T) {
+	const base = "/ex/confluence/test-cloud/wiki/api/v2"
+	var added AddPageFooterCommentRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case base + "/pages/1/footer-comments":
+			if r.URL.Query().Get("cursor") == "" {
+				w.Write([]byte(`{"results":[{"id":"10","body":{"atlas_doc_format":{"representation":"atlas_doc_format","value":"{\"type\":\"doc\",\"version\":1,\"content\":[{\"type\":\"paragraph\",\"content\":[{\"type\":\"text\",\"text\":\"First\"}]}]}"}}}],"_links":{"next":"/wiki/api/v2/pages/1/footer-comments?cursor=next"}}`))
+				return
+			}
+			w.Write([]byte(`{"results":[{"id":"11"}]}`))
+		case base + "/pages/1/inline-comments":
+			w.Write([]byte(`{"results":[{"id":"20","resolutionStatus":"resolved","properties":{"inlineOriginalSelection":"typo"}}]}`))
+		case base + "/footer-comments":
+			json.NewDecoder(r.Body).Decode(&added)
+			w.Write([]byte(`{"id":"12","pageId":"1"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	confluence := NewConfluenceService(client)
+	ctx := context.Background()
+
+	footer, err := confluence.GetPageFooterComments(ctx, "1")
+	if err != nil {
+		t.Fatalf("GetPageFooterComments() error = %v", err)
+	}
+	if len(footer) != 2 {
+		t.Fatalf("GetPageFooterComments() returned %d comments, want 2 across pages", len(footer))
+	}
+	if got := footer[0].Text(); got != "First" {
+		t.Errorf("Text() = %q, want %q", got, "First")
+	}
+
+	inline, err := confluence.GetPageInlineComments(ctx, "1")
+	if err != nil {
+		t.Fatalf("GetPageInlineComments() error = %v", err)
+	}
+	if len(inline) != 1 || inline[0].ResolutionStatus != "resolved" || inline[0].Properties.OriginalSelection != "typo" {
+		t.Errorf("GetPageInlineComments() = %+v, want one resolved comment on \"typo\"", inline)
+	}
+
+	if _, err := confluence.AddPageFooterComment(ctx, "1", "Looks **good**"); err != nil {
+		t.Fatalf("AddPageFooterComment() error = %v", err)
+	}
+	if added.PageID != "1" || added.Body.Representation != "atlas_doc_format" {
+		t.Errorf("request = %+v, want ADF body for page 1", added)
+	}
+	var doc ADF
+	if err := json.Unmarshal([]byte(added.Body.Value), &doc); err != nil || doc.Type != "doc" {
+		t.Errorf("body value is not an ADF document: %q", added.Body.Value)
+	}
+}
+
+func TestGetPageAttachments(t *testing.T) {
+	const path = "/ex/confluence/test-cloud/wiki/api/v2/pages/1/attachments"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			w.Write([]byte(`{"results":[{"id":"att1","title":"diagram.png","mediaType":"image/png","fileSize":2048}],"_links":{"next":"/wiki/api/v2/pages/1/attachments?cursor=next"}}`))
+			return
+		}
+		w.Write([]byte(`{"results":[{"id":"att2","title":"spec.pdf"}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+
+	attachments, err := NewConfluenceService(client).GetPageAttachments(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("GetPageAttachments() error = %v", err)
+	}
+	if len(attachments) != 2 {
+		t.Fatalf("GetPageAttachments() returned %d attachments, want 2 across pages", len(attachments))
+	}
+	if attachments[0].Title != "diagram.png" || attachments[0].FileSize != 2048 {
+		t.Errorf("attachments[0] = %+v", attachments[0])
+	}
+}
+
+// TestPagePropertyRoundTrip tests setting, updating, and reading a page property.
+func TestPagePropertyRoundTrip(t *testing.T) {
+	const base = "/ex/confluence/test-cloud/wiki/api/v2/pages/1/properties"
+	var stored *ContentProperty
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == base:
+			resp := ContentPropertiesResponse{Results: []*ContentProperty{}}
+			if stored != nil && r.URL.Query().Get("key") == stored.Key {
+				resp.Results = append(resp.Results, stored)
+			}
+			json.NewEncoder(w).Encode(resp)
+		case r.Method == http.MethodPost && r.URL.Path == base:
+			var req ContentProperty
+			json.NewDecoder(r.Body).Decode(&req)
+			stored = &ContentProperty{ID: "99", Key: req.Key, Value: req.Value, Version: &PageVersion{Number: 1}}
+			json.NewEncoder(w).Encode(stored)
+		case r.Method == http.MethodPut && r.URL.Path == base+"/99":
+			var req ContentProperty
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Version == nil || req.Version.Number != stored.Version.Number+1 {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			stored = &ContentProperty{ID: "99", Key: req.Key, Value: req.Value, Version: req.Version}
+			json.NewEncoder(w).Encode(stored)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	confluence := NewConfluenceService(client)
+	ctx := context.Background()
+
+	if _, err := confluence.GetPageProperty(ctx, "1", "review"); !errors.Is(err, ErrPropertyNotFound) {
+		t.Fatalf("GetPageProperty() before set error = %v, want ErrPropertyNotFound", err)
+	}
+
+	if _, err := confluence.SetPageProperty(ctx, "1", "review", json.RawMessage(`{"approved":false}`)); err != nil {
+		t.Fatalf("SetPageProperty() create error = %v", err)
+	}
+	if _, err := confluence.SetPageProperty(ctx, "1", "review", json.RawMessage(`{"approved":true}`)); err != nil {
+		t.Fatalf("SetPageProperty() update error = %v", err)
+	}
+
+	property, err := confluence.GetPageProperty(ctx, "1", "review")
+	if err != nil {
+		t.Fatalf("GetPageProperty() error = %v", err)
+	}
+	if string(property.Value) != `{"approved":true}` || property.Version.Number != 2 {
+		t.Errorf("GetPageProperty() = %s (version %d), want updated value at version 2", property.Value, property.Version.Number)
+	}
+
+	want := "GET,GET,POST,GET,PUT,GET"
+	if got := strings.Join(methods, ","); got != want {
+		t.Errorf("requests = %s, want %s", got, want)
+	}
+}
+
+func TestUpsertPage(t *testing.T) {
+	const v2 = "/ex/confluence/test-cloud/wiki/api/v2"
+
+	// existing holds the page titled "Runbook"; nil until it is created.
+	var existing *Page
+	var requests []string
+	var lastBody CreatePageRequest
+	var lastVersion int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+strings.TrimPrefix(r.URL.Path, v2))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == v2+"/spaces/100/pages":
+			if got := r.URL.Query().Get("title"); got != "Runbook" {
+				t.Errorf("title = %q, want the exact title", got)
+			}
+			var results []*Page
+			if existing != nil {
+				results = append(results, existing)
+			}
+			json.NewEncoder(w).Encode(PagesResponse{Results: results})
+		case r.Method == http.MethodGet && r.URL.Path == v2+"/spaces":
+			json.NewEncoder(w).Encode(SpacesResponse{Results: []*Space{{ID: "100", Key: "OPS"}}})
+		case r.Method == http.MethodGet && r.URL.Path == v2+"/spaces/100/operations":
+			w.Write([]byte(`{"operations":[{"operation":"create","targetType":"page"}]}`))
+		case r.Method == http.MethodGet && existing != nil && r.URL.Path == v2+"/pages/"+existing.ID+"/operations":
+			w.Write([]byte(`{"operations":[{"operation":"update","targetType":"page"}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == v2+"/pages":
+			json.NewDecoder(r.Body).Decode(&lastBody)
+			existing = &Page{ID: "42", Title: lastBody.Title, SpaceID: lastBody.SpaceID, ParentID: lastBody.ParentID, Version: &PageVersion{Number: 1}}
+			json.NewEncoder(w).Encode(existing)
+		case r.Method == http.MethodGet && existing != nil && r.URL.Path == v2+"/pages/"+existing.ID:
+			page := *existing
+			page.Body = &PageBody{Storage: &BodyContent{Representation: "storage", Value: "<p>old</p>"}}
+			json.NewEncoder(w).Encode(page)
+		case r.Method == http.MethodPut && existing != nil && r.URL.Path == v2+"/pages/"+existing.ID:
+			var req UpdatePageRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			lastVersion = req.Version.Number
+			lastBody.Body = req.Body
+			existing.Version = &PageVersion{Number: req.Version.Number}
+			json.NewEncoder(w).Encode(existing)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	confluence := NewConfluenceService(client)
+	ctx := context.Background()
+
+	// No page with the exact title: created under the parent.
+	result, err := confluence.UpsertPage(ctx, UpsertPageOptions{
+		SpaceKey: "OPS",
+		Title:    "Runbook",
+		ParentID: "9",
+		Body:     "<p>v1</p>",
+	})
+	if err != nil {
+		t.Fatalf("UpsertPage() create error = %v", err)
+	}
+	if !result.Created || result.Page.ID != "42" {
+		t.Errorf("UpsertPage() create = %+v, want created page 42", result)
+	}
+	if lastBody.SpaceID != "100" || lastBody.ParentID != "9" || lastBody.Body.Representation != RepresentationStorage {
+		t.Errorf("create request = %+v", lastBody)
+	}
+
+	// Now it exists: updated with the next version, in the requested representation.
+	result, err = confluence.UpsertPage(ctx, UpsertPageOptions{
+		SpaceKey:       "OPS",
+		Title:          "Runbook",
+		ParentID:       "9",
+		Body:           `{"type":"doc","version":1,"content":[]}`,
+		Representation: RepresentationADF,
+	})
+	if err != nil {
+		t.Fatalf("UpsertPage() update error = %v", err)
+	}
+	if result.Created || result.Page.ID != "42" {
+		t.Errorf("UpsertPage() update = %+v, want updated page 42", result)
+	}
+	if lastVersion != 2 {
+		t.Errorf("update version = %d, want 2", lastVersion)
+	}
+	if lastBody.Body.Representation != RepresentationADF {
+		t.Errorf("update representation = %q, want %q", lastBody.Body.Representation, RepresentationADF)
+	}
+
+	want := "GET /spaces,GET /spaces/100/pages,GET /spaces/100/operations,POST /pages," +
+		"GET /spaces,GET /spaces/100/pages,GET /pages/42/operations,GET /pages/42,PUT /pages/42"
+	if got := strings.Join(requests, ","); got != want {
+		t.Errorf("requests =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestUpsertPageAmbiguousTitle tests that UpsertPage neither creates nor
+// updates a page when several pages have the exact title.
+func TestUpsertPageAmbiguousTitle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/ex/confluence/test-cloud/wiki/api/v2/spaces":
+			json.NewEncoder(w).Encode(SpacesResponse{Results: []*Space{{ID: "100", Key: "OPS"}}})
+		case "/ex/confluence/test-cloud/wiki/api/v2/spaces/100/pages":
+			json.NewEncoder(w).Encode(PagesResponse{Results: []*Page{{ID: "1", Title: "Runbook"}, {ID: "2", Title: "Runbook"}}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	confluence := NewConfluenceService(newTestClient(t, server))
+
+	_, err := confluence.UpsertPage(context.Background(), UpsertPageOptions{SpaceKey: "OPS", Title: "Runbook", Body: "<p>x</p>"})
+	if err == nil || !strings.Contains(err.Error(), "IDs 1, 2") {
+		t.Errorf("UpsertPage() error = %v, want the matching page IDs", err)
+	}
+}
+
+// TestGetPageFallsBackToV1 tests that a page whose v2 bodies are all empty
+// gets its body from the v1 content API.
+// TestGetPageViewFallback tests that the rendered HTML is only requested
+// when the storage format returned no body, not for an empty page.
+func TestGetPageViewFallback(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageBody string
+		wantFormats string
+	}{
+		{name: "empty page", storageBody: `{"storage": {"value": "", "representation": "storage"}}`, wantFormats: "storage,atlas_doc_format"},
+		{name: "no body", storageBody: `{}`, wantFormats: "storage,atlas_doc_format,view"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v2Formats []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path != "/ex/confluence/test-cloud/wiki/api/v2/pages/42" {
+					fmt.Fprint(w, `{"id": "42"}`)
+					return
+				}
+				format := r.URL.Query().Get("body-format")
+				v2Formats = append(v2Formats, format)
+				if format == "storage" {
+					fmt.Fprintf(w, `{"id": "42", "body": %s}`, tt.storageBody)
+					return
+				}
+				fmt.Fprint(w, `{"id": "42", "body": {}}`)
+			}))
+			defer server.Close()
+
+			if _, err := NewConfluenceService(newTestClient(t, server)).GetPage(context.Background(), "42"); err != nil {
+				t.Fatalf("GetPage() error = %v", err)
+			}
+			if got := strings.Join(v2Formats, ","); got != tt.wantFormats {
+				t.Errorf("v2 body formats tried = %s, want %s", got, tt.wantFormats)
+			}
+		})
+	}
+}
+
+func TestGetPageFallsBackToV1(t *testing.T) {
+	var v2Formats []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/ex/confluence/test-cloud/wiki/api/v2/pages/42":
+			format := r.URL.Query().Get("body-format")
+			v2Formats = append(v2Formats, format)
+			fmt.Fprintf(w, `{"id": "42", "title": "Legacy", "body": {%q: {"value": "", "representation": %q}}}`, format, format)
+		case "/ex/confluence/test-cloud/wiki/rest/api/content/42":
+			if got := r.URL.Query().Get("expand"); got != "body.storage" {
+				t.Errorf("expand = %q, want body.storage", got)
+			}
+			fmt.Fprint(w, `{"id": "42", "title": "Legacy", "body": {"storage": {"value": "<p>Old content</p>", "representation": "storage"}}}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+
+	page, err := NewConfluenceService(client).GetPage(context.Background(), "42")
+	if err != nil {
+		t.Fatalf("GetPage() error = %v", err)
+	}
+	if page.Title != "Legacy" {
+		t.Errorf("Title = %q, want Legacy", page.Title)
+	}
+	if page.Body == nil || page.Body.Storage == nil || page.Body.Storage.Value != "<p>Old content</p>" {
+		t.Errorf("Body = %+v, want the v1 storage body", page.Body)
+	}
+	if strings.Join(v2Formats, ",") != "storage,atlas_doc_format" {
+		t.Errorf("v2 body formats tried = %v, want storage then atlas_doc_format", v2Formats)
+	}
+}
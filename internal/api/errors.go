@@ -0,0 +1,183 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError represents an error response from the API.
+//
+// Messages holds the human-readable errors extracted from the response body
+// (see parseAPIError). Body always keeps the raw response for debugging.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Body       string
+	Messages   []string
+}
+
+func (e *APIError) Error() string {
+	messages := e.Messages
+	if messages == nil {
+		messages = parseErrorMessages(e.Body)
+	}
+
+	switch {
+	case len(messages) == 1:
+		return fmt.Sprintf("API error: %s (status %d): %s", e.Status, e.StatusCode, messages[0])
+	case len(messages) > 1:
+		var b strings.Builder
+		fmt.Fprintf(&b, "API error: %s (status %d):", e.Status, e.StatusCode)
+		for _, msg := range messages {
+			fmt.Fprintf(&b, "\n  - %s", msg)
+		}
+		return b.String()
+	case isReadableBody(e.Body):
+		return fmt.Sprintf("API error: %s (status %d): %s", e.Status, e.StatusCode, strings.TrimSpace(e.Body))
+	default:
+		return fmt.Sprintf("API error: %s (status %d)", e.Status, e.StatusCode)
+	}
+}
+
+// AuthError is returned when there are no usable credentials: nobody is
+// logged in, or an expired token cannot be refreshed.
+type AuthError struct {
+	Err error
+}
+
+func (e *AuthError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// IsPermanentError reports whether repeating a request cannot succeed:
+// there are no usable credentials, the token lacks a scope, or the API
+// rejected the request itself (400, 401, 403, 404). Network errors and
+// server errors that outlast the client's retries are worth retrying later.
+func IsPermanentError(err error) bool {
+	var authErr *AuthError
+	var scopeErr *MissingScopeError
+	if errors.As(err, &authErr) || errors.As(err, &scopeErr) {
+		return true
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+			return true
+		}
+	}
+	return false
+}
+
+// parseAPIError builds an APIError from a non-2xx response, extracting
+// readable messages from the known Jira and Confluence error shapes.
+func parseAPIError(statusCode int, status string, body []byte) *APIError {
+	return &APIError{
+		StatusCode: statusCode,
+		Status:     status,
+		Body:       string(body),
+		Messages:   parseErrorMessages(string(body)),
+	}
+}
+
+// atlassianErrorBody covers the error shapes returned by Atlassian APIs:
+//
+//	Jira:          {"errorMessages": ["..."], "errors": {"field": "..."}}
+//	Confluence v2: {"errors": [{"title": "...", "detail": "..."}]}
+//	Gateway/v1:    {"message": "..."}
+type atlassianErrorBody struct {
+	ErrorMessages []string        `json:"errorMessages"`
+	Errors        json.RawMessage `json:"errors"`
+	Message       string          `json:"message"`
+}
+
+// confluenceError is a single entry in Confluence's "errors" array.
+type confluenceError struct {
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	Code   string `json:"code"`
+}
+
+// parseErrorMessages extracts human-readable messages from an error body.
+// Returns nil if the body is empty, not JSON, or has no recognized messages.
+func parseErrorMessages(body string) []string {
+	body = strings.TrimSpace(body)
+	if body == "" || body[0] != '{' {
+		return nil
+	}
+
+	var parsed atlassianErrorBody
+	if err := json.Unmarshal([]byte(body), &parsed); err != nil {
+		return nil
+	}
+
+	var messages []string
+	for _, msg := range parsed.ErrorMessages {
+		if msg != "" {
+			messages = append(messages, msg)
+		}
+	}
+
+	if len(parsed.Errors) > 0 {
+		// Jira: map of field name to message. Sorted for stable output.
+		var fieldErrors map[string]string
+		if err := json.Unmarshal(parsed.Errors, &fieldErrors); err == nil {
+			fields := make([]string, 0, len(fieldErrors))
+			for field := range fieldErrors {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+			for _, field := range fields {
+				messages = append(messages, fmt.Sprintf("%s: %s", field, fieldErrors[field]))
+			}
+		}
+
+		// Confluence: array of error objects.
+		var confluenceErrors []confluenceError
+		if err := json.Unmarshal(parsed.Errors, &confluenceErrors); err == nil {
+			for _, ce := range confluenceErrors {
+				msg := ce.Title
+				if msg == "" {
+					msg = ce.Code
+				}
+				if ce.Detail != "" && ce.Detail != msg {
+					if msg != "" {
+						msg += ": "
+					}
+					msg += ce.Detail
+				}
+				if msg != "" {
+					messages = append(messages, msg)
+				}
+			}
+		}
+	}
+
+	if len(messages) == 0 && parsed.Message != "" {
+		messages = append(messages, parsed.Message)
+	}
+
+	return messages
+}
+
+// isReadableBody reports whether a raw body is worth showing to the user.
+// Empty bodies and HTML error pages (from proxies/gateways) are not.
+func isReadableBody(body string) bool {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return false
+	}
+	lower := strings.ToLower(body)
+	if strings.HasPrefix(lower, "<!doctype") || strings.HasPrefix(lower, "<html") {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestParseAPIError tests extraction of readable messages from error bodies.
+func TestParseAPIError(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       string
+		statusCode   int
+		body         string
+		wantMessages []string
+		wantContains []string
+		wantAbsent   []string
+	}{
+		{
+			name:       "jira errorMessages and field errors",
+			status:     "400 Bad Request",
+			statusCode: 400,
+			body:       `{"errorMessages":["Issue does not exist"],"errors":{"summary":"Summary is required","assignee":"User cannot be assigned"}}`,
+			wantMessages: []string{
+				"Issue does not exist",
+				"assignee: User cannot be assigned",
+				"summary: Summary is required",
+			},
+			wantContains: []string{"status 400", "\n  - Issue does not exist", "\n  - summary: Summary is required"},
+			wantAbsent:   []string{"errorMessages", "{"},
+		},
+		{
+			name:         "jira single message",
+			status:       "404 Not Found",
+			statusCode:   404,
+			body:         `{"errorMessages":["Issue does not exist or you do not have permission to see it."],"errors":{}}`,
+			wantMessages: []string{"Issue does not exist or you do not have permission to see it."},
+			wantContains: []string{"API error: 404 Not Found (status 404): Issue does not exist"},
+		},
+		{
+			name:         "confluence errors array",
+			status:       "404 Not Found",
+			statusCode:   404,
+			body:         `{"errors":[{"status":404,"code":"NOT_FOUND","title":"Not Found","detail":"Page 123 could not be found"}]}`,
+			wantMessages: []string{"Not Found: Page 123 could not be found"},
+			wantContains: []string{"Not Found: Page 123 could not be found"},
+			wantAbsent:   []string{"NOT_FOUND"},
+		},
+		{
+			name:         "gateway message",
+			status:       "401 Unauthorized",
+			statusCode:   401,
+			body:         `{"code":401,"message":"Unauthorized; scope does not match"}`,
+			wantMessages: []string{"Unauthorized; scope does not match"},
+		},
+		{
+			name:         "html body fallback",
+			status:       "502 Bad Gateway",
+			statusCode:   502,
+			body:         "<!DOCTYPE html><html><body>Bad Gateway</body></html>",
+			wantContains: []string{"API error: 502 Bad Gateway (status 502)"},
+			wantAbsent:   []string{"<html>", "DOCTYPE"},
+		},
+		{
+			name:         "empty body fallback",
+			status:       "503 Service Unavailable",
+			statusCode:   503,
+			body:         "",
+			wantContains: []string{"API error: 503 Service Unavailable (status 503)"},
+		},
+		{
+			name:         "unrecognized json shown raw",
+			status:       "500 Internal Server Error",
+			statusCode:   500,
+			body:         `{"foo":"bar"}`,
+			wantContains: []string{`{"foo":"bar"}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := parseAPIError(tt.statusCode, tt.status, []byte(tt.body))
+
+			if apiErr.Body != tt.body {
+				t.Errorf("Body = %q, want raw body %q", apiErr.Body, tt.body)
+			}
+
+			if len(apiErr.Messages) != len(tt.wantMessages) {
+				t.Fatalf("Messages = %q, want %q", apiErr.Messages, tt.wantMessages)
+			}
+			for i, want := range tt.wantMessages {
+				if apiErr.Messages[i] != want {
+					t.Errorf("Messages[%d] = %q, want %q", i, apiErr.Messages[i], want)
+				}
+			}
+
+			errStr := apiErr.Error()
+			for _, want := range tt.wantContains {
+				if !strings.Contains(errStr, want) {
+					t.Errorf("Error() = %q, want it to contain %q", errStr, want)
+				}
+			}
+			for _, absent := range tt.wantAbsent {
+				if strings.Contains(errStr, absent) {
+					t.Errorf("Error() = %q, should not contain %q", errStr, absent)
+				}
+			}
+		})
+	}
+}
+
+func TestIsPermanentError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"bad JQL", &APIError{StatusCode: 400}, true},
+		{"unauthorized", fmt.Errorf("failed to search: %w", &APIError{StatusCode: 401}), true},
+		{"forbidden", &APIError{StatusCode: 403}, true},
+		{"not found", &APIError{StatusCode: 404}, true},
+		{"not logged in", &AuthError{Err: errors.New("not authenticated")}, true},
+		{"missing scope", &MissingScopeError{Operation: OpJiraWrite, Scope: "write:jira-work"}, true},
+		{"rate limited", &APIError{StatusCode: 429}, false},
+		{"server error", &APIError{StatusCode: 503}, false},
+		{"network", errors.New("dial tcp: connection refused"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPermanentError(tt.err); got != tt.want {
+				t.Errorf("IsPermanentError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var issueKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[0-9]+$`)
+
+// NormalizeIssueKey returns the issue key for a bare key (PROJ-123), a numeric
+// issue ID, or an issue URL such as https://example.atlassian.net/browse/PROJ-123
+// or a board URL with ?selectedIssue=PROJ-123. Bare keys and IDs are returned
+// unchanged.
+func NormalizeIssueKey(input string) (string, error) {
+	ref := strings.TrimSpace(input)
+	if ref == "" {
+		return "", fmt.Errorf("issue key is empty")
+	}
+	if issueKeyPattern.MatchString(ref) || isPageID(ref) {
+		return ref, nil
+	}
+
+	u, err := url.Parse(ref)
+	if err == nil && u.Host != "" {
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		for i, seg := range segments {
+			if seg == "browse" && i+1 < len(segments) && issueKeyPattern.MatchString(segments[i+1]) {
+				return segments[i+1], nil
+			}
+		}
+		if key := u.Query().Get("selectedIssue"); issueKeyPattern.MatchString(key) {
+			return key, nil
+		}
+		return "", fmt.Errorf("no issue key found in %q: expected a URL like https://example.atlassian.net/browse/PROJ-123", input)
+	}
+
+	return "", fmt.Errorf("invalid issue key %q: expected a key like PROJ-123 or an issue URL", input)
+}
@@ -0,0 +1,37 @@
+package api
+
+import "testing"
+
+func TestNormalizeIssueKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "bare key", input: "PROJ-123", want: "PROJ-123"},
+		{name: "bare key kept as typed", input: "proj-123", want: "proj-123"},
+		{name: "key with digits and underscore", input: "AB_2-7", want: "AB_2-7"},
+		{name: "numeric issue ID", input: "10001", want: "10001"},
+		{name: "browse URL", input: "https://acme.atlassian.net/browse/PROJ-123", want: "PROJ-123"},
+		{name: "browse URL with query string", input: "https://acme.atlassian.net/browse/PROJ-123?focusedCommentId=10500&page=1#comment-10500", want: "PROJ-123"},
+		{name: "browse URL with trailing slash", input: "https://acme.atlassian.net/browse/PROJ-123/", want: "PROJ-123"},
+		{name: "board URL with selected issue", input: "https://acme.atlassian.net/jira/software/projects/PROJ/boards/1?selectedIssue=PROJ-42", want: "PROJ-42"},
+		{name: "empty", input: " ", wantErr: true},
+		{name: "words", input: "fix the login bug", wantErr: true},
+		{name: "missing number", input: "PROJ-", wantErr: true},
+		{name: "URL without key", input: "https://acme.atlassian.net/jira/your-work", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeIssueKey(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeIssueKey(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeIssueKey(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,8 +3,13 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -13,8 +18,9 @@ import (
 
 // JiraService handles Jira API operations.
 type JiraService struct {
-	client      *Client
-	fieldsCache []*Field
+	client          *Client
+	fieldsCache     []*Field
+	issueTypesCache map[string][]*ProjectIssueType // By project key
 }
 
 // NewJiraService creates a new Jira service.
@@ -28,26 +34,56 @@ type Issue struct {
 	Key    string      `json:"key"`
 	Self   string      `json:"self"`
 	Fields IssueFields `json:"fields"`
+
+	// RenderedFields holds the HTML of rich text fields (e.g. description),
+	// keyed by field ID. GetIssue always requests it; see RenderedField.
+	RenderedFields map[string]json.RawMessage `json:"renderedFields,omitempty"`
+
+	// Only populated when requested via GetIssueOptions.Expand.
+	Transitions []*Transition   `json:"transitions,omitempty"`
+	Changelog   *IssueChangelog `json:"changelog,omitempty"`
+}
+
+// RenderedField returns the rendered HTML of a field, or "" if the field is
+// empty or has no HTML rendering.
+func (i *Issue) RenderedField(id string) string {
+	var html string
+	if err := json.Unmarshal(i.RenderedFields[id], &html); err != nil {
+		return ""
+	}
+	return html
+}
+
+// IssueChangelog is the changelog embedded in an issue with expand=changelog.
+type IssueChangelog struct {
+	StartAt    int               `json:"startAt"`
+	MaxResults int               `json:"maxResults"`
+	Total      int               `json:"total"`
+	Histories  []*ChangelogEntry `json:"histories"`
 }
 
 // IssueFields contains the fields of a Jira issue.
 type IssueFields struct {
-	Summary     string        `json:"summary"`
-	Description *ADF          `json:"description,omitempty"`
-	Status      *Status       `json:"status,omitempty"`
-	Priority    *Priority     `json:"priority,omitempty"`
-	IssueType   *IssueType    `json:"issuetype,omitempty"`
-	Assignee    *User         `json:"assignee,omitempty"`
-	Reporter    *User         `json:"reporter,omitempty"`
-	Project     *Project      `json:"project,omitempty"`
-	Labels      []string      `json:"labels,omitempty"`
-	Created     string        `json:"created,omitempty"`
-	Updated     string        `json:"updated,omitempty"`
-	Resolution  *Resolution   `json:"resolution,omitempty"`
-	Components  []*Component  `json:"components,omitempty"`
-	Comment     *Comments     `json:"comment,omitempty"`
-	Parent      *Issue        `json:"parent,omitempty"`
-	Attachment  []*Attachment `json:"attachment,omitempty"`
+	Summary      string         `json:"summary"`
+	Description  *ADF           `json:"description,omitempty"`
+	Status       *Status        `json:"status,omitempty"`
+	Priority     *Priority      `json:"priority,omitempty"`
+	IssueType    *IssueType     `json:"issuetype,omitempty"`
+	Assignee     *User          `json:"assignee,omitempty"`
+	Reporter     *User          `json:"reporter,omitempty"`
+	Project      *Project       `json:"project,omitempty"`
+	Labels       []string       `json:"labels,omitempty"`
+	Created      string         `json:"created,omitempty"`
+	Updated      string         `json:"updated,omitempty"`
+	Resolution   *Resolution    `json:"resolution,omitempty"`
+	Components   []*Component   `json:"components,omitempty"`
+	Comment      *Comments      `json:"comment,omitempty"`
+	Parent       *Issue         `json:"parent,omitempty"`
+	Attachment   []*Attachment  `json:"attachment,omitempty"`
+	Votes        *Votes         `json:"votes,omitempty"`
+	DueDate      string         `json:"duedate,omitempty"`
+	Security     *SecurityLevel `json:"security,omitempty"`
+	TimeTracking *TimeTracking  `json:"timetracking,omitempty"`
 
 	// Extra holds custom field values not captured by the typed fields above.
 	// Keys are field IDs like "customfield_10413", values are raw JSON.
@@ -82,7 +118,8 @@ func (f *IssueFields) UnmarshalJSON(data []byte) error {
 
 // FormatCustomFieldValue extracts a human-readable string from a raw JSON
 // custom field value. Handles common Jira field value shapes:
-// select/radio, user, ADF, arrays, strings, numbers, null.
+// select/radio, user, named objects (sprints, versions), ADF, arrays,
+// strings, numbers, null.
 func FormatCustomFieldValue(raw json.RawMessage) string {
 	if len(raw) == 0 || string(raw) == "null" {
 		return ""
@@ -104,6 +141,14 @@ func FormatCustomFieldValue(raw json.RawMessage) string {
 		return userField.DisplayName
 	}
 
+	// Try as object with "name" key (sprints, versions, components).
+	var namedField struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(raw, &namedField); err == nil && namedField.Name != "" {
+		return namedField.Name
+	}
+
 	// Try as ADF document.
 	var adfField struct {
 		Type    string `json:"type"`
@@ -263,6 +308,14 @@ type Resolution struct {
 	Description string `json:"description,omitempty"`
 }
 
+// SecurityLevel represents an issue security level, which restricts who can
+// see an issue.
+type SecurityLevel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
 // Component represents a project component.
 type Component struct {
 	ID   string `json:"id"`
@@ -291,6 +344,10 @@ type Transition struct {
 	ID   string  `json:"id"`
 	Name string  `json:"name"`
 	To   *Status `json:"to,omitempty"`
+
+	// Fields on the transition screen, keyed by field ID. Only populated by
+	// GetTransitionsWithFields.
+	Fields map[string]*FieldMeta `json:"fields,omitempty"`
 }
 
 // SearchResult represents the result of a JQL search.
@@ -304,18 +361,75 @@ type SearchResult struct {
 	IsLast        bool     `json:"isLast"`        // True if this is the last page
 }
 
+// NextPage returns the options for fetching the page after this one, or false
+// when there are no more results.
+//
+// Cloud instances paginate with nextPageToken. Some instances (and proxies in
+// front of them) still answer in the older offset style: no token, but a full
+// page and a Total larger than what has been fetched so far. In that case the
+// next page is requested with startAt instead of stopping early.
+func (r *SearchResult) NextPage(opts SearchOptions) (SearchOptions, bool) {
+	if r.IsLast || len(r.Issues) == 0 {
+		return opts, false
+	}
+
+	if r.NextPageToken != "" {
+		opts.NextPageToken = r.NextPageToken
+		opts.StartAt = 0
+		return opts, true
+	}
+
+	pageSize := r.MaxResults
+	if pageSize == 0 {
+		pageSize = opts.MaxResults
+	}
+	fetched := opts.StartAt + len(r.Issues)
+	if len(r.Issues) < pageSize || r.Total <= fetched {
+		return opts, false
+	}
+
+	opts.NextPageToken = ""
+	opts.StartAt = fetched
+	return opts, true
+}
+
 // TransitionsResponse represents available transitions for an issue.
 type TransitionsResponse struct {
 	Transitions []*Transition `json:"transitions"`
 }
 
-// GetIssue fetches a single issue by key.
+// GetIssueOptions controls which parts of an issue are fetched.
+type GetIssueOptions struct {
+	Fields []string // Field IDs to return; empty means all fields
+	Expand []string // Expands in addition to renderedFields (e.g. changelog, transitions)
+}
+
+// GetIssue fetches a single issue by key with all fields.
 func (s *JiraService) GetIssue(ctx context.Context, key string) (*Issue, error) {
+	return s.GetIssueWithOptions(ctx, key, GetIssueOptions{})
+}
+
+// GetIssueWithOptions fetches a single issue by key.
+// Requesting only the fields you need is noticeably faster on issues with
+// many custom fields.
+func (s *JiraService) GetIssueWithOptions(ctx context.Context, key string, opts GetIssueOptions) (*Issue, error) {
 	path := fmt.Sprintf("%s/issue/%s", s.client.JiraBaseURL(), key)
 
+	expand := []string{"renderedFields"}
+	for _, e := range opts.Expand {
+		if !slices.Contains(expand, e) {
+			expand = append(expand, e)
+		}
+	}
+
+	fields := "*all"
+	if len(opts.Fields) > 0 {
+		fields = strings.Join(opts.Fields, ",")
+	}
+
 	params := url.Values{}
-	params.Set("expand", "renderedFields")
-	params.Set("fields", "*all")
+	params.Set("expand", strings.Join(expand, ","))
+	params.Set("fields", fields)
 
 	var issue Issue
 	if err := s.client.Get(ctx, path+"?"+params.Encode(), &issue); err != nil {
@@ -344,13 +458,53 @@ func (s *JiraService) DownloadAttachment(ctx context.Context, attachmentID strin
 	return s.client.GetRaw(ctx, path)
 }
 
+// ErrNoThumbnail is returned when Jira has no thumbnail for an attachment,
+// e.g. because it is not an image.
+var ErrNoThumbnail = errors.New("no thumbnail available")
+
+// DownloadAttachmentThumbnail downloads the thumbnail of an image attachment.
+// Returns ErrNoThumbnail if Jira has none.
+func (s *JiraService) DownloadAttachmentThumbnail(ctx context.Context, attachmentID string) ([]byte, string, error) {
+	// redirect=false returns the image directly instead of redirecting to the
+	// media service, which would drop the Authorization header.
+	path := fmt.Sprintf("%s/attachment/thumbnail/%s?redirect=false", s.client.JiraBaseURL(), attachmentID)
+
+	content, contentType, err := s.client.GetRaw(ctx, path)
+	if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == 404 {
+		return nil, "", fmt.Errorf("%w: attachment %s", ErrNoThumbnail, attachmentID)
+	}
+	return content, contentType, err
+}
+
 // UploadAttachment uploads a file as an attachment to an issue.
 // Returns the list of created attachments (Jira returns an array).
 func (s *JiraService) UploadAttachment(ctx context.Context, issueKey, filePath string) ([]*Attachment, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	var size int64 = -1
+	if info, err := f.Stat(); err == nil {
+		size = info.Size()
+	}
+
+	return s.UploadAttachmentReader(ctx, issueKey, filepath.Base(filePath), f, size)
+}
+
+// UploadAttachmentReader uploads the content of r as an attachment named
+// filename. size is the content length if known, or -1; it is only used for
+// logging.
+func (s *JiraService) UploadAttachmentReader(ctx context.Context, issueKey, filename string, r io.Reader, size int64) ([]*Attachment, error) {
 	path := fmt.Sprintf("%s/issue/%s/attachments", s.client.JiraBaseURL(), issueKey)
 
+	if size >= 0 {
+		debugLog("Uploading %s (%d bytes) to %s", filename, size, issueKey)
+	}
+
 	var attachments []*Attachment
-	if err := s.client.PostMultipart(ctx, path, "file", filePath, &attachments); err != nil {
+	if err := s.client.PostMultipartReader(ctx, path, "file", filename, r, &attachments); err != nil {
 		return nil, err
 	}
 
@@ -363,6 +517,7 @@ type SearchOptions struct {
 	MaxResults    int
 	Fields        []string
 	NextPageToken string // Token for pagination (replaces startAt)
+	StartAt       int    // Offset fallback for instances that don't return a token
 }
 
 // Search searches for issues using JQL.
@@ -378,10 +533,13 @@ func (s *JiraService) Search(ctx context.Context, opts SearchOptions) (*SearchRe
 	if opts.NextPageToken != "" {
 		params.Set("nextPageToken", opts.NextPageToken)
 	}
+	if opts.StartAt > 0 {
+		params.Set("startAt", strconv.Itoa(opts.StartAt))
+	}
 	if len(opts.Fields) > 0 {
 		params.Set("fields", strings.Join(opts.Fields, ","))
 	} else {
-		params.Set("fields", "summary,status,priority,issuetype,assignee,reporter,created,updated,labels,project")
+		params.Set("fields", "summary,status,priority,issuetype,assignee,reporter,created,updated,labels,project,duedate")
 	}
 
 	var result SearchResult
@@ -392,6 +550,120 @@ func (s *JiraService) Search(ctx context.Context, opts SearchOptions) (*SearchRe
 	return &result, nil
 }
 
+// Limits for one GetIssues request. The JQL travels in the query string, so
+// long key lists are split to keep URLs well under proxy limits.
+const (
+	issueBatchMaxKeys = 100
+	issueBatchMaxJQL  = 2000
+)
+
+// GetIssues fetches many issues with "key in (...)" searches instead of one
+// request per key. Keys are split into chunks that respect the JQL length
+// limit. Issues are returned in the order of keys; with no fields, the search
+// defaults are fetched. Issues that do not exist are left out.
+func (s *JiraService) GetIssues(ctx context.Context, keys []string, fields []string) ([]*Issue, error) {
+	byKey, err := s.GetIssuesByKey(ctx, keys, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]*Issue, 0, len(keys))
+	seen := make(map[*Issue]bool, len(keys))
+	for _, key := range keys {
+		issue := byKey[strings.ToUpper(key)]
+		if issue == nil || seen[issue] {
+			continue
+		}
+		seen[issue] = true
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+// GetIssuesByKey is GetIssues returning the issues by the requested key or
+// ID, uppercased. Search results carry the issue's current key, so a moved
+// or renamed issue (OLD-1, now NEW-7) does not match its requested key; such
+// keys are fetched one by one, which follows the move. Keys of issues that
+// do not exist are missing from the map.
+func (s *JiraService) GetIssuesByKey(ctx context.Context, keys []string, fields []string) (map[string]*Issue, error) {
+	found := make(map[string]*Issue, len(keys)*2)
+	for _, chunk := range chunkIssueKeys(keys, issueBatchMaxKeys, issueBatchMaxJQL) {
+		opts := SearchOptions{
+			JQL:        issueKeysJQL(chunk),
+			MaxResults: len(chunk),
+			Fields:     fields,
+		}
+		for {
+			result, err := s.Search(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, issue := range result.Issues {
+				found[strings.ToUpper(issue.Key)] = issue
+				found[issue.ID] = issue
+			}
+			next, more := result.NextPage(opts)
+			if !more {
+				break
+			}
+			opts = next
+		}
+	}
+
+	byKey := make(map[string]*Issue, len(keys))
+	fetched := make(map[string]bool)
+	for _, key := range keys {
+		key = strings.ToUpper(key)
+		if issue := found[key]; issue != nil {
+			byKey[key] = issue
+			continue
+		}
+		if fetched[key] {
+			continue
+		}
+		fetched[key] = true
+		issue, err := s.GetIssueWithOptions(ctx, key, GetIssueOptions{Fields: fields})
+		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == 404 {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		byKey[key] = issue
+	}
+	return byKey, nil
+}
+
+func issueKeysJQL(keys []string) string {
+	return "key in (" + strings.Join(keys, ", ") + ")"
+}
+
+// chunkIssueKeys splits keys into groups of at most maxKeys whose
+// "key in (...)" JQL stays within maxJQL characters.
+func chunkIssueKeys(keys []string, maxKeys, maxJQL int) [][]string {
+	var chunks [][]string
+	var chunk []string
+	length := len(issueKeysJQL(nil))
+	for _, key := range keys {
+		added := len(key)
+		if len(chunk) > 0 {
+			added += len(", ")
+		}
+		if len(chunk) > 0 && (len(chunk) == maxKeys || length+added > maxJQL) {
+			chunks = append(chunks, chunk)
+			chunk = nil
+			length = len(issueKeysJQL(nil))
+			added = len(key)
+		}
+		chunk = append(chunk, key)
+		length += added
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
 // CreateIssueRequest represents a request to create an issue.
 type CreateIssueRequest struct {
 	Fields CreateIssueFields `json:"fields"`
@@ -404,10 +676,13 @@ type CreateIssueFields struct {
 	Description  *ADF                   `json:"description,omitempty"`
 	IssueType    *IssueTypeID           `json:"issuetype"`
 	Assignee     *AccountID             `json:"assignee,omitempty"`
+	Reporter     *AccountID             `json:"reporter,omitempty"`
 	Priority     *PriorityID            `json:"priority,omitempty"`
 	Labels       []string               `json:"labels,omitempty"`
 	Parent       *ParentID              `json:"parent,omitempty"`
-	CustomFields map[string]interface{} `json:"-"` // Merged during marshaling
+	Security     *SecurityLevelID       `json:"security,omitempty"`
+	DueDate      string                 `json:"duedate,omitempty"` // YYYY-MM-DD
+	CustomFields map[string]interface{} `json:"-"`                 // Merged during marshaling
 }
 
 // MarshalJSON implements custom JSON marshaling to include custom fields.
@@ -425,6 +700,9 @@ func (r *CreateIssueRequest) MarshalJSON() ([]byte, error) {
 	if r.Fields.Assignee != nil {
 		fields["assignee"] = r.Fields.Assignee
 	}
+	if r.Fields.Reporter != nil {
+		fields["reporter"] = r.Fields.Reporter
+	}
 	if r.Fields.Priority != nil {
 		fields["priority"] = r.Fields.Priority
 	}
@@ -434,6 +712,12 @@ func (r *CreateIssueRequest) MarshalJSON() ([]byte, error) {
 	if r.Fields.Parent != nil {
 		fields["parent"] = r.Fields.Parent
 	}
+	if r.Fields.Security != nil {
+		fields["security"] = r.Fields.Security
+	}
+	if r.Fields.DueDate != "" {
+		fields["duedate"] = r.Fields.DueDate
+	}
 
 	// Merge custom fields
 	for k, v := range r.Fields.CustomFields {
@@ -455,7 +739,7 @@ type IssueTypeID struct {
 	Name string `json:"name"`
 }
 
-// AccountID is used when setting assignee.
+// AccountID is used when setting assignee or reporter.
 type AccountID struct {
 	AccountID string `json:"accountId"`
 }
@@ -470,6 +754,11 @@ type ParentID struct {
 	Key string `json:"key"`
 }
 
+// SecurityLevelID is used when setting an issue's security level.
+type SecurityLevelID struct {
+	ID string `json:"id"`
+}
+
 // CreateIssueResponse represents the response from creating an issue.
 type CreateIssueResponse struct {
 	ID   string `json:"id"`
@@ -479,6 +768,10 @@ type CreateIssueResponse struct {
 
 // CreateIssue creates a new issue.
 func (s *JiraService) CreateIssue(ctx context.Context, req *CreateIssueRequest) (*CreateIssueResponse, error) {
+	if err := s.client.RequireScopes(OpJiraWrite); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("%s/issue", s.client.JiraBaseURL())
 
 	var result CreateIssueResponse
@@ -503,8 +796,14 @@ type ProjectIssueTypesResponse struct {
 	IssueTypes []*ProjectIssueType `json:"issueTypes"`
 }
 
-// GetProjectIssueTypes gets the available issue types for a project.
+// GetProjectIssueTypes gets the available issue types for a project. The
+// result is cached per project for the life of the service.
 func (s *JiraService) GetProjectIssueTypes(ctx context.Context, projectKey string) ([]*ProjectIssueType, error) {
+	cacheKey := strings.ToUpper(projectKey)
+	if types, ok := s.issueTypesCache[cacheKey]; ok {
+		return types, nil
+	}
+
 	path := fmt.Sprintf("%s/issue/createmeta/%s/issuetypes", s.client.JiraBaseURL(), projectKey)
 
 	var result ProjectIssueTypesResponse
@@ -512,6 +811,10 @@ func (s *JiraService) GetProjectIssueTypes(ctx context.Context, projectKey strin
 		return nil, err
 	}
 
+	if s.issueTypesCache == nil {
+		s.issueTypesCache = make(map[string][]*ProjectIssueType)
+	}
+	s.issueTypesCache[cacheKey] = result.IssueTypes
 	return result.IssueTypes, nil
 }
 
@@ -590,6 +893,62 @@ func (s *JiraService) GetPriorities(ctx context.Context) ([]*Priority, error) {
 	return result, nil
 }
 
+// LabelsResponse is one page of the /label endpoint.
+type LabelsResponse struct {
+	Values     []string `json:"values"`
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	Total      int      `json:"total"`
+	IsLast     bool     `json:"isLast"`
+}
+
+// GetLabels gets the labels used in the Jira instance. With a query, only
+// labels starting with it (case-insensitive) are returned. Jira has no server
+// side filter for this endpoint, so all pages are fetched.
+func (s *JiraService) GetLabels(ctx context.Context, query string) ([]string, error) {
+	path := fmt.Sprintf("%s/label", s.client.JiraBaseURL())
+	prefix := strings.ToLower(query)
+
+	var labels []string
+	startAt := 0
+	for {
+		params := url.Values{}
+		params.Set("startAt", strconv.Itoa(startAt))
+		params.Set("maxResults", "1000")
+
+		var result LabelsResponse
+		if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
+			return nil, err
+		}
+
+		for _, label := range result.Values {
+			if strings.HasPrefix(strings.ToLower(label), prefix) {
+				labels = append(labels, label)
+			}
+		}
+
+		startAt += len(result.Values)
+		if result.IsLast || len(result.Values) == 0 || (result.Total > 0 && startAt >= result.Total) {
+			return labels, nil
+		}
+	}
+}
+
+// GetSecurityLevels gets the security levels the current user can set on
+// issues in a project. Projects without an issue security scheme return none.
+func (s *JiraService) GetSecurityLevels(ctx context.Context, projectKey string) ([]*SecurityLevel, error) {
+	path := fmt.Sprintf("%s/project/%s/securitylevel", s.client.JiraBaseURL(), url.PathEscape(projectKey))
+
+	var result struct {
+		Levels []*SecurityLevel `json:"levels"`
+	}
+	if err := s.client.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Levels, nil
+}
+
 // UpdateIssueRequest represents a request to update an issue.
 type UpdateIssueRequest struct {
 	Fields map[string]interface{} `json:"fields,omitempty"`
@@ -603,9 +962,34 @@ type UpdateOp struct {
 	Set    interface{} `json:"set,omitempty"`
 }
 
+// NotifyOptions controls whether Jira emails watchers about a change.
+type NotifyOptions struct {
+	// Notify sends notifications (Jira's default). Disabling it requires
+	// project admin permission; Jira responds with 403 otherwise.
+	Notify bool
+}
+
+// query returns the query string suffix for the options.
+func (o NotifyOptions) query() string {
+	if o.Notify {
+		return ""
+	}
+	return "?notifyUsers=false"
+}
+
 // UpdateIssue updates an existing issue.
 func (s *JiraService) UpdateIssue(ctx context.Context, key string, req *UpdateIssueRequest) error {
-	path := fmt.Sprintf("%s/issue/%s", s.client.JiraBaseURL(), key)
+	return s.UpdateIssueWithOptions(ctx, key, req, NotifyOptions{Notify: true})
+}
+
+// UpdateIssueWithOptions updates an existing issue, optionally without
+// notifying watchers.
+func (s *JiraService) UpdateIssueWithOptions(ctx context.Context, key string, req *UpdateIssueRequest, opts NotifyOptions) error {
+	if err := s.client.RequireScopes(OpJiraWrite); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("%s/issue/%s%s", s.client.JiraBaseURL(), key, opts.query())
 	return s.client.Put(ctx, path, req, nil)
 }
 
@@ -621,6 +1005,19 @@ func (s *JiraService) GetTransitions(ctx context.Context, key string) ([]*Transi
 	return result.Transitions, nil
 }
 
+// GetTransitionsWithFields gets available transitions for an issue along with
+// the fields each transition's screen accepts.
+func (s *JiraService) GetTransitionsWithFields(ctx context.Context, key string) ([]*Transition, error) {
+	path := fmt.Sprintf("%s/issue/%s/transitions?expand=transitions.fields", s.client.JiraBaseURL(), key)
+
+	var result TransitionsResponse
+	if err := s.client.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Transitions, nil
+}
+
 // TransitionRequest represents a request to transition an issue.
 type TransitionRequest struct {
 	Transition TransitionID           `json:"transition"`
@@ -633,8 +1030,13 @@ type TransitionID struct {
 }
 
 // TransitionIssue transitions an issue to a new status.
-// Optional fields can be set during the transition.
+// Optional fields can be set during the transition. Jira has no notifyUsers
+// option for transitions, so watchers are always notified.
 func (s *JiraService) TransitionIssue(ctx context.Context, key string, transitionID string, fields map[string]interface{}) error {
+	if err := s.client.RequireScopes(OpJiraWrite); err != nil {
+		return err
+	}
+
 	path := fmt.Sprintf("%s/issue/%s/transitions", s.client.JiraBaseURL(), key)
 	req := &TransitionRequest{
 		Transition: TransitionID{ID: transitionID},
@@ -661,6 +1063,22 @@ type CommentOptions struct {
 	Body           string
 	VisibilityType string // "role" or "group"
 	VisibilityName string // role name or group name
+	Format         string // Body format: FormatMarkdown (default) or FormatWiki
+	// Prefix is ADF placed before the converted Body, e.g. a quote of the
+	// comment being replied to. It is sent as-is, whatever the Format.
+	Prefix []ADFContent
+}
+
+// commentBody converts the comment text to ADF and puts any Prefix first.
+func commentBody(opts *CommentOptions) (*ADF, error) {
+	body, err := BodyToADF(opts.Body, opts.Format)
+	if err != nil {
+		return nil, err
+	}
+	if len(opts.Prefix) > 0 {
+		body.Content = append(append([]ADFContent{}, opts.Prefix...), body.Content...)
+	}
+	return body, nil
 }
 
 // AddComment adds a comment to an issue.
@@ -670,10 +1088,19 @@ func (s *JiraService) AddComment(ctx context.Context, key string, body string) (
 
 // AddCommentWithOptions adds a comment with optional visibility restrictions.
 func (s *JiraService) AddCommentWithOptions(ctx context.Context, key string, opts *CommentOptions) (*Comment, error) {
+	if err := s.client.RequireScopes(OpJiraCommentWrite); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("%s/issue/%s/comment", s.client.JiraBaseURL(), key)
 
+	body, err := commentBody(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &AddCommentRequest{
-		Body: TextToADF(opts.Body),
+		Body: body,
 	}
 
 	if opts.VisibilityType != "" && opts.VisibilityName != "" {
@@ -715,12 +1142,42 @@ func (s *JiraService) GetComments(ctx context.Context, key string) ([]*Comment,
 	return result.Comments, nil
 }
 
+// GetCommentsAll gets all comments for an issue by following pagination.
+// GetComments only returns the first page (Jira's default page size).
+func (s *JiraService) GetCommentsAll(ctx context.Context, key string) ([]*Comment, error) {
+	path := fmt.Sprintf("%s/issue/%s/comment", s.client.JiraBaseURL(), key)
+
+	var allComments []*Comment
+	for {
+		params := url.Values{}
+		params.Set("startAt", strconv.Itoa(len(allComments)))
+		params.Set("maxResults", "100")
+
+		var result Comments
+		if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
+			return nil, err
+		}
+		allComments = append(allComments, result.Comments...)
+
+		if len(result.Comments) == 0 || len(allComments) >= result.Total {
+			break
+		}
+	}
+
+	return allComments, nil
+}
+
 // UpdateComment updates an existing comment.
 func (s *JiraService) UpdateComment(ctx context.Context, key string, commentID string, opts *CommentOptions) (*Comment, error) {
 	path := fmt.Sprintf("%s/issue/%s/comment/%s", s.client.JiraBaseURL(), key, commentID)
 
+	body, err := commentBody(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &AddCommentRequest{
-		Body: TextToADF(opts.Body),
+		Body: body,
 	}
 
 	if opts.VisibilityType != "" && opts.VisibilityName != "" {
@@ -897,26 +1354,82 @@ type CreateRemoteLinkRequest struct {
 	Object       *RemoteLinkObject `json:"object"`
 }
 
-// CreateRemoteLink creates a remote/web link on an issue.
-func (s *JiraService) CreateRemoteLink(ctx context.Context, issueKey, url, title, summary string) (*RemoteLink, error) {
-	path := fmt.Sprintf("%s/issue/%s/remotelink", s.client.JiraBaseURL(), issueKey)
+// RemoteLinkOptions contains options for creating or updating remote links.
+type RemoteLinkOptions struct {
+	URL          string
+	Title        string
+	Summary      string
+	Relationship string         // e.g. "causes", "mentioned in"
+	GlobalID     string         // Creating a link with an existing globalId updates it
+	Application  *RemoteLinkApp // e.g. com.atlassian.confluence, so Jira renders the link for that app
+	Icon         *RemoteLinkIcon
+}
 
-	req := &CreateRemoteLinkRequest{
+// request builds the API request body from the options.
+func (o *RemoteLinkOptions) request() *CreateRemoteLinkRequest {
+	return &CreateRemoteLinkRequest{
+		GlobalID:     o.GlobalID,
+		Application:  o.Application,
+		Relationship: o.Relationship,
 		Object: &RemoteLinkObject{
-			URL:     url,
-			Title:   title,
-			Summary: summary,
+			URL:     o.URL,
+			Title:   o.Title,
+			Summary: o.Summary,
+			Icon:    o.Icon,
 		},
 	}
+}
+
+// CreateRemoteLink creates a remote/web link on an issue.
+func (s *JiraService) CreateRemoteLink(ctx context.Context, issueKey, url, title, summary string) (*RemoteLink, error) {
+	return s.CreateRemoteLinkWithOptions(ctx, issueKey, &RemoteLinkOptions{
+		URL:     url,
+		Title:   title,
+		Summary: summary,
+	})
+}
+
+// CreateRemoteLinkWithOptions creates a remote link with optional relationship and globalId.
+// If a link with the same globalId already exists on the issue, Jira updates it instead.
+func (s *JiraService) CreateRemoteLinkWithOptions(ctx context.Context, issueKey string, opts *RemoteLinkOptions) (*RemoteLink, error) {
+	path := fmt.Sprintf("%s/issue/%s/remotelink", s.client.JiraBaseURL(), issueKey)
 
 	var result RemoteLink
-	if err := s.client.Post(ctx, path, req, &result); err != nil {
+	if err := s.client.Post(ctx, path, opts.request(), &result); err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
 
+// GetRemoteLink gets a single remote link by ID.
+func (s *JiraService) GetRemoteLink(ctx context.Context, issueKey string, linkID int) (*RemoteLink, error) {
+	path := fmt.Sprintf("%s/issue/%s/remotelink/%d", s.client.JiraBaseURL(), issueKey, linkID)
+
+	var link RemoteLink
+	if err := s.client.Get(ctx, path, &link); err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// UpdateRemoteLink replaces the URL, title, and summary of a remote link.
+func (s *JiraService) UpdateRemoteLink(ctx context.Context, issueKey string, linkID int, url, title, summary string) error {
+	return s.UpdateRemoteLinkWithOptions(ctx, issueKey, linkID, &RemoteLinkOptions{
+		URL:     url,
+		Title:   title,
+		Summary: summary,
+	})
+}
+
+// UpdateRemoteLinkWithOptions replaces a remote link. Fields left empty in
+// opts are cleared, so callers wanting a partial update should merge first.
+func (s *JiraService) UpdateRemoteLinkWithOptions(ctx context.Context, issueKey string, linkID int, opts *RemoteLinkOptions) error {
+	path := fmt.Sprintf("%s/issue/%s/remotelink/%d", s.client.JiraBaseURL(), issueKey, linkID)
+	return s.client.Put(ctx, path, opts.request(), nil)
+}
+
 // DeleteRemoteLink deletes a remote/web link from an issue.
 func (s *JiraService) DeleteRemoteLink(ctx context.Context, issueKey string, linkID int) error {
 	path := fmt.Sprintf("%s/issue/%s/remotelink/%d", s.client.JiraBaseURL(), issueKey, linkID)
@@ -939,6 +1452,7 @@ type Field struct {
 // FieldSchema describes the type of a field.
 type FieldSchema struct {
 	Type     string `json:"type"`
+	Items    string `json:"items,omitempty"` // Element type when Type is "array"
 	System   string `json:"system,omitempty"`
 	Custom   string `json:"custom,omitempty"`
 	CustomID int    `json:"customId,omitempty"`
@@ -961,6 +1475,18 @@ func (s *JiraService) GetFields(ctx context.Context) ([]*Field, error) {
 	return fields, nil
 }
 
+// AmbiguousFieldError is returned when a field name matches more than one
+// field, as custom fields may share a name.
+type AmbiguousFieldError struct {
+	Name string
+	IDs  []string
+}
+
+func (e *AmbiguousFieldError) Error() string {
+	return fmt.Sprintf("field name '%s' matches %d fields: %s; use the field ID instead",
+		e.Name, len(e.IDs), strings.Join(e.IDs, ", "))
+}
+
 // GetFieldByName finds a field by name and returns it.
 // Returns nil if not found.
 func (s *JiraService) GetFieldByName(ctx context.Context, name string) (*Field, error) {
@@ -968,15 +1494,30 @@ func (s *JiraService) GetFieldByName(ctx context.Context, name string) (*Field,
 	if err != nil {
 		return nil, err
 	}
+	return FindFieldByName(fields, name)
+}
 
-	nameLower := strings.ToLower(name)
+// FindFieldByName finds the field with the given name, ignoring case.
+// Returns nil if none matches and an *AmbiguousFieldError if several do.
+func FindFieldByName(fields []*Field, name string) (*Field, error) {
+	var matches []*Field
 	for _, f := range fields {
-		if strings.ToLower(f.Name) == nameLower {
-			return f, nil
+		if strings.EqualFold(f.Name, name) {
+			matches = append(matches, f)
 		}
 	}
 
-	return nil, nil
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	}
+	ids := make([]string, len(matches))
+	for i, f := range matches {
+		ids[i] = f.ID
+	}
+	return nil, &AmbiguousFieldError{Name: name, IDs: ids}
 }
 
 // GetFieldByID finds a field by its ID (e.g., "customfield_10016") and returns it.
@@ -1100,6 +1641,81 @@ func (s *JiraService) IsIssueFlagged(ctx context.Context, issueKey string) (bool
 	return false, nil
 }
 
+// Votes represents the vote information for an issue.
+type Votes struct {
+	Self     string  `json:"self,omitempty"`
+	Votes    int     `json:"votes"`
+	HasVoted bool    `json:"hasVoted"`
+	Voters   []*User `json:"voters,omitempty"`
+}
+
+// GetVotes gets the vote count for an issue and whether the current user has voted.
+func (s *JiraService) GetVotes(ctx context.Context, issueKey string) (*Votes, error) {
+	path := fmt.Sprintf("%s/issue/%s/votes", s.client.JiraBaseURL(), issueKey)
+
+	var votes Votes
+	if err := s.client.Get(ctx, path, &votes); err != nil {
+		return nil, err
+	}
+
+	return &votes, nil
+}
+
+// AddVote casts the current user's vote on an issue.
+// Jira rejects votes on issues the user reported or when voting is disabled.
+func (s *JiraService) AddVote(ctx context.Context, issueKey string) error {
+	path := fmt.Sprintf("%s/issue/%s/votes", s.client.JiraBaseURL(), issueKey)
+	return s.client.Post(ctx, path, nil, nil)
+}
+
+// RemoveVote removes the current user's vote from an issue.
+func (s *JiraService) RemoveVote(ctx context.Context, issueKey string) error {
+	path := fmt.Sprintf("%s/issue/%s/votes", s.client.JiraBaseURL(), issueKey)
+	return s.client.Delete(ctx, path)
+}
+
+// TimeTracking holds an issue's estimates and logged time, as Jira duration
+// strings (e.g. "1d 4h") and in seconds. Unset values are empty or zero.
+type TimeTracking struct {
+	OriginalEstimate         string `json:"originalEstimate,omitempty"`
+	RemainingEstimate        string `json:"remainingEstimate,omitempty"`
+	TimeSpent                string `json:"timeSpent,omitempty"`
+	OriginalEstimateSeconds  int    `json:"originalEstimateSeconds,omitempty"`
+	RemainingEstimateSeconds int    `json:"remainingEstimateSeconds,omitempty"`
+	TimeSpentSeconds         int    `json:"timeSpentSeconds,omitempty"`
+}
+
+// GetTimeTracking gets the original and remaining estimates and the time
+// spent on an issue.
+func (s *JiraService) GetTimeTracking(ctx context.Context, issueKey string) (*TimeTracking, error) {
+	issue, err := s.GetIssueWithOptions(ctx, issueKey, GetIssueOptions{Fields: []string{"timetracking"}})
+	if err != nil {
+		return nil, err
+	}
+	if issue.Fields.TimeTracking == nil {
+		return &TimeTracking{}, nil
+	}
+	return issue.Fields.TimeTracking, nil
+}
+
+// SetTimeTracking sets the original and/or remaining estimate of an issue.
+// Estimates are Jira duration strings; an empty estimate is left unchanged.
+// Time spent can only be changed by logging work.
+func (s *JiraService) SetTimeTracking(ctx context.Context, issueKey, originalEstimate, remainingEstimate string) error {
+	timetracking := map[string]string{}
+	if originalEstimate != "" {
+		timetracking["originalEstimate"] = originalEstimate
+	}
+	if remainingEstimate != "" {
+		timetracking["remainingEstimate"] = remainingEstimate
+	}
+
+	req := &UpdateIssueRequest{
+		Fields: map[string]interface{}{"timetracking": timetracking},
+	}
+	return s.UpdateIssue(ctx, issueKey, req)
+}
+
 // Sprint represents a Jira sprint.
 type Sprint struct {
 	ID            int    `json:"id"`
@@ -1226,37 +1842,110 @@ func (s *JiraService) RankIssuesAfter(ctx context.Context, issueKeys []string, r
 	return s.client.Put(ctx, path, body, nil)
 }
 
-// RankIssuesToTop ranks issues to the top of the backlog.
+// ErrEmptyBoard is returned when ranking issues to the top or bottom of a
+// board that has no issues to rank against.
+var ErrEmptyBoard = errors.New("board has no issues")
+
+// RankIssuesToTop ranks issues to the top of the backlog, in the given order.
+// Returns ErrEmptyBoard if the board has no issues.
 func (s *JiraService) RankIssuesToTop(ctx context.Context, issueKeys []string, boardID int) error {
-	// Get the first issue on the board to rank before it
+	target, err := s.boardRankTarget(ctx, issueKeys, boardID, false)
+	if err != nil {
+		return err
+	}
+	if target == "" {
+		return s.rankInOrder(ctx, issueKeys)
+	}
+	return s.RankIssuesBefore(ctx, issueKeys, target)
+}
+
+// RankIssuesToBottom ranks issues after the last issue on the board, in the
+// given order. Returns ErrEmptyBoard if the board has no issues.
+func (s *JiraService) RankIssuesToBottom(ctx context.Context, issueKeys []string, boardID int) error {
+	target, err := s.boardRankTarget(ctx, issueKeys, boardID, true)
+	if err != nil {
+		return err
+	}
+	if target == "" {
+		return s.rankInOrder(ctx, issueKeys)
+	}
+	return s.RankIssuesAfter(ctx, issueKeys, target)
+}
+
+// boardRankTarget returns the first (or, with last, the final) issue on the
+// board that is not one of issueKeys. An issue can't be ranked against
+// itself, so issues already at that end of the board are skipped. Returns ""
+// if the board holds only issueKeys.
+func (s *JiraService) boardRankTarget(ctx context.Context, issueKeys []string, boardID int, last bool) (string, error) {
+	// At most len(issueKeys) issues at either end are being ranked.
+	window := len(issueKeys) + 1
+
+	startAt := 0
+	if last {
+		_, total, err := s.boardIssueKeys(ctx, boardID, 0, 1)
+		if err != nil {
+			return "", err
+		}
+		if total == 0 {
+			return "", ErrEmptyBoard
+		}
+		startAt = max(total-window, 0)
+	}
+
+	keys, total, err := s.boardIssueKeys(ctx, boardID, startAt, window)
+	if err != nil {
+		return "", err
+	}
+	if total == 0 {
+		return "", ErrEmptyBoard
+	}
+	if last {
+		slices.Reverse(keys)
+	}
+
+	for _, key := range keys {
+		if !slices.ContainsFunc(issueKeys, func(k string) bool { return strings.EqualFold(k, key) }) {
+			return key, nil
+		}
+	}
+	return "", nil
+}
+
+// boardIssueKeys gets the keys of a range of board issues in rank order,
+// and the total number of issues on the board.
+func (s *JiraService) boardIssueKeys(ctx context.Context, boardID, startAt, maxResults int) ([]string, int, error) {
 	path := fmt.Sprintf("%s/board/%d/issue", s.client.AgileBaseURL(), boardID)
 
 	params := url.Values{}
-	params.Set("maxResults", "1")
+	params.Set("startAt", strconv.Itoa(startAt))
+	params.Set("maxResults", strconv.Itoa(maxResults))
+	params.Set("fields", "key")
 
 	var result struct {
+		Total  int `json:"total"`
 		Issues []struct {
 			Key string `json:"key"`
 		} `json:"issues"`
 	}
 
 	if err := s.client.Get(ctx, path+"?"+params.Encode(), &result); err != nil {
-		return err
+		return nil, 0, err
 	}
 
-	if len(result.Issues) == 0 {
-		// No issues on board, nothing to rank against
-		return nil
+	keys := make([]string, len(result.Issues))
+	for i, issue := range result.Issues {
+		keys[i] = issue.Key
 	}
+	return keys, result.Total, nil
+}
 
-	// If the first issue is already one we're ranking, we're done
-	for _, key := range issueKeys {
-		if key == result.Issues[0].Key {
-			return nil
-		}
+// rankInOrder ranks issues after the first one, so they end up in the given
+// order. Used when there is no other issue on the board to rank against.
+func (s *JiraService) rankInOrder(ctx context.Context, issueKeys []string) error {
+	if len(issueKeys) < 2 {
+		return nil
 	}
-
-	return s.RankIssuesBefore(ctx, issueKeys, result.Issues[0].Key)
+	return s.RankIssuesAfter(ctx, issueKeys[1:], issueKeys[0])
 }
 
 // GetBoardIssues gets issues on a board.
@@ -1506,3 +2195,70 @@ func convertAttrs(attrs *ADFAttrs) map[string]interface{} {
 	}
 	return result
 }
+
+// EntityProperty is an arbitrary JSON value stored on an issue under a key.
+type EntityProperty struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// EntityPropertyKeysResponse represents the property keys set on an issue.
+type EntityPropertyKeysResponse struct {
+	Keys []struct {
+		Key string `json:"key"`
+	} `json:"keys"`
+}
+
+// GetIssuePropertyKeys lists the property keys set on an issue.
+func (s *JiraService) GetIssuePropertyKeys(ctx context.Context, issueKey string) ([]string, error) {
+	path := fmt.Sprintf("%s/issue/%s/properties", s.client.JiraBaseURL(), issueKey)
+
+	var result EntityPropertyKeysResponse
+	if err := s.client.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(result.Keys))
+	for _, k := range result.Keys {
+		keys = append(keys, k.Key)
+	}
+	return keys, nil
+}
+
+// GetIssueProperty gets a property value from an issue.
+func (s *JiraService) GetIssueProperty(ctx context.Context, issueKey, propertyKey string) (*EntityProperty, error) {
+	path := fmt.Sprintf("%s/issue/%s/properties/%s", s.client.JiraBaseURL(), issueKey, url.PathEscape(propertyKey))
+
+	var result EntityProperty
+	if err := s.client.Get(ctx, path, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SetIssueProperty creates or replaces a property on an issue.
+// value must be valid JSON.
+func (s *JiraService) SetIssueProperty(ctx context.Context, issueKey, propertyKey string, value json.RawMessage) error {
+	path := fmt.Sprintf("%s/issue/%s/properties/%s", s.client.JiraBaseURL(), issueKey, url.PathEscape(propertyKey))
+	return s.client.Put(ctx, path, value, nil)
+}
+
+// DeleteIssueProperty removes a property from an issue.
+func (s *JiraService) DeleteIssueProperty(ctx context.Context, issueKey, propertyKey string) error {
+	path := fmt.Sprintf("%s/issue/%s/properties/%s", s.client.JiraBaseURL(), issueKey, url.PathEscape(propertyKey))
+	return s.client.Delete(ctx, path)
+}
+
+// AddWatcher adds the user with the given account ID as a watcher of an
+// issue. Adding someone other than yourself requires the Manage Watchers
+// project permission.
+func (s *JiraService) AddWatcher(ctx context.Context, issueKey, accountID string) error {
+	if err := s.client.RequireScopes(OpJiraWrite); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("%s/issue/%s/watchers", s.client.JiraBaseURL(), issueKey)
+	// The request body is the account ID as a bare JSON string.
+	return s.client.Post(ctx, path, accountID, nil)
+}
@@ -3,8 +3,13 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -423,6 +428,43 @@ func TestCreateIssueRequest(t *testing.T) {
 	}
 }
 
+// TestCreateIssueRequestReporter tests that the reporter is sent as an
+// account ID only when set.
+func TestCreateIssueRequestReporter(t *testing.T) {
+	req := &CreateIssueRequest{
+		Fields: CreateIssueFields{
+			Project:   &ProjectID{Key: "TEST"},
+			Summary:   "On behalf of",
+			IssueType: &IssueTypeID{Name: "Task"},
+			Reporter:  &AccountID{AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+		},
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var decoded struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got := string(decoded.Fields["reporter"]); got != `{"accountId":"5b10ac8d82e05b22cc7d4ef5"}` {
+		t.Errorf("fields.reporter = %s, want the account ID object", got)
+	}
+
+	req.Fields.Reporter = nil
+	data, err = json.Marshal(req)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if strings.Contains(string(data), `"reporter"`) {
+		t.Errorf("JSON should not contain reporter when unset: %s", data)
+	}
+}
+
 // TestTransition tests the Transition structure.
 func TestTransition(t *testing.T) {
 	transition := &Transition{
@@ -649,3 +691,802 @@ func TestGetChangelog(t *testing.T) {
 		t.Errorf("ToString = %q, want %q", result.Values[0].Items[0].ToString, "In Progress")
 	}
 }
+
+func TestRemoteLinkOptionsRequest(t *testing.T) {
+	opts := &RemoteLinkOptions{
+		URL:          "https://ci.example.com/build/42",
+		Title:        "Build #42",
+		Summary:      "Nightly",
+		Relationship: "built by",
+		GlobalID:     "ci-build-42",
+		Application:  &RemoteLinkApp{Type: "com.atlassian.confluence", Name: "Confluence"},
+	}
+
+	data, err := json.Marshal(opts.request())
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got["globalId"] != "ci-build-42" {
+		t.Errorf("globalId = %v, want %q", got["globalId"], "ci-build-42")
+	}
+	if app, ok := got["application"].(map[string]interface{}); !ok || app["type"] != "com.atlassian.confluence" {
+		t.Errorf("application = %v, want type com.atlassian.confluence", got["application"])
+	}
+	if got["relationship"] != "built by" {
+		t.Errorf("relationship = %v, want %q", got["relationship"], "built by")
+	}
+	object, ok := got["object"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("object missing from request: %s", data)
+	}
+	if object["url"] != opts.URL || object["title"] != opts.Title || object["summary"] != opts.Summary {
+		t.Errorf("object = %v, want url/title/summary from options", object)
+	}
+}
+
+func TestCreateRemoteLinkSendsGlobalID(t *testing.T) {
+	var got CreateRemoteLinkRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/ex/jira/test-cloud/rest/api/3/issue/TEST-1/remotelink" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+		// Jira answers 200 when a link with this globalId was updated.
+		json.NewEncoder(w).Encode(RemoteLink{ID: 10001, Self: "https://example.atlassian.net/rest/api/3/issue/TEST-1/remotelink/10001"})
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+
+	link, err := NewJiraService(client).CreateRemoteLinkWithOptions(context.Background(), "TEST-1", &RemoteLinkOptions{
+		URL:      "https://ci.example.com/build/42",
+		Title:    "Build #42",
+		GlobalID: "ci-build-42",
+	})
+	if err != nil {
+		t.Fatalf("CreateRemoteLinkWithOptions() error = %v", err)
+	}
+	if link.ID != 10001 {
+		t.Errorf("link ID = %d, want 10001", link.ID)
+	}
+	if got.GlobalID != "ci-build-42" {
+		t.Errorf("globalId sent = %q, want %q", got.GlobalID, "ci-build-42")
+	}
+	if got.Object == nil || got.Object.URL != "https://ci.example.com/build/42" {
+		t.Errorf("object sent = %+v, want the link URL", got.Object)
+	}
+}
+
+func TestUpdateRemoteLinkSendsApplicationAndIcon(t *testing.T) {
+	var got CreateRemoteLinkRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/ex/jira/test-cloud/rest/api/3/issue/TEST-1/remotelink/10001" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+
+	err := NewJiraService(client).UpdateRemoteLinkWithOptions(context.Background(), "TEST-1", 10001, &RemoteLinkOptions{
+		URL:         "https://example.atlassian.net/wiki/pages/123",
+		Title:       "Runbook v2",
+		Application: &RemoteLinkApp{Type: "com.atlassian.confluence", Name: "Confluence"},
+		Icon:        &RemoteLinkIcon{URL16x16: "https://example.atlassian.net/wiki/favicon.ico"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateRemoteLinkWithOptions() error = %v", err)
+	}
+	if got.Application == nil || got.Application.Type != "com.atlassian.confluence" {
+		t.Errorf("application sent = %+v, want com.atlassian.confluence", got.Application)
+	}
+	if got.Object == nil || got.Object.Icon == nil || got.Object.Icon.URL16x16 != "https://example.atlassian.net/wiki/favicon.ico" {
+		t.Errorf("object sent = %+v, want the icon", got.Object)
+	}
+}
+
+func TestNotifyUsersQueryParam(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Method+" "+r.URL.Path+" notifyUsers="+r.URL.Query().Get("notifyUsers"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+	ctx := context.Background()
+
+	req := &UpdateIssueRequest{Fields: map[string]interface{}{"summary": "x"}}
+	if err := jira.UpdateIssue(ctx, "TEST-1", req); err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+	if err := jira.UpdateIssueWithOptions(ctx, "TEST-1", req, NotifyOptions{Notify: false}); err != nil {
+		t.Fatalf("UpdateIssueWithOptions() error = %v", err)
+	}
+
+	want := []string{
+		"PUT /ex/jira/test-cloud/rest/api/3/issue/TEST-1 notifyUsers=",
+		"PUT /ex/jira/test-cloud/rest/api/3/issue/TEST-1 notifyUsers=false",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestSearchNextPage(t *testing.T) {
+	issues := func(n int) []*Issue {
+		out := make([]*Issue, n)
+		for i := range out {
+			out[i] = &Issue{}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name        string
+		result      SearchResult
+		opts        SearchOptions
+		wantMore    bool
+		wantToken   string
+		wantStartAt int
+	}{
+		{
+			name:      "token",
+			result:    SearchResult{Issues: issues(2), NextPageToken: "abc"},
+			opts:      SearchOptions{MaxResults: 2},
+			wantMore:  true,
+			wantToken: "abc",
+		},
+		{
+			name:   "last page",
+			result: SearchResult{Issues: issues(2), NextPageToken: "abc", IsLast: true},
+			opts:   SearchOptions{MaxResults: 2},
+		},
+		{
+			name:        "no token, full page, more in total",
+			result:      SearchResult{Issues: issues(2), MaxResults: 2, Total: 5},
+			opts:        SearchOptions{MaxResults: 2, StartAt: 2},
+			wantMore:    true,
+			wantStartAt: 4,
+		},
+		{
+			name:   "no token, short page",
+			result: SearchResult{Issues: issues(1), MaxResults: 2, Total: 5},
+			opts:   SearchOptions{MaxResults: 2, StartAt: 4},
+		},
+		{
+			name:   "no token, total reached",
+			result: SearchResult{Issues: issues(2), MaxResults: 2, Total: 4},
+			opts:   SearchOptions{MaxResults: 2, StartAt: 2},
+		},
+		{
+			name:   "no token, no total",
+			result: SearchResult{Issues: issues(2), MaxResults: 2},
+			opts:   SearchOptions{MaxResults: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, more := tt.result.NextPage(tt.opts)
+			if more != tt.wantMore {
+				t.Fatalf("NextPage() more = %v, want %v", more, tt.wantMore)
+			}
+			if !more {
+				return
+			}
+			if next.NextPageToken != tt.wantToken || next.StartAt != tt.wantStartAt {
+				t.Errorf("NextPage() = token %q startAt %d, want token %q startAt %d",
+					next.NextPageToken, next.StartAt, tt.wantToken, tt.wantStartAt)
+			}
+		})
+	}
+}
+
+func TestSearchWithoutPageToken(t *testing.T) {
+	// Simulates an instance that answers in the old offset style: no
+	// nextPageToken, only startAt/maxResults/total.
+	const total = 5
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		requests = append(requests, "startAt="+q.Get("startAt")+" nextPageToken="+q.Get("nextPageToken"))
+
+		startAt := 0
+		if s := q.Get("startAt"); s != "" {
+			startAt, _ = strconv.Atoi(s)
+		}
+		maxResults, _ := strconv.Atoi(q.Get("maxResults"))
+
+		var issues []map[string]string
+		for i := startAt; i < total && i < startAt+maxResults; i++ {
+			issues = append(issues, map[string]string{"key": fmt.Sprintf("TEST-%d", i+1)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"issues":     issues,
+			"startAt":    startAt,
+			"maxResults": maxResults,
+			"total":      total,
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+
+	var keys []string
+	opts := SearchOptions{JQL: "project = TEST", MaxResults: 2}
+	for {
+		result, err := jira.Search(context.Background(), opts)
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		for _, issue := range result.Issues {
+			keys = append(keys, issue.Key)
+		}
+		next, more := result.NextPage(opts)
+		if !more {
+			break
+		}
+		opts = next
+	}
+
+	if got := strings.Join(keys, ","); got != "TEST-1,TEST-2,TEST-3,TEST-4,TEST-5" {
+		t.Errorf("keys = %s, want all %d issues", got, total)
+	}
+	wantRequests := []string{
+		"startAt= nextPageToken=",
+		"startAt=2 nextPageToken=",
+		"startAt=4 nextPageToken=",
+	}
+	if strings.Join(requests, "\n") != strings.Join(wantRequests, "\n") {
+		t.Errorf("requests =\n%s\nwant\n%s", strings.Join(requests, "\n"), strings.Join(wantRequests, "\n"))
+	}
+}
+
+func TestGetCommentsAll(t *testing.T) {
+	const total = 150
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		starts = append(starts, q.Get("startAt"))
+
+		startAt, _ := strconv.Atoi(q.Get("startAt"))
+		maxResults, _ := strconv.Atoi(q.Get("maxResults"))
+
+		var comments []map[string]string
+		for i := startAt; i < total && i < startAt+maxResults; i++ {
+			comments = append(comments, map[string]string{"id": strconv.Itoa(i + 1)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"comments":   comments,
+			"startAt":    startAt,
+			"maxResults": maxResults,
+			"total":      total,
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+
+	comments, err := jira.GetCommentsAll(context.Background(), "TEST-1")
+	if err != nil {
+		t.Fatalf("GetCommentsAll() error = %v", err)
+	}
+	if len(comments) != total {
+		t.Errorf("GetCommentsAll() returned %d comments, want %d", len(comments), total)
+	}
+	if got := strings.Join(starts, ","); got != "0,100" {
+		t.Errorf("startAt values = %s, want 0,100", got)
+	}
+}
+
+func TestGetIssueWithOptions(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		queries = append(queries, "fields="+q.Get("fields")+" expand="+q.Get("expand"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"key":"TEST-1","fields":{},"transitions":[{"id":"31","name":"Start"}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+	ctx := context.Background()
+
+	if _, err := jira.GetIssue(ctx, "TEST-1"); err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	issue, err := jira.GetIssueWithOptions(ctx, "TEST-1", GetIssueOptions{
+		Fields: []string{"summary", "status"},
+		Expand: []string{"transitions", "renderedFields"},
+	})
+	if err != nil {
+		t.Fatalf("GetIssueWithOptions() error = %v", err)
+	}
+	if len(issue.Transitions) != 1 || issue.Transitions[0].ID != "31" {
+		t.Errorf("Transitions = %+v, want one transition with ID 31", issue.Transitions)
+	}
+
+	want := []string{
+		"fields=*all expand=renderedFields",
+		"fields=summary,status expand=renderedFields,transitions",
+	}
+	if strings.Join(queries, "\n") != strings.Join(want, "\n") {
+		t.Errorf("queries =\n%s\nwant\n%s", strings.Join(queries, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestIssueRenderedField(t *testing.T) {
+	var issue Issue
+	data := `{"key":"TEST-1","fields":{"summary":"Hi"},"renderedFields":{"description":"<p>Hello <b>world</b></p>","duedate":null,"comment":{"comments":[]}}}`
+	if err := json.Unmarshal([]byte(data), &issue); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := issue.RenderedField("description"); got != "<p>Hello <b>world</b></p>" {
+		t.Errorf("RenderedField(description) = %q", got)
+	}
+	for _, id := range []string{"duedate", "comment", "missing"} {
+		if got := issue.RenderedField(id); got != "" {
+			t.Errorf("RenderedField(%s) = %q, want empty", id, got)
+		}
+	}
+}
+
+func TestIssuePropertyRoundTrip(t *testing.T) {
+	const path = "/ex/jira/test-cloud/rest/api/3/issue/TEST-1/properties/deploy-info"
+	stored := map[string]json.RawMessage{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			var value json.RawMessage
+			json.NewDecoder(r.Body).Decode(&value)
+			stored["deploy-info"] = value
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			value, ok := stored["deploy-info"]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(EntityProperty{Key: "deploy-info", Value: value})
+		case http.MethodDelete:
+			delete(stored, "deploy-info")
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+	ctx := context.Background()
+
+	if err := jira.SetIssueProperty(ctx, "TEST-1", "deploy-info", json.RawMessage(`{"env":"prod","build":42}`)); err != nil {
+		t.Fatalf("SetIssueProperty() error = %v", err)
+	}
+	property, err := jira.GetIssueProperty(ctx, "TEST-1", "deploy-info")
+	if err != nil {
+		t.Fatalf("GetIssueProperty() error = %v", err)
+	}
+	if string(property.Value) != `{"env":"prod","build":42}` {
+		t.Errorf("GetIssueProperty() value = %s, want the value that was set", property.Value)
+	}
+
+	if err := jira.DeleteIssueProperty(ctx, "TEST-1", "deploy-info"); err != nil {
+		t.Fatalf("DeleteIssueProperty() error = %v", err)
+	}
+	if _, err := jira.GetIssueProperty(ctx, "TEST-1", "deploy-info"); err == nil {
+		t.Error("GetIssueProperty() after delete should fail")
+	}
+}
+
+func TestChunkIssueKeys(t *testing.T) {
+	keys := make([]string, 250)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("PROJ-%d", i+1)
+	}
+
+	chunks := chunkIssueKeys(keys, 100, 10000)
+	if len(chunks) != 3 || len(chunks[0]) != 100 || len(chunks[1]) != 100 || len(chunks[2]) != 50 {
+		sizes := make([]int, len(chunks))
+		for i, c := range chunks {
+			sizes[i] = len(c)
+		}
+		t.Fatalf("chunk sizes = %v, want [100 100 50]", sizes)
+	}
+
+	chunks = chunkIssueKeys(keys, 100, 200)
+	var rejoined []string
+	for _, chunk := range chunks {
+		if jql := issueKeysJQL(chunk); len(jql) > 200 {
+			t.Errorf("chunk JQL is %d characters, want at most 200", len(jql))
+		}
+		rejoined = append(rejoined, chunk...)
+	}
+	if strings.Join(rejoined, ",") != strings.Join(keys, ",") {
+		t.Error("chunks do not contain every key in order")
+	}
+
+	if got := chunkIssueKeys(nil, 100, 200); len(got) != 0 {
+		t.Errorf("chunkIssueKeys(nil) = %v, want no chunks", got)
+	}
+}
+
+func TestGetIssues(t *testing.T) {
+	var jqls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/issue/PROJ-3") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`))
+			return
+		}
+		jql := r.URL.Query().Get("jql")
+		jqls = append(jqls, jql)
+
+		// Answer in reverse order, and leave out PROJ-3 as if it were not visible.
+		inner := strings.TrimSuffix(strings.TrimPrefix(jql, "key in ("), ")")
+		keys := strings.Split(inner, ", ")
+		var issues []map[string]string
+		for i := len(keys) - 1; i >= 0; i-- {
+			if keys[i] != "PROJ-3" {
+				issues = append(issues, map[string]string{"key": keys[i], "id": "1" + strings.TrimPrefix(keys[i], "PROJ-")})
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"issues": issues, "isLast": true})
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+
+	keys := make([]string, 150)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("PROJ-%d", i+1)
+	}
+
+	issues, err := jira.GetIssues(context.Background(), keys, []string{"summary"})
+	if err != nil {
+		t.Fatalf("GetIssues() error = %v", err)
+	}
+	if len(jqls) != 2 {
+		t.Errorf("GetIssues() made %d searches, want 2", len(jqls))
+	}
+	if len(issues) != 149 {
+		t.Fatalf("GetIssues() returned %d issues, want 149", len(issues))
+	}
+	if issues[0].Key != "PROJ-1" || issues[2].Key != "PROJ-4" || issues[148].Key != "PROJ-150" {
+		t.Errorf("GetIssues() order = %s, %s, ..., %s; want request order", issues[0].Key, issues[2].Key, issues[148].Key)
+	}
+}
+
+// TestGetIssuesByKeyMovedIssue tests that a moved issue, which search returns
+// under its new key, is found by its old key.
+func TestGetIssuesByKeyMovedIssue(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, strings.TrimPrefix(r.URL.Path, "/ex/jira/test-cloud/rest/api/3"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/ex/jira/test-cloud/rest/api/3/search/jql":
+			w.Write([]byte(`{"issues":[{"id":"10001","key":"PROJ-1"},{"id":"10007","key":"NEW-7"}],"isLast":true}`))
+		case "/ex/jira/test-cloud/rest/api/3/issue/OLD-1":
+			w.Write([]byte(`{"id":"10007","key":"NEW-7","fields":{"summary":"Moved"}}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	jira := NewJiraService(newTestClient(t, server))
+
+	byKey, err := jira.GetIssuesByKey(context.Background(), []string{"proj-1", "OLD-1"}, []string{"summary"})
+	if err != nil {
+		t.Fatalf("GetIssuesByKey() error = %v", err)
+	}
+	if byKey["PROJ-1"] == nil || byKey["OLD-1"] == nil || byKey["OLD-1"].Key != "NEW-7" {
+		t.Errorf("GetIssuesByKey() = %v, want PROJ-1 and OLD-1 (as NEW-7)", byKey)
+	}
+	if got := strings.Join(requests, ","); got != "/search/jql,/issue/OLD-1" {
+		t.Errorf("requests = %s, want one search and one lookup of the moved key", got)
+	}
+}
+
+func TestGetLabels(t *testing.T) {
+	pages := [][]string{
+		{"backend", "Backend-API", "bug"},
+		{"frontend", "backlog"},
+	}
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/rest/api/3/label") {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		start := r.URL.Query().Get("startAt")
+		starts = append(starts, start)
+
+		page := 0
+		if start != "0" {
+			page = 1
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LabelsResponse{
+			Values:  pages[page],
+			StartAt: page * 3,
+			Total:   5,
+			IsLast:  page == 1,
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+
+	labels, err := jira.GetLabels(context.Background(), "BACK")
+	if err != nil {
+		t.Fatalf("GetLabels() error = %v", err)
+	}
+	if got := strings.Join(labels, ","); got != "backend,Backend-API,backlog" {
+		t.Errorf("GetLabels(\"BACK\") = %s, want backend,Backend-API,backlog", got)
+	}
+	if got := strings.Join(starts, ","); got != "0,3" {
+		t.Errorf("startAt values = %s, want 0,3", got)
+	}
+
+	starts = nil
+	labels, err = jira.GetLabels(context.Background(), "")
+	if err != nil {
+		t.Fatalf("GetLabels() error = %v", err)
+	}
+	if len(labels) != 5 {
+		t.Errorf("GetLabels(\"\") returned %d labels, want 5", len(labels))
+	}
+}
+
+func TestDownloadAttachmentThumbnail(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Query().Get("redirect") != "false" {
+			t.Errorf("redirect = %q, want false", r.URL.Query().Get("redirect"))
+		}
+		if strings.HasSuffix(r.URL.Path, "/20") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte("thumb"))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+
+	content, contentType, err := jira.DownloadAttachmentThumbnail(context.Background(), "10")
+	if err != nil {
+		t.Fatalf("DownloadAttachmentThumbnail() error = %v", err)
+	}
+	if string(content) != "thumb" || contentType != "image/png" {
+		t.Errorf("got %q (%s), want thumb (image/png)", content, contentType)
+	}
+	if want := "/ex/jira/test-cloud/rest/api/3/attachment/thumbnail/10"; paths[0] != want {
+		t.Errorf("path = %s, want %s", paths[0], want)
+	}
+
+	_, _, err = jira.DownloadAttachmentThumbnail(context.Background(), "20")
+	if !errors.Is(err, ErrNoThumbnail) {
+		t.Errorf("error = %v, want ErrNoThumbnail", err)
+	}
+}
+
+func TestAddWatcher(t *testing.T) {
+	var gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/ex/jira/test-cloud/rest/api/3/issue/TEST-1/watchers" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+
+	if err := NewJiraService(client).AddWatcher(context.Background(), "TEST-1", "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Fatalf("AddWatcher() error = %v", err)
+	}
+	if strings.TrimSpace(gotBody) != `"5b10ac8d82e05b22cc7d4ef5"` {
+		t.Errorf("request body = %s, want the account ID as a JSON string", gotBody)
+	}
+}
+
+func TestRankIssuesToBoardEnds(t *testing.T) {
+	const agile = "/ex/jira/test-cloud/rest/agile/1.0"
+	board := []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4"}
+	var ranked map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == agile+"/board/1/issue":
+			startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+			maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+			var issues []string
+			for i := startAt; i < len(board) && i < startAt+maxResults; i++ {
+				issues = append(issues, fmt.Sprintf(`{"key":%q}`, board[i]))
+			}
+			fmt.Fprintf(w, `{"total":%d,"issues":[%s]}`, len(board), strings.Join(issues, ","))
+		case r.Method == http.MethodGet && r.URL.Path == agile+"/board/2/issue":
+			w.Write([]byte(`{"total":0,"issues":[]}`))
+		case r.Method == http.MethodPut && r.URL.Path == agile+"/issue/rank":
+			ranked = nil
+			json.NewDecoder(r.Body).Decode(&ranked)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+	ctx := context.Background()
+
+	// The last issue is one being ranked, so the target is the one before it.
+	if err := jira.RankIssuesToBottom(ctx, []string{"TEST-1", "TEST-4"}, 1); err != nil {
+		t.Fatalf("RankIssuesToBottom() error = %v", err)
+	}
+	if ranked["rankAfterIssue"] != "TEST-3" {
+		t.Errorf("RankIssuesToBottom() request = %v, want rankAfterIssue TEST-3", ranked)
+	}
+
+	if err := jira.RankIssuesToTop(ctx, []string{"TEST-1", "TEST-3"}, 1); err != nil {
+		t.Fatalf("RankIssuesToTop() error = %v", err)
+	}
+	if ranked["rankBeforeIssue"] != "TEST-2" {
+		t.Errorf("RankIssuesToTop() request = %v, want rankBeforeIssue TEST-2", ranked)
+	}
+
+	if err := jira.RankIssuesToTop(ctx, []string{"TEST-1"}, 2); !errors.Is(err, ErrEmptyBoard) {
+		t.Errorf("RankIssuesToTop() on an empty board error = %v, want ErrEmptyBoard", err)
+	}
+	if err := jira.RankIssuesToBottom(ctx, []string{"TEST-1"}, 2); !errors.Is(err, ErrEmptyBoard) {
+		t.Errorf("RankIssuesToBottom() on an empty board error = %v, want ErrEmptyBoard", err)
+	}
+}
+
+func TestTimeTracking(t *testing.T) {
+	const path = "/ex/jira/test-cloud/rest/api/3/issue/TEST-1"
+	var update map[string]map[string]map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			if got := r.URL.Query().Get("fields"); got != "timetracking" {
+				t.Errorf("fields = %q, want timetracking", got)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"key":"TEST-1","fields":{"timetracking":{"originalEstimate":"3d","remainingEstimate":"1d 4h","timeSpent":"1d 4h","originalEstimateSeconds":86400,"remainingEstimateSeconds":43200,"timeSpentSeconds":43200}}}`))
+		case http.MethodPut:
+			json.NewDecoder(r.Body).Decode(&update)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	jira := NewJiraService(client)
+	ctx := context.Background()
+
+	tt, err := jira.GetTimeTracking(ctx, "TEST-1")
+	if err != nil {
+		t.Fatalf("GetTimeTracking() error = %v", err)
+	}
+	if tt.OriginalEstimate != "3d" || tt.RemainingEstimate != "1d 4h" || tt.TimeSpentSeconds != 43200 {
+		t.Errorf("GetTimeTracking() = %+v", tt)
+	}
+
+	if err := jira.SetTimeTracking(ctx, "TEST-1", "", "2h"); err != nil {
+		t.Fatalf("SetTimeTracking() error = %v", err)
+	}
+	got := update["fields"]["timetracking"]
+	if len(got) != 1 || got["remainingEstimate"] != "2h" {
+		t.Errorf("timetracking update = %v, want only remainingEstimate 2h", got)
+	}
+}
+
+func TestGetProjectIssueTypesCached(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issueTypes": [{"id": "10000", "name": "Epic", "hierarchyLevel": 1}]}`))
+	}))
+	defer server.Close()
+
+	jira := NewJiraService(newTestClient(t, server))
+	ctx := context.Background()
+
+	for _, project := range []string{"PROJ", "proj", "PROJ"} {
+		types, err := jira.GetProjectIssueTypes(ctx, project)
+		if err != nil {
+			t.Fatalf("GetProjectIssueTypes(%s) error = %v", project, err)
+		}
+		if len(types) != 1 || types[0].Name != "Epic" {
+			t.Errorf("GetProjectIssueTypes(%s) = %v, want the Epic type", project, types)
+		}
+	}
+	if _, err := jira.GetProjectIssueTypes(ctx, "OTHER"); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2 (one per project)", requests)
+	}
+}
+
+func TestCommentBodyPrefix(t *testing.T) {
+	prefix := []ADFContent{{Type: "blockquote", Content: []ADFContent{{Type: "paragraph"}}}}
+	opts := &CommentOptions{Body: "h2. Reply", Format: FormatWiki, Prefix: prefix}
+
+	body, err := commentBody(opts)
+	if err != nil {
+		t.Fatalf("commentBody() error = %v", err)
+	}
+	if len(body.Content) != 2 || body.Content[0].Type != "blockquote" || body.Content[1].Type != "heading" {
+		t.Errorf("content = %+v, want the prefix then the converted body", body.Content)
+	}
+	if len(opts.Prefix) != 1 {
+		t.Errorf("commentBody() modified opts.Prefix")
+	}
+}
+
+func TestFindFieldByName(t *testing.T) {
+	fields := []*Field{
+		{ID: "summary", Name: "Summary"},
+		{ID: "customfield_10016", Name: "Story Points"},
+		{ID: "customfield_10020", Name: "Team"},
+		{ID: "customfield_10021", Name: "team"},
+	}
+
+	if f, err := FindFieldByName(fields, "story points"); err != nil || f == nil || f.ID != "customfield_10016" {
+		t.Errorf("FindFieldByName(story points) = %v, %v, want customfield_10016", f, err)
+	}
+	if f, err := FindFieldByName(fields, "Sprint"); err != nil || f != nil {
+		t.Errorf("FindFieldByName(Sprint) = %v, %v, want nil, nil", f, err)
+	}
+
+	_, err := FindFieldByName(fields, "Team")
+	var ambiguous *AmbiguousFieldError
+	if !errors.As(err, &ambiguous) || !slices.Equal(ambiguous.IDs, []string{"customfield_10020", "customfield_10021"}) {
+		t.Errorf("FindFieldByName(Team) error = %v, want an AmbiguousFieldError with both IDs", err)
+	}
+}
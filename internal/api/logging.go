@@ -0,0 +1,179 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogFileEnv is the environment variable that enables request/response
+// logging to a file. Unlike ATL_DEBUG, nothing is written to the terminal.
+const LogFileEnv = "ATL_LOG_FILE"
+
+// redacted replaces sensitive values in log output.
+const redacted = "[REDACTED]"
+
+// sensitiveKeys are JSON keys whose values are never written to the log.
+// Matching is by suffix, so "refresh_token" and "clientSecret" are covered.
+var sensitiveKeys = []string{
+	"token",
+	"secret",
+	"password",
+	"authorization",
+}
+
+// WithLogger sets a logger that receives one line per HTTP request.
+func WithLogger(logger *log.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// logFile is the ATL_LOG_FILE shared by every client in the process.
+var logFile struct {
+	mu     sync.Mutex
+	file   *os.File
+	logger *log.Logger
+}
+
+// OpenLogFile opens the log file named by ATL_LOG_FILE in append mode. Clients
+// created afterwards log to it until CloseLogFile is called. It does nothing
+// if the variable is unset or the file is already open.
+func OpenLogFile() error {
+	logFile.mu.Lock()
+	defer logFile.mu.Unlock()
+
+	path := os.Getenv(LogFileEnv)
+	if path == "" || logFile.file != nil {
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open log file %s: %w", path, err)
+	}
+
+	logFile.file = f
+	logFile.logger = log.New(f, "", log.LstdFlags|log.Lmicroseconds)
+	return nil
+}
+
+// CloseLogFile closes the log file opened by OpenLogFile, if any.
+func CloseLogFile() error {
+	logFile.mu.Lock()
+	defer logFile.mu.Unlock()
+
+	if logFile.file == nil {
+		return nil
+	}
+	err := logFile.file.Close()
+	logFile.file = nil
+	logFile.logger = nil
+	return err
+}
+
+// fileLogger returns the logger for the open log file, or nil.
+func fileLogger() *log.Logger {
+	logFile.mu.Lock()
+	defer logFile.mu.Unlock()
+	return logFile.logger
+}
+
+// logRequest writes a structured line describing a completed request.
+// The Authorization header is always redacted, and request bodies have
+// secret-looking values replaced. status is 0 if the request never got a response.
+func (c *Client) logRequest(req *http.Request, reqBody []byte, status int, duration time.Duration, reqErr error) {
+	if c.logger == nil {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "method=%s url=%q status=%d duration=%s", req.Method, req.URL.String(), status, duration.Round(time.Millisecond))
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		fmt.Fprintf(&b, " auth=%q", redactAuthHeader(auth))
+	}
+	if len(reqBody) > 0 {
+		fmt.Fprintf(&b, " body=%s", redactBody(reqBody))
+	}
+	if reqErr != nil {
+		fmt.Fprintf(&b, " error=%q", reqErr.Error())
+	}
+
+	c.logger.Println(b.String())
+}
+
+// redactAuthHeader keeps the auth scheme but hides the credential.
+func redactAuthHeader(value string) string {
+	if scheme, _, ok := strings.Cut(value, " "); ok {
+		return scheme + " " + redacted
+	}
+	return redacted
+}
+
+// redactBody returns a loggable version of a request body.
+// JSON bodies have the values of sensitive keys replaced; non-JSON bodies
+// that mention a sensitive key are dropped entirely.
+func redactBody(body []byte) string {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		lower := strings.ToLower(string(body))
+		for _, key := range sensitiveKeys {
+			if strings.Contains(lower, key) {
+				return redacted
+			}
+		}
+		return string(body)
+	}
+
+	out, err := json.Marshal(redactValue(data))
+	if err != nil {
+		return redacted
+	}
+	return string(out)
+}
+
+// redactValue walks decoded JSON and replaces values of sensitive keys.
+func redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, inner := range val {
+			if isSensitiveKey(k) {
+				val[k] = redacted
+				continue
+			}
+			val[k] = redactValue(inner)
+		}
+		return val
+	case []interface{}:
+		for i, inner := range val {
+			val[i] = redactValue(inner)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// isSensitiveKey reports whether a JSON key names a secret.
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.HasSuffix(lower, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// statusOf returns the response status code, or 0 for a nil response.
+func statusOf(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enthus-appdev/atl-cli/internal/auth"
+)
+
+// TestRequestLogRedactsAuthHeader verifies the bearer token never reaches the log file.
+func TestRequestLogRedactsAuthHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := &Client{
+		httpClient: server.Client(),
+		tokens: &auth.TokenSet{
+			AccessToken: "super-secret-token",
+			ExpiresAt:   time.Now().Add(time.Hour),
+		},
+		logger: log.New(&buf, "", 0),
+	}
+
+	body := map[string]string{"summary": "hello", "client_secret": "shh"}
+	if err := client.Post(context.Background(), server.URL+"/issue", body, nil); err != nil {
+		t.Fatalf("Client.Post() error = %v", err)
+	}
+
+	logged := buf.String()
+	if strings.Contains(logged, "super-secret-token") {
+		t.Errorf("log output contains access token: %s", logged)
+	}
+	if !strings.Contains(logged, `auth="Bearer [REDACTED]"`) {
+		t.Errorf("log output should contain redacted auth header, got: %s", logged)
+	}
+	if strings.Contains(logged, "shh") {
+		t.Errorf("log output contains client secret: %s", logged)
+	}
+	for _, want := range []string{"method=POST", "status=200", "duration=", "/issue", "hello"} {
+		if !strings.Contains(logged, want) {
+			t.Errorf("log output missing %q, got: %s", want, logged)
+		}
+	}
+}
+
+// TestRedactBody tests redaction of request bodies.
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "nested json secrets",
+			body:    `{"grant_type":"refresh_token","refresh_token":"abc","nested":{"clientSecret":"xyz"}}`,
+			want:    []string{`"grant_type":"refresh_token"`, redacted},
+			notWant: []string{"abc", "xyz"},
+		},
+		{
+			name: "plain json untouched",
+			body: `{"summary":"Fix bug"}`,
+			want: []string{`"summary":"Fix bug"`},
+		},
+		{
+			name:    "non-json with secret",
+			body:    "password=hunter2",
+			want:    []string{redacted},
+			notWant: []string{"hunter2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redactBody([]byte(tt.body))
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("redactBody() = %q, want it to contain %q", got, w)
+				}
+			}
+			for _, nw := range tt.notWant {
+				if strings.Contains(got, nw) {
+					t.Errorf("redactBody() = %q, should not contain %q", got, nw)
+				}
+			}
+		})
+	}
+}
+
+// TestOpenLogFile verifies the log file is opened once and shared until it
+// is closed.
+func TestOpenLogFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "atl.log")
+	t.Setenv(LogFileEnv, path)
+	t.Cleanup(func() { CloseLogFile() })
+
+	if err := OpenLogFile(); err != nil {
+		t.Fatalf("OpenLogFile() error = %v", err)
+	}
+	first := fileLogger()
+	if first == nil {
+		t.Fatal("fileLogger() = nil after OpenLogFile()")
+	}
+	if err := OpenLogFile(); err != nil {
+		t.Fatalf("second OpenLogFile() error = %v", err)
+	}
+	if fileLogger() != first {
+		t.Error("second OpenLogFile() should keep the open file")
+	}
+
+	first.Println("hello")
+	if err := CloseLogFile(); err != nil {
+		t.Fatalf("CloseLogFile() error = %v", err)
+	}
+	if fileLogger() != nil {
+		t.Error("fileLogger() should be nil after CloseLogFile()")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || !strings.Contains(string(data), "hello") {
+		t.Errorf("log file = %q, %v, want the logged line", data, err)
+	}
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ParsePageRef extracts a Confluence page ID from a bare ID, a page URL such
+// as https://example.atlassian.net/wiki/spaces/KEY/pages/12345/Title, a
+// viewpage.action?pageId=12345 URL, or a tiny link such as
+// https://example.atlassian.net/wiki/x/QQAB.
+//
+// Tiny link codes are the page ID encoded as little-endian base64, so they
+// are decoded locally without a request.
+func ParsePageRef(input string) (string, error) {
+	ref := strings.TrimSpace(input)
+	if ref == "" {
+		return "", fmt.Errorf("page ID or URL is empty")
+	}
+	if isPageID(ref) {
+		return ref, nil
+	}
+
+	u, err := url.Parse(ref)
+	if err != nil || (u.Host == "" && !strings.HasPrefix(u.Path, "/")) {
+		return "", fmt.Errorf("invalid page reference %q: expected a page ID, page URL, or tiny link", input)
+	}
+
+	if id := u.Query().Get("pageId"); isPageID(id) {
+		return id, nil
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, seg := range segments {
+		if i+1 >= len(segments) {
+			break
+		}
+		switch seg {
+		case "pages":
+			// /pages/12345/Title, or /pages/edit-v2/12345 from the editor.
+			for _, next := range segments[i+1 : min(i+3, len(segments))] {
+				if isPageID(next) {
+					return next, nil
+				}
+			}
+		case "x":
+			id, err := decodeTinyLink(segments[i+1])
+			if err != nil {
+				return "", fmt.Errorf("invalid tiny link %q: %w", input, err)
+			}
+			return id, nil
+		}
+	}
+
+	return "", fmt.Errorf("no page ID found in %q: expected a page ID, page URL, or tiny link", input)
+}
+
+// decodeTinyLink turns the code of a /x/<code> tiny link into a page ID.
+// Confluence builds the code by base64-encoding the ID's little-endian bytes,
+// using '-' and '_' for '/' and '+', and dropping trailing 'A' and '='
+// characters (zero bits).
+func decodeTinyLink(code string) (string, error) {
+	code = strings.TrimRight(code, "=")
+	if code == "" || len(code) > 11 {
+		return "", fmt.Errorf("unexpected code length")
+	}
+	padded := strings.NewReplacer("-", "/", "_", "+").Replace(code) + strings.Repeat("A", 11-len(code))
+	b, err := base64.RawStdEncoding.DecodeString(padded)
+	if err != nil {
+		return "", err
+	}
+	id := binary.LittleEndian.Uint64(b)
+	if id == 0 {
+		return "", fmt.Errorf("code decodes to page ID 0")
+	}
+	return strconv.FormatUint(id, 10), nil
+}
+
+func isPageID(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,38 @@
+package api
+
+import "testing"
+
+func TestParsePageRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "bare ID", input: "123456", want: "123456"},
+		{name: "bare ID with whitespace", input: " 123456\n", want: "123456"},
+		{name: "page URL", input: "https://acme.atlassian.net/wiki/spaces/DOCS/pages/123456/Getting+Started", want: "123456"},
+		{name: "page URL without title", input: "https://acme.atlassian.net/wiki/spaces/DOCS/pages/123456", want: "123456"},
+		{name: "editor URL", input: "https://acme.atlassian.net/wiki/spaces/DOCS/pages/edit-v2/123456", want: "123456"},
+		{name: "viewpage URL", input: "https://acme.atlassian.net/wiki/pages/viewpage.action?pageId=123456", want: "123456"},
+		{name: "tiny link", input: "https://acme.atlassian.net/wiki/x/QQAB", want: "65601"},
+		{name: "tiny link path", input: "/wiki/x/TmG8", want: "12345678"},
+		{name: "tiny link with URL-safe characters", input: "https://acme.atlassian.net/wiki/x/----", want: "16777215"},
+		{name: "empty", input: "", wantErr: true},
+		{name: "page title", input: "Getting Started", wantErr: true},
+		{name: "space URL", input: "https://acme.atlassian.net/wiki/spaces/DOCS/overview", wantErr: true},
+		{name: "tiny link too long", input: "https://acme.atlassian.net/wiki/x/ABCDEFGHIJKL", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePageRef(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePageRef(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePageRef(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
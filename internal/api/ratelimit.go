@@ -0,0 +1,157 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit is the rate-limit state Atlassian reported on the most recent
+// response. Zero values mean the header was not present.
+type RateLimit struct {
+	Limit      int           // X-RateLimit-Limit: requests allowed in the window
+	Remaining  int           // X-RateLimit-Remaining: requests left in the window
+	Reset      time.Time     // X-RateLimit-Reset: when the window resets
+	RetryAfter time.Duration // Retry-After: set when the request was throttled
+	NearLimit  bool          // X-RateLimit-NearLimit: less than 20% of the quota left
+}
+
+// lowRemainingRatio is the fraction of the quota below which Low reports true
+// when Atlassian doesn't send X-RateLimit-NearLimit.
+const lowRemainingRatio = 0.1
+
+// parseRateLimit extracts rate-limit headers from a response. Returns nil
+// when none are present, which is the norm for most Atlassian endpoints.
+func parseRateLimit(h http.Header) *RateLimit {
+	var rl RateLimit
+	found := false
+
+	if v, err := strconv.Atoi(h.Get("X-RateLimit-Limit")); err == nil {
+		rl.Limit = v
+		found = true
+	}
+	if v, err := strconv.Atoi(h.Get("X-RateLimit-Remaining")); err == nil {
+		rl.Remaining = v
+		found = true
+	}
+	if v := h.Get("X-RateLimit-Reset"); v != "" {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			rl.Reset = t
+			found = true
+		}
+	}
+	if v := h.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			rl.RetryAfter = time.Duration(secs) * time.Second
+			found = true
+		} else if t, err := http.ParseTime(v); err == nil {
+			rl.RetryAfter = time.Until(t)
+			found = true
+		}
+	}
+	if strings.EqualFold(h.Get("X-RateLimit-NearLimit"), "true") {
+		rl.NearLimit = true
+		found = true
+	}
+
+	if !found {
+		return nil
+	}
+	return &rl
+}
+
+// Low reports whether the quota is close to exhaustion or already exhausted.
+func (r *RateLimit) Low() bool {
+	if r == nil {
+		return false
+	}
+	if r.NearLimit || r.RetryAfter > 0 {
+		return true
+	}
+	return r.Limit > 0 && float64(r.Remaining) <= float64(r.Limit)*lowRemainingRatio
+}
+
+// Warning describes a low quota for display, or returns "" when the quota is
+// not low.
+func (r *RateLimit) Warning() string {
+	if !r.Low() {
+		return ""
+	}
+	if r.RetryAfter > 0 {
+		return fmt.Sprintf("Atlassian is rate limiting requests; retrying after %s", r.RetryAfter.Round(time.Second))
+	}
+
+	msg := "approaching the Atlassian API rate limit"
+	if r.Limit > 0 {
+		msg += fmt.Sprintf(" (%d of %d requests remaining)", r.Remaining, r.Limit)
+	}
+	if !r.Reset.IsZero() {
+		msg += fmt.Sprintf("; quota resets at %s", r.Reset.Local().Format("15:04:05"))
+	}
+	return msg + ". Further requests may be throttled"
+}
+
+// String summarizes the state for debug logging.
+func (r *RateLimit) String() string {
+	var parts []string
+	if r.Limit > 0 {
+		parts = append(parts, fmt.Sprintf("%d/%d remaining", r.Remaining, r.Limit))
+	}
+	if !r.Reset.IsZero() {
+		parts = append(parts, "resets "+r.Reset.Format(time.RFC3339))
+	}
+	if r.RetryAfter > 0 {
+		parts = append(parts, "retry after "+r.RetryAfter.String())
+	}
+	if r.NearLimit {
+		parts = append(parts, "near limit")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// recordRateLimit stores the rate-limit state from a response, if any, so
+// LastRateLimit can report it. Retry-After only describes the response that
+// carried it, so a later response without rate-limit headers clears it.
+func (c *Client) recordRateLimit(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+	rl := parseRateLimit(resp.Header)
+
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	if rl == nil {
+		if c.rateLimit != nil {
+			c.rateLimit.RetryAfter = 0
+		}
+		return
+	}
+	debugLog("Rate limit: %s", rl)
+	c.rateLimit = rl
+}
+
+// LastRateLimit returns the rate-limit state from the most recent response
+// that carried rate-limit headers, or nil if none has.
+func (c *Client) LastRateLimit() *RateLimit {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	if c.rateLimit == nil {
+		return nil
+	}
+	rl := *c.rateLimit
+	return &rl
+}
+
+// LastRateLimit returns the rate-limit state from the most recent Jira
+// response that carried rate-limit headers, or nil if none has.
+func (s *JiraService) LastRateLimit() *RateLimit {
+	return s.client.LastRateLimit()
+}
+
+// LastRateLimit returns the rate-limit state from the most recent Confluence
+// response that carried rate-limit headers, or nil if none has.
+func (s *ConfluenceService) LastRateLimit() *RateLimit {
+	return s.client.LastRateLimit()
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enthus-appdev/atl-cli/internal/auth"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	h := http.Header{}
+	if rl := parseRateLimit(h); rl != nil {
+		t.Errorf("parseRateLimit(no headers) = %+v, want nil", rl)
+	}
+
+	h.Set("X-RateLimit-Limit", "100")
+	h.Set("X-RateLimit-Remaining", "7")
+	h.Set("X-RateLimit-Reset", "2026-01-02T15:04:05Z")
+	h.Set("Retry-After", "30")
+	h.Set("X-RateLimit-NearLimit", "true")
+
+	rl := parseRateLimit(h)
+	if rl == nil {
+		t.Fatal("parseRateLimit() = nil, want rate limit")
+	}
+	if rl.Limit != 100 || rl.Remaining != 7 {
+		t.Errorf("Limit/Remaining = %d/%d, want 100/7", rl.Limit, rl.Remaining)
+	}
+	if want := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC); !rl.Reset.Equal(want) {
+		t.Errorf("Reset = %v, want %v", rl.Reset, want)
+	}
+	if rl.RetryAfter != 30*time.Second {
+		t.Errorf("RetryAfter = %v, want 30s", rl.RetryAfter)
+	}
+	if !rl.NearLimit {
+		t.Error("NearLimit = false, want true")
+	}
+}
+
+func TestRateLimitLow(t *testing.T) {
+	tests := []struct {
+		name string
+		rl   *RateLimit
+		want bool
+	}{
+		{"nil", nil, false},
+		{"plenty left", &RateLimit{Limit: 100, Remaining: 50}, false},
+		{"ten percent left", &RateLimit{Limit: 100, Remaining: 10}, true},
+		{"near limit header", &RateLimit{NearLimit: true}, true},
+		{"throttled", &RateLimit{RetryAfter: time.Second}, true},
+		{"remaining without limit", &RateLimit{Remaining: 0}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rl.Low(); got != tt.want {
+				t.Errorf("Low() = %v, want %v", got, tt.want)
+			}
+			if got := tt.rl.Warning() != ""; got != tt.want {
+				t.Errorf("Warning() non-empty = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientRecordsRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "500")
+		w.Header().Set("X-RateLimit-Remaining", "20")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		httpClient: server.Client(),
+		tokens: &auth.TokenSet{
+			AccessToken: "test-token",
+			ExpiresAt:   time.Now().Add(time.Hour),
+		},
+	}
+
+	if rl := client.LastRateLimit(); rl != nil {
+		t.Fatalf("LastRateLimit() before any request = %+v, want nil", rl)
+	}
+
+	if err := client.Get(context.Background(), server.URL, nil); err != nil {
+		t.Fatalf("Client.Get() error = %v", err)
+	}
+
+	rl := client.LastRateLimit()
+	if rl == nil {
+		t.Fatal("LastRateLimit() = nil after response with rate-limit headers")
+	}
+	if rl.Limit != 500 || rl.Remaining != 20 {
+		t.Errorf("LastRateLimit() = %d/%d, want 500/20", rl.Limit, rl.Remaining)
+	}
+	if !rl.Low() {
+		t.Error("Low() = false, want true with 4% of the quota left")
+	}
+	if msg := rl.Warning(); !strings.Contains(msg, "20 of 500") {
+		t.Errorf("Warning() = %q, want it to mention the remaining quota", msg)
+	}
+}
+
+func TestRecordRateLimitClearsRetryAfter(t *testing.T) {
+	client := &Client{}
+
+	throttled := &http.Response{Header: http.Header{}}
+	throttled.Header.Set("Retry-After", "30")
+	throttled.Header.Set("X-RateLimit-Limit", "500")
+	throttled.Header.Set("X-RateLimit-Remaining", "400")
+	client.recordRateLimit(throttled)
+	if rl := client.LastRateLimit(); rl == nil || rl.RetryAfter != 30*time.Second {
+		t.Fatalf("LastRateLimit() = %+v, want RetryAfter 30s", rl)
+	}
+
+	client.recordRateLimit(&http.Response{Header: http.Header{}})
+	rl := client.LastRateLimit()
+	if rl == nil || rl.RetryAfter != 0 {
+		t.Fatalf("LastRateLimit() after a normal response = %+v, want RetryAfter cleared", rl)
+	}
+	if rl.Limit != 500 {
+		t.Errorf("Limit = %d, want the last reported 500", rl.Limit)
+	}
+	if msg := rl.Warning(); msg != "" {
+		t.Errorf("Warning() = %q, want none once throttling is over", msg)
+	}
+}
@@ -6,9 +6,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
+// accessibleResourcesURL lists the sites an access token can reach.
+// A variable so tests can point it at a local server.
+var accessibleResourcesURL = AtlassianAPIURL + "/oauth/token/accessible-resources"
+
 // AccessibleResource represents an accessible Atlassian cloud resource.
 type AccessibleResource struct {
 	ID        string   `json:"id"`
@@ -23,7 +29,7 @@ type AccessibleResource struct {
 func GetAccessibleResources(ctx context.Context, accessToken string) ([]*AccessibleResource, error) {
 	httpClient := &http.Client{Timeout: 30 * time.Second}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, AtlassianAPIURL+"/oauth/token/accessible-resources", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, accessibleResourcesURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -53,3 +59,18 @@ func GetAccessibleResources(ctx context.Context, accessToken string) ([]*Accessi
 
 	return resources, nil
 }
+
+// FindAccessibleResource returns the resource whose site URL has the given
+// hostname, or nil if the token can't access that site.
+func FindAccessibleResource(resources []*AccessibleResource, hostname string) *AccessibleResource {
+	for _, r := range resources {
+		u, err := url.Parse(r.URL)
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(u.Host, hostname) {
+			return r
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetAccessibleResources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer test-token")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"id": "cloud-new", "url": "https://acme.atlassian.net", "name": "Acme", "scopes": ["read:jira-work"]},
+			{"id": "cloud-other", "url": "https://other.atlassian.net", "name": "Other", "scopes": []}
+		]`))
+	}))
+	defer server.Close()
+
+	orig := accessibleResourcesURL
+	accessibleResourcesURL = server.URL
+	defer func() { accessibleResourcesURL = orig }()
+
+	resources, err := GetAccessibleResources(context.Background(), "test-token")
+	if err != nil {
+		t.Fatalf("GetAccessibleResources() error = %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("GetAccessibleResources() returned %d resources, want 2", len(resources))
+	}
+
+	got := FindAccessibleResource(resources, "acme.atlassian.net")
+	if got == nil || got.ID != "cloud-new" {
+		t.Errorf("FindAccessibleResource(acme) = %+v, want cloud-new", got)
+	}
+	if got := FindAccessibleResource(resources, "missing.atlassian.net"); got != nil {
+		t.Errorf("FindAccessibleResource(missing) = %+v, want nil", got)
+	}
+}
+
+func TestGetAccessibleResourcesError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code":401,"message":"Unauthorized"}`))
+	}))
+	defer server.Close()
+
+	orig := accessibleResourcesURL
+	accessibleResourcesURL = server.URL
+	defer func() { accessibleResourcesURL = orig }()
+
+	if _, err := GetAccessibleResources(context.Background(), "expired"); err == nil {
+		t.Error("GetAccessibleResources() error = nil, want error for 401")
+	}
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Operation names a group of API calls that need the same OAuth scopes.
+type Operation string
+
+// Operations checked before the request is made.
+const (
+	OpConfluenceSearch    Operation = "Confluence search"
+	OpConfluencePageWrite Operation = "creating or updating Confluence pages"
+	OpJiraWrite           Operation = "changing Jira issues"
+	OpJiraCommentWrite    Operation = "adding Jira comments"
+)
+
+// operationScopes lists the scopes that allow each operation. Any one of
+// them is enough (a classic scope or its granular equivalent); the first is
+// the one atl auth setup asks for.
+var operationScopes = map[Operation][]string{
+	OpConfluenceSearch:    {"search:confluence"},
+	OpConfluencePageWrite: {"write:page:confluence", "write:confluence-content"},
+	OpJiraWrite:           {"write:jira-work", "write:issue:jira"},
+	OpJiraCommentWrite:    {"write:jira-work", "write:comment:jira"},
+}
+
+// MissingScopeError is returned when the stored token lacks a scope an
+// operation needs, instead of making a call that would fail with a 403.
+type MissingScopeError struct {
+	Operation Operation
+	Scope     string
+}
+
+func (e *MissingScopeError) Error() string {
+	return fmt.Sprintf("missing OAuth scope %s, needed for %s\n\n"+
+		"Re-run 'atl auth setup' and add %s to your OAuth app, then 'atl auth logout' and 'atl auth login' to get a token with it",
+		e.Scope, e.Operation, e.Scope)
+}
+
+// RequireScopes returns a *MissingScopeError if the token was granted
+// scopes but none that allow op. Tokens stored without scope information
+// are not checked.
+func (c *Client) RequireScopes(op Operation) error {
+	allowed := operationScopes[op]
+	granted := c.grantedScopes()
+	if len(allowed) == 0 || len(granted) == 0 {
+		return nil
+	}
+	for _, scope := range allowed {
+		if slices.Contains(granted, scope) {
+			return nil
+		}
+	}
+	return &MissingScopeError{Operation: op, Scope: allowed[0]}
+}
+
+// grantedScopes returns the non-empty scopes recorded on the token.
+func (c *Client) grantedScopes() []string {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.tokens == nil {
+		return nil
+	}
+	var scopes []string
+	for _, s := range c.tokens.Scopes {
+		if s != "" {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/auth"
+)
+
+func TestSearchWithCQLMissingScope(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	client.tokens.Scopes = []string{"read:jira-work", "read:page:confluence", "offline_access"}
+
+	_, err := NewConfluenceService(client).SearchWithCQL(context.Background(), "type = page", 10, "")
+
+	var scopeErr *MissingScopeError
+	if !errors.As(err, &scopeErr) {
+		t.Fatalf("SearchWithCQL() error = %v, want *MissingScopeError", err)
+	}
+	if scopeErr.Scope != "search:confluence" {
+		t.Errorf("Scope = %q, want search:confluence", scopeErr.Scope)
+	}
+	for _, want := range []string{"missing OAuth scope search:confluence", "Confluence search", "atl auth setup"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err.Error(), want)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("made %d requests, want none", requests)
+	}
+}
+
+func TestRequireScopes(t *testing.T) {
+	tests := []struct {
+		name    string
+		scopes  []string
+		op      Operation
+		wantErr bool
+	}{
+		{name: "classic scope granted", scopes: []string{"write:jira-work"}, op: OpJiraWrite},
+		{name: "granular alternative granted", scopes: []string{"write:issue:jira"}, op: OpJiraWrite},
+		{name: "missing", scopes: []string{"read:jira-work"}, op: OpJiraWrite, wantErr: true},
+		{name: "classic scope for comments", scopes: []string{"write:jira-work"}, op: OpJiraCommentWrite},
+		{name: "granular comment scope", scopes: []string{"write:comment:jira"}, op: OpJiraCommentWrite},
+		{name: "issue scope does not allow comments", scopes: []string{"write:issue:jira"}, op: OpJiraCommentWrite, wantErr: true},
+		{name: "token without scope information", scopes: nil, op: OpConfluenceSearch},
+		{name: "empty scope string from token response", scopes: []string{""}, op: OpConfluencePageWrite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{tokens: &auth.TokenSet{Scopes: tt.scopes}}
+			err := client.RequireScopes(tt.op)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RequireScopes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
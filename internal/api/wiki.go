@@ -0,0 +1,412 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Text formats accepted by BodyToADF.
+const (
+	FormatMarkdown = "markdown"
+	FormatWiki     = "wiki"
+)
+
+// CheckBodyFormat returns an error if format is not one BodyToADF accepts.
+func CheckBodyFormat(format string) error {
+	switch format {
+	case "", FormatMarkdown, FormatWiki:
+		return nil
+	}
+	return fmt.Errorf("invalid format %q: must be %s or %s", format, FormatMarkdown, FormatWiki)
+}
+
+// BodyToADF converts an issue description or comment to Atlassian Document
+// Format. format is FormatMarkdown (also the default when empty) or
+// FormatWiki for Jira's legacy wiki markup.
+func BodyToADF(text, format string) (*ADF, error) {
+	if err := CheckBodyFormat(format); err != nil {
+		return nil, err
+	}
+	if format == FormatWiki {
+		return WikiMarkupToADF(text), nil
+	}
+	return MarkdownToADF(text), nil
+}
+
+// WikiMarkupToADF converts Jira wiki markup, the text format used before
+// ADF, to Atlassian Document Format.
+// Supports:
+//   - Headings: h1. to h6.
+//   - Bold: *text*
+//   - Italic: _text_
+//   - Strikethrough: -text-
+//   - Underline: +text+
+//   - Monospace: {{text}}
+//   - Code blocks: {code}...{code}, {code:java}...{code}, {noformat}...{noformat}
+//   - Quotes: {quote}...{quote} and bq. text
+//   - Bullet lists: * item or - item, ** for nesting
+//   - Numbered lists: # item, ## for nesting (mixed, e.g. #*, too)
+//   - Links: [url] or [text|url]
+//   - Horizontal rules: ----
+//
+// Line breaks inside a paragraph are kept, as Jira renders them.
+func WikiMarkupToADF(text string) *ADF {
+	content := []ADFContent{}
+	if text != "" {
+		content = parseWikiBlocks(strings.Split(text, "\n"))
+	}
+
+	return &ADF{
+		Type:    "doc",
+		Version: 1,
+		Content: content,
+	}
+}
+
+var (
+	wikiHeadingPattern = regexp.MustCompile(`^h([1-6])\.\s*(.*)$`)
+	wikiListPattern    = regexp.MustCompile(`^([*#]+|-)\s+(.*)$`)
+	wikiMacroPattern   = regexp.MustCompile(`^\{(code|noformat|quote)(?::([^}]*))?\}`)
+)
+
+// parseWikiBlocks parses block-level wiki markup.
+func parseWikiBlocks(lines []string) []ADFContent {
+	var content []ADFContent
+	i := 0
+
+	for i < len(lines) {
+		trimmed := strings.TrimSpace(lines[i])
+
+		if trimmed == "" {
+			i++
+			continue
+		}
+
+		// {code}, {noformat}, {quote}
+		if m := wikiMacroPattern.FindStringSubmatch(trimmed); m != nil {
+			body, consumed := collectWikiMacro(lines, i, m[1])
+			switch m[1] {
+			case "quote":
+				content = append(content, ADFContent{Type: "blockquote", Content: wikiQuoteContent(body)})
+			default:
+				block := ADFContent{
+					Type:    "codeBlock",
+					Content: []ADFContent{{Type: "text", Text: strings.Join(body, "\n")}},
+				}
+				if lang := wikiCodeLanguage(m[1], m[2]); lang != "" {
+					block.Attrs = &ADFAttrs{Language: lang}
+				}
+				if block.Content[0].Text == "" {
+					block.Content = nil // ADF text nodes must not be empty
+				}
+				content = append(content, block)
+			}
+			i += consumed
+			continue
+		}
+
+		if m := wikiHeadingPattern.FindStringSubmatch(trimmed); m != nil {
+			content = append(content, ADFContent{
+				Type:    "heading",
+				Attrs:   &ADFAttrs{Level: int(m[1][0] - '0')},
+				Content: parseWikiInline(strings.TrimSpace(m[2])),
+			})
+			i++
+			continue
+		}
+
+		if quoted, ok := strings.CutPrefix(trimmed, "bq. "); ok {
+			content = append(content, ADFContent{
+				Type:    "blockquote",
+				Content: []ADFContent{{Type: "paragraph", Content: parseWikiInline(strings.TrimSpace(quoted))}},
+			})
+			i++
+			continue
+		}
+
+		if trimmed == "----" {
+			content = append(content, ADFContent{Type: "rule"})
+			i++
+			continue
+		}
+
+		if wikiListPattern.MatchString(trimmed) {
+			block, consumed := parseWikiList(lines, i)
+			content = append(content, block)
+			i += consumed
+			continue
+		}
+
+		para, consumed := parseWikiParagraph(lines, i)
+		content = append(content, para)
+		i += consumed
+	}
+
+	return content
+}
+
+// collectWikiMacro returns the lines between a {name} opening tag on line
+// start and the matching closing {name}, and the number of lines consumed.
+// Text after the opening tag or before the closing tag on the same line is
+// part of the body. An unclosed macro runs to the end of the input.
+func collectWikiMacro(lines []string, start int, name string) ([]string, int) {
+	closing := "{" + name + "}"
+
+	first := strings.TrimSpace(lines[start])
+	first = first[len(wikiMacroPattern.FindString(first)):]
+	if before, _, found := strings.Cut(first, closing); found {
+		return nonEmptyLines(before), 1
+	}
+
+	body := nonEmptyLines(first)
+	i := start + 1
+	for i < len(lines) {
+		if before, _, found := strings.Cut(lines[i], closing); found {
+			if strings.TrimSpace(before) != "" {
+				body = append(body, before)
+			}
+			return body, i - start + 1
+		}
+		body = append(body, lines[i])
+		i++
+	}
+	return body, i - start
+}
+
+// nonEmptyLines returns s as a single line, or nothing if it is blank.
+func nonEmptyLines(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	return []string{s}
+}
+
+// wikiCodeLanguage returns the language of a {code:...} macro, given either
+// as the first parameter ({code:java}) or as language=java.
+func wikiCodeLanguage(name, params string) string {
+	if name != "code" || params == "" {
+		return ""
+	}
+	for _, param := range strings.Split(params, "|") {
+		key, value, hasValue := strings.Cut(param, "=")
+		switch {
+		case !hasValue:
+			return strings.TrimSpace(key)
+		case strings.TrimSpace(key) == "language" || strings.TrimSpace(key) == "lang":
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// wikiQuoteContent parses the body of a {quote} macro. A blockquote needs at
+// least one block, so an empty quote gets an empty paragraph.
+func wikiQuoteContent(body []string) []ADFContent {
+	content := parseWikiBlocks(body)
+	if len(content) == 0 {
+		return []ADFContent{{Type: "paragraph"}}
+	}
+	return content
+}
+
+// wikiListItem is a list line: its markers (e.g. "#*") and text.
+type wikiListItem struct {
+	markers string
+	text    string
+}
+
+// parseWikiList parses consecutive list lines into a (possibly nested) list.
+func parseWikiList(lines []string, start int) (ADFContent, int) {
+	var items []wikiListItem
+	i := start
+	for i < len(lines) {
+		m := wikiListPattern.FindStringSubmatch(strings.TrimSpace(lines[i]))
+		if m == nil {
+			break
+		}
+		items = append(items, wikiListItem{markers: m[1], text: m[2]})
+		i++
+	}
+
+	list, _ := buildWikiList(items, 1)
+	return list, i - start
+}
+
+// buildWikiList builds the list at the given nesting depth from items,
+// nesting deeper items under the item before them. It returns the list and
+// the number of items used. The list type is taken from the first item's
+// marker at this depth.
+func buildWikiList(items []wikiListItem, depth int) (ADFContent, int) {
+	list := ADFContent{Type: "bulletList"}
+	if items[0].markers[min(depth, len(items[0].markers))-1] == '#' {
+		list.Type = "orderedList"
+	}
+
+	i := 0
+	for i < len(items) && len(items[i].markers) >= depth {
+		if len(items[i].markers) == depth {
+			list.Content = append(list.Content, ADFContent{
+				Type:    "listItem",
+				Content: []ADFContent{{Type: "paragraph", Content: parseWikiInline(items[i].text)}},
+			})
+			i++
+			continue
+		}
+
+		nested, consumed := buildWikiList(items[i:], depth+1)
+		if len(list.Content) == 0 {
+			// Skipped a level (e.g. ** first); hold the nested list in an empty item.
+			list.Content = append(list.Content, ADFContent{Type: "listItem", Content: []ADFContent{{Type: "paragraph"}}})
+		}
+		last := &list.Content[len(list.Content)-1]
+		last.Content = append(last.Content, nested)
+		i += consumed
+	}
+
+	return list, i
+}
+
+// parseWikiParagraph parses consecutive non-empty lines up to the next
+// block element, keeping the line breaks between them.
+func parseWikiParagraph(lines []string, start int) (ADFContent, int) {
+	var content []ADFContent
+	i := start
+
+	for i < len(lines) {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" {
+			break
+		}
+		if i > start && (wikiMacroPattern.MatchString(trimmed) ||
+			wikiHeadingPattern.MatchString(trimmed) ||
+			wikiListPattern.MatchString(trimmed) ||
+			strings.HasPrefix(trimmed, "bq. ") ||
+			trimmed == "----") {
+			break
+		}
+
+		if i > start {
+			content = append(content, ADFContent{Type: "hardBreak"})
+		}
+		content = append(content, parseWikiInline(trimmed)...)
+		i++
+	}
+
+	return ADFContent{
+		Type:    "paragraph",
+		Content: content,
+	}, i - start
+}
+
+// wikiMarks maps the wiki emphasis delimiters to ADF marks.
+var wikiMarks = map[byte]string{
+	'*': "strong",
+	'_': "em",
+	'-': "strike",
+	'+': "underline",
+}
+
+var (
+	wikiMonospacePattern = regexp.MustCompile(`^\{\{(.+?)\}\}`)
+	wikiLinkPattern      = regexp.MustCompile(`^\[([^\]|]*)\|([^\]]+)\]`)
+	wikiBareLinkPattern  = regexp.MustCompile(`^\[([^\]|]+)\]`)
+)
+
+// parseWikiInline parses inline wiki markup (emphasis, monospace, links).
+func parseWikiInline(text string) []ADFContent {
+	var content []ADFContent
+	remaining := text
+	prev := ' ' // the character before remaining, for word-boundary checks
+
+	addText := func(s string) {
+		if len(content) > 0 && content[len(content)-1].Type == "text" && len(content[len(content)-1].Marks) == 0 {
+			content[len(content)-1].Text += s
+			return
+		}
+		content = append(content, ADFContent{Type: "text", Text: s})
+	}
+
+	for len(remaining) > 0 {
+		consumed := 0
+
+		switch remaining[0] {
+		case '{':
+			if m := wikiMonospacePattern.FindStringSubmatch(remaining); m != nil {
+				content = append(content, ADFContent{Type: "text", Text: m[1], Marks: []ADFMark{{Type: "code"}}})
+				consumed = len(m[0])
+			}
+		case '[':
+			if m := wikiLinkPattern.FindStringSubmatch(remaining); m != nil {
+				label := m[1]
+				if label == "" {
+					label = m[2]
+				}
+				link := ADFMark{Type: "link", Attrs: &ADFAttrs{Href: strings.TrimSpace(m[2])}}
+				content = append(content, addMarkToContent(parseWikiInline(label), link)...)
+				consumed = len(m[0])
+			} else if m := wikiBareLinkPattern.FindStringSubmatch(remaining); m != nil && isWikiURL(m[1]) {
+				content = append(content, ADFContent{
+					Type:  "text",
+					Text:  m[1],
+					Marks: []ADFMark{{Type: "link", Attrs: &ADFAttrs{Href: m[1]}}},
+				})
+				consumed = len(m[0])
+			}
+		default:
+			if mark, ok := wikiMarks[remaining[0]]; ok && !isWordChar(prev) {
+				if inner, n := wikiEmphasis(remaining); n > 0 {
+					content = append(content, addMarkToContent(parseWikiInline(inner), ADFMark{Type: mark})...)
+					consumed = n
+				}
+			}
+		}
+
+		if consumed == 0 {
+			// Plain text up to the next character that may start markup
+			consumed = len(remaining)
+			if idx := strings.IndexAny(remaining[1:], "{[*_-+"); idx >= 0 {
+				consumed = idx + 1
+			}
+			addText(remaining[:consumed])
+		}
+
+		prev, _ = utf8.DecodeLastRuneInString(remaining[:consumed])
+		remaining = remaining[consumed:]
+	}
+
+	return content
+}
+
+// wikiEmphasis matches emphasis such as *bold* at the start of s and
+// returns the text inside and the length of the match, or 0 if there is
+// none. As in Jira, the text must not start or end with a space and the
+// closing delimiter must not be followed by a letter or digit.
+func wikiEmphasis(s string) (string, int) {
+	delim := s[0]
+	if len(s) < 3 || s[1] == ' ' || s[1] == delim {
+		return "", 0
+	}
+	for i := 2; i < len(s); i++ {
+		if s[i] != delim || s[i-1] == ' ' {
+			continue
+		}
+		if i+1 < len(s) && isWordChar(rune(s[i+1])) {
+			continue
+		}
+		return s[1:i], i + 1
+	}
+	return "", 0
+}
+
+// isWikiURL reports whether a bare [link] target is a URL rather than, for
+// example, a [~user] mention, which is left as text.
+func isWikiURL(s string) bool {
+	return strings.Contains(s, "://") || strings.HasPrefix(s, "mailto:")
+}
+
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
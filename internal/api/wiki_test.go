@@ -0,0 +1,207 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWikiMarkupToADF_Headings(t *testing.T) {
+	adf := WikiMarkupToADF("h2. Steps to reproduce\nh6.Tiny")
+
+	if len(adf.Content) != 2 {
+		t.Fatalf("expected 2 blocks, got %d", len(adf.Content))
+	}
+	heading := adf.Content[0]
+	if heading.Type != "heading" || heading.Attrs == nil || heading.Attrs.Level != 2 {
+		t.Fatalf("expected h2 heading, got %+v", heading)
+	}
+	if len(heading.Content) != 1 || heading.Content[0].Text != "Steps to reproduce" {
+		t.Errorf("heading content = %+v, want 'Steps to reproduce'", heading.Content)
+	}
+	if adf.Content[1].Attrs == nil || adf.Content[1].Attrs.Level != 6 {
+		t.Errorf("expected h6 heading, got %+v", adf.Content[1])
+	}
+}
+
+func TestWikiMarkupToADF_CodeBlocks(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantLang string
+		wantText string
+	}{
+		{
+			name:     "plain",
+			input:    "{code}\nfunc main() {\n  *not bold*\n}\n{code}",
+			wantText: "func main() {\n  *not bold*\n}",
+		},
+		{
+			name:     "language",
+			input:    "{code:java}\nSystem.out.println(\"hi\");\n{code}",
+			wantLang: "java",
+			wantText: "System.out.println(\"hi\");",
+		},
+		{
+			name:     "language parameter",
+			input:    "{code:title=Main.go|language=go}\npackage main\n{code}",
+			wantLang: "go",
+			wantText: "package main",
+		},
+		{
+			name:     "single line",
+			input:    "{code}x := 1{code}",
+			wantText: "x := 1",
+		},
+		{
+			name:     "noformat",
+			input:    "{noformat}\nh1. not a heading\n{noformat}",
+			wantText: "h1. not a heading",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adf := WikiMarkupToADF(tt.input)
+			if len(adf.Content) != 1 {
+				t.Fatalf("expected 1 block, got %d: %+v", len(adf.Content), adf.Content)
+			}
+			block := adf.Content[0]
+			if block.Type != "codeBlock" {
+				t.Fatalf("expected codeBlock, got %q", block.Type)
+			}
+			lang := ""
+			if block.Attrs != nil {
+				lang = block.Attrs.Language
+			}
+			if lang != tt.wantLang {
+				t.Errorf("language = %q, want %q", lang, tt.wantLang)
+			}
+			if len(block.Content) != 1 || block.Content[0].Text != tt.wantText {
+				t.Errorf("code = %+v, want %q", block.Content, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestWikiMarkupToADF_CodeBlockBetweenParagraphs(t *testing.T) {
+	adf := WikiMarkupToADF("Run this:\n{code:bash}\nmake test\n{code}\nThen check the output.")
+
+	var types []string
+	for _, block := range adf.Content {
+		types = append(types, block.Type)
+	}
+	if len(types) != 3 || types[0] != "paragraph" || types[1] != "codeBlock" || types[2] != "paragraph" {
+		t.Errorf("blocks = %v, want [paragraph codeBlock paragraph]", types)
+	}
+}
+
+func TestWikiMarkupToADF_Inline(t *testing.T) {
+	adf := WikiMarkupToADF("*bold* _italic_ -gone- +under+ {{mono}} [docs|https://example.com] [https://example.org] well-known [~jdoe]")
+
+	para := adf.Content[0]
+	want := []struct {
+		text string
+		mark string
+		href string
+	}{
+		{"bold", "strong", ""},
+		{" ", "", ""},
+		{"italic", "em", ""},
+		{" ", "", ""},
+		{"gone", "strike", ""},
+		{" ", "", ""},
+		{"under", "underline", ""},
+		{" ", "", ""},
+		{"mono", "code", ""},
+		{" ", "", ""},
+		{"docs", "link", "https://example.com"},
+		{" ", "", ""},
+		{"https://example.org", "link", "https://example.org"},
+		{" well-known [~jdoe]", "", ""},
+	}
+
+	if len(para.Content) != len(want) {
+		data, _ := json.Marshal(para.Content)
+		t.Fatalf("expected %d nodes, got %d: %s", len(want), len(para.Content), data)
+	}
+	for i, w := range want {
+		node := para.Content[i]
+		if node.Text != w.text {
+			t.Errorf("node %d text = %q, want %q", i, node.Text, w.text)
+		}
+		if w.mark == "" {
+			if len(node.Marks) != 0 {
+				t.Errorf("node %d marks = %+v, want none", i, node.Marks)
+			}
+			continue
+		}
+		if len(node.Marks) != 1 || node.Marks[0].Type != w.mark {
+			t.Errorf("node %d marks = %+v, want %s", i, node.Marks, w.mark)
+			continue
+		}
+		if w.href != "" && (node.Marks[0].Attrs == nil || node.Marks[0].Attrs.Href != w.href) {
+			t.Errorf("node %d href = %+v, want %s", i, node.Marks[0].Attrs, w.href)
+		}
+	}
+}
+
+func TestWikiMarkupToADF_NestedLists(t *testing.T) {
+	adf := WikiMarkupToADF("# First\n## First.a\n# Second\n#* Bullet under second")
+
+	if len(adf.Content) != 1 || adf.Content[0].Type != "orderedList" {
+		t.Fatalf("expected one orderedList, got %+v", adf.Content)
+	}
+	items := adf.Content[0].Content
+	if len(items) != 2 {
+		t.Fatalf("expected 2 top-level items, got %d", len(items))
+	}
+	if len(items[0].Content) != 2 || items[0].Content[1].Type != "orderedList" {
+		t.Errorf("first item = %+v, want a paragraph and a nested orderedList", items[0].Content)
+	}
+	if len(items[1].Content) != 2 || items[1].Content[1].Type != "bulletList" {
+		t.Errorf("second item = %+v, want a paragraph and a nested bulletList", items[1].Content)
+	}
+	if err := ValidateADF(adf); err != nil {
+		t.Errorf("ValidateADF() error = %v", err)
+	}
+}
+
+func TestWikiMarkupToADF_QuoteAndRule(t *testing.T) {
+	adf := WikiMarkupToADF("{quote}\nIt *works* on my machine.\n{quote}\n----\nbq. Short quote")
+
+	if len(adf.Content) != 3 {
+		t.Fatalf("expected 3 blocks, got %d: %+v", len(adf.Content), adf.Content)
+	}
+	if adf.Content[0].Type != "blockquote" || adf.Content[0].Content[0].Type != "paragraph" {
+		t.Errorf("expected blockquote with a paragraph, got %+v", adf.Content[0])
+	}
+	if adf.Content[1].Type != "rule" {
+		t.Errorf("expected rule, got %q", adf.Content[1].Type)
+	}
+	if adf.Content[2].Type != "blockquote" {
+		t.Errorf("expected blockquote for bq., got %q", adf.Content[2].Type)
+	}
+}
+
+func TestWikiMarkupToADF_LineBreaks(t *testing.T) {
+	adf := WikiMarkupToADF("line one\nline two")
+
+	para := adf.Content[0]
+	if len(para.Content) != 3 || para.Content[1].Type != "hardBreak" {
+		t.Errorf("paragraph = %+v, want text, hardBreak, text", para.Content)
+	}
+}
+
+func TestBodyToADF(t *testing.T) {
+	md, err := BodyToADF("# Title", "")
+	if err != nil || md.Content[0].Type != "heading" {
+		t.Errorf("BodyToADF(markdown default) = %+v, %v", md, err)
+	}
+	wiki, err := BodyToADF("h1. Title", FormatWiki)
+	if err != nil || wiki.Content[0].Type != "heading" {
+		t.Errorf("BodyToADF(wiki) = %+v, %v", wiki, err)
+	}
+	if _, err := BodyToADF("text", "textile"); err == nil {
+		t.Error("BodyToADF(textile) should return an error")
+	}
+}
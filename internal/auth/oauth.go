@@ -62,6 +62,8 @@ func DefaultScopes() []string {
 		"read:content.metadata:confluence",
 		"read:content-details:confluence",
 		"read:hierarchical-content:confluence",
+		"read:comment:confluence",
+		"write:comment:confluence",
 		// Confluence template scopes (v1 API)
 		"read:template:confluence",
 		"write:template:confluence",
@@ -226,17 +228,25 @@ func (f *OAuthFlow) State() string {
 	return f.state
 }
 
-// DefaultCallbackPort is the port used for the OAuth callback server.
+// DefaultCallbackPort is the port used for the OAuth callback server when
+// none is configured.
 const DefaultCallbackPort = 8085
 
+// CallbackURL returns the redirect URI for a callback port. It must match a
+// callback URL registered in the OAuth app exactly.
+func CallbackURL(port int) string {
+	return fmt.Sprintf("http://localhost:%d/callback", port)
+}
+
 // StartCallbackServer starts a local HTTP server to receive the OAuth callback.
-// It listens on the default callback port (8085) which must match the OAuth app configuration.
+// It listens on the given port, whose CallbackURL must be registered in the
+// OAuth app. Listening happens before it returns, so a port that is already
+// in use is reported before the browser is opened.
 // Returns the server, the port it's listening on, and any error.
-func StartCallbackServer(codeChan chan<- string, errChan chan<- error, expectedState string) (*http.Server, int, error) {
-	port := DefaultCallbackPort
+func StartCallbackServer(port int, codeChan chan<- string, errChan chan<- error, expectedState string) (*http.Server, int, error) {
 	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to start callback server on port %d: %w", port, err)
+		return nil, 0, fmt.Errorf("port %d is not available: %w", port, err)
 	}
 
 	mux := http.NewServeMux()
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// freePort returns a port that nothing is listening on.
+func freePort(t *testing.T) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// TestStartCallbackServerCustomPort tests that the callback server listens on
+// the requested port and passes the code on.
+func TestStartCallbackServerCustomPort(t *testing.T) {
+	port := freePort(t)
+	codeChan := make(chan string, 1)
+	errChan := make(chan error, 1)
+
+	server, got, err := StartCallbackServer(port, codeChan, errChan, "state-123")
+	if err != nil {
+		t.Fatalf("StartCallbackServer() error = %v", err)
+	}
+	defer server.Shutdown(context.Background())
+
+	if got != port {
+		t.Errorf("port = %d, want %d", got, port)
+	}
+
+	resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/callback?state=state-123&code=abc", port))
+	if err != nil {
+		t.Fatalf("callback request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	select {
+	case code := <-codeChan:
+		if code != "abc" {
+			t.Errorf("code = %q, want abc", code)
+		}
+	case err := <-errChan:
+		t.Fatalf("callback error: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no code received")
+	}
+}
+
+// TestStartCallbackServerPortInUse tests that a taken port is reported
+// instead of silently picking another one.
+func TestStartCallbackServerPortInUse(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	port := l.Addr().(*net.TCPAddr).Port
+
+	if _, _, err := StartCallbackServer(port, make(chan string, 1), make(chan error, 1), "state"); err == nil {
+		t.Error("StartCallbackServer() on a port in use should return an error")
+	}
+}
+
+func TestCallbackURL(t *testing.T) {
+	if got := CallbackURL(9000); got != "http://localhost:9000/callback" {
+		t.Errorf("CallbackURL(9000) = %q", got)
+	}
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+// APIOptions holds the options for the api command.
+type APIOptions struct {
+	IO      *iostreams.IOStreams
+	Method  string
+	Path    string
+	Data    string
+	Product string
+}
+
+// NewCmdAPI creates the api command.
+func NewCmdAPI(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &APIOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "api <method> <path>",
+		Short: "Make an authenticated request to any Jira or Confluence endpoint",
+		Long: `Make an authenticated REST request and print the raw JSON response.
+
+This is an escape hatch for endpoints that atl does not wrap yet. The path is
+relative to your configured Atlassian site, exactly as shown in the Jira and
+Confluence REST API docs. Paths starting with /wiki/ go to Confluence; all
+others go to Jira. Use --product to override.
+
+The response is printed as returned by the API, indented when writing to a
+terminal.`,
+		Example: `  # Get the current user
+  atl api GET /rest/api/3/myself
+
+  # Query Confluence
+  atl api GET "/wiki/api/v2/spaces?limit=5"
+
+  # Send a request body from a file
+  atl api POST /rest/api/3/issue/PROJ-1/comment --data @comment.json
+
+  # Send a request body inline or from stdin
+  atl api PUT /rest/api/3/issue/PROJ-1 --data '{"fields":{"summary":"New"}}'
+  cat body.json | atl api POST /rest/api/3/search/jql --data @-`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Method = strings.ToUpper(args[0])
+			opts.Path = args[1]
+
+			switch opts.Method {
+			case "GET", "POST", "PUT", "PATCH", "DELETE":
+			default:
+				return fmt.Errorf("unsupported method: %s (expected GET, POST, PUT, PATCH, or DELETE)", args[0])
+			}
+			if strings.Contains(opts.Path, "://") {
+				return fmt.Errorf("path must be relative to your site (e.g. /rest/api/3/myself), not a full URL")
+			}
+			if opts.Product == "" {
+				opts.Product = detectProduct(opts.Path)
+			}
+			return runAPI(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Data, "data", "d", "", "JSON request body (use @file to read from a file, @- for stdin)")
+	cmd.Flags().StringVarP(&opts.Product, "product", "p", "", "Product the path belongs to: jira or confluence (default: from path)")
+
+	return cmd
+}
+
+func runAPI(ctx context.Context, opts *APIOptions) error {
+	var body interface{}
+	if opts.Data != "" {
+		data, err := opts.IO.ReadArg(opts.Data)
+		if err != nil {
+			return err
+		}
+		if !json.Valid(data) {
+			return fmt.Errorf("--data is not valid JSON")
+		}
+		body = json.RawMessage(data)
+	}
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	url, err := client.ProductURL(opts.Product, opts.Path)
+	if err != nil {
+		return err
+	}
+
+	var result json.RawMessage
+	if err := client.Request(ctx, opts.Method, url, body, &result); err != nil {
+		return err
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	if opts.IO.IsStdoutTTY {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, result, "", "  "); err == nil {
+			result = indented.Bytes()
+		}
+	}
+
+	fmt.Fprintln(opts.IO.Out, string(result))
+	return nil
+}
+
+// detectProduct infers the product from an API path.
+func detectProduct(path string) string {
+	if strings.HasPrefix(strings.TrimPrefix(path, "/"), "wiki/") {
+		return "confluence"
+	}
+	return "jira"
+}
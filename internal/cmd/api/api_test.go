@@ -0,0 +1,25 @@
+package api
+
+import (
+	"testing"
+)
+
+func TestDetectProduct(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/rest/api/3/myself", "jira"},
+		{"/rest/agile/1.0/board", "jira"},
+		{"/wiki/api/v2/spaces", "confluence"},
+		{"wiki/rest/api/content/123", "confluence"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := detectProduct(tt.path); got != tt.want {
+				t.Errorf("detectProduct(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ func NewCmdAuth(ios *iostreams.IOStreams) *cobra.Command {
 	cmd.AddCommand(NewCmdLogout(ios))
 	cmd.AddCommand(NewCmdStatus(ios))
 	cmd.AddCommand(NewCmdRefresh(ios))
+	cmd.AddCommand(NewCmdSync(ios))
 
 	return cmd
 }
@@ -18,9 +18,10 @@ import (
 
 // LoginOptions holds the options for the login command.
 type LoginOptions struct {
-	IO       *iostreams.IOStreams
-	Hostname string
-	Scopes   []string
+	IO           *iostreams.IOStreams
+	Hostname     string
+	Scopes       []string
+	CallbackPort int
 }
 
 // NewCmdLogin creates the login command.
@@ -36,24 +37,39 @@ func NewCmdLogin(ios *iostreams.IOStreams) *cobra.Command {
 
 This will open a browser window where you can authorize the CLI to access
 your Atlassian account. The authorization tokens are stored securely in
-your system's keychain/credential manager.`,
+your system's keychain/credential manager.
+
+The browser returns to a local callback server on port 8085. If that port is
+taken or your OAuth app has a different callback URL registered, pick
+another port with --callback-port or the oauth.callback_port config key;
+the callback URL to register is printed before the browser opens.`,
 		Example: `  # Login to your Atlassian instance
   atl auth login
 
   # Login to a specific instance
-  atl auth login --hostname mycompany.atlassian.net`,
+  atl auth login --hostname mycompany.atlassian.net
+
+  # Use a different callback port (register http://localhost:9000/callback)
+  atl auth login --callback-port 9000`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runLogin(opts)
+			if cmd.Flags().Changed("callback-port") && (opts.CallbackPort < 1 || opts.CallbackPort > 65535) {
+				return fmt.Errorf("--callback-port must be between 1 and 65535")
+			}
+			return runLogin(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().StringVar(&opts.Hostname, "hostname", "", "The hostname of the Atlassian instance to authenticate with")
 	cmd.Flags().StringSliceVar(&opts.Scopes, "scopes", nil, "Additional OAuth scopes to request")
+	cmd.Flags().IntVar(&opts.CallbackPort, "callback-port", 0, "Port for the OAuth callback server (default: oauth.callback_port or 8085)")
 
 	return cmd
 }
 
-func runLogin(opts *LoginOptions) error {
+func runLogin(ctx context.Context, opts *LoginOptions) error {
+	// Login waits for the browser callback; its instructions must show now.
+	opts.IO.StopPager()
+
 	// Load config for OAuth credentials and API version
 	cfg, err := config.Load()
 	if err != nil {
@@ -84,10 +100,11 @@ func runLogin(opts *LoginOptions) error {
 		scopes = append(scopes, opts.Scopes...)
 	}
 
+	port := callbackPort(opts.CallbackPort, cfg)
 	oauthConfig := &auth.OAuthConfig{
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
-		RedirectURI:  fmt.Sprintf("http://localhost:%d/callback", auth.DefaultCallbackPort),
+		RedirectURI:  auth.CallbackURL(port),
 		Scopes:       scopes,
 	}
 
@@ -96,13 +113,14 @@ func runLogin(opts *LoginOptions) error {
 		return fmt.Errorf("failed to initialize OAuth flow: %w", err)
 	}
 
-	// Start callback server on fixed port
+	// Start the callback server before opening the browser, so a port that is
+	// in use fails fast.
 	codeChan := make(chan string, 1)
 	errChan := make(chan error, 1)
 
-	server, _, err := auth.StartCallbackServer(codeChan, errChan, flow.State())
+	server, _, err := auth.StartCallbackServer(port, codeChan, errChan, flow.State())
 	if err != nil {
-		return fmt.Errorf("failed to start callback server: %w", err)
+		return fmt.Errorf("failed to start callback server: %w\n\nPick a free port with --callback-port and register its callback URL in your OAuth app", err)
 	}
 
 	defer func() {
@@ -113,6 +131,7 @@ func runLogin(opts *LoginOptions) error {
 
 	// Open browser
 	authURL := flow.AuthorizationURL()
+	fmt.Fprintf(opts.IO.Out, "Callback URL: %s (must be registered in your OAuth app)\n", oauthConfig.RedirectURI)
 	fmt.Fprintln(opts.IO.Out, "Opening browser to authenticate...")
 	fmt.Fprintln(opts.IO.Out, "")
 	fmt.Fprintln(opts.IO.Out, "If the browser doesn't open, visit this URL:")
@@ -134,10 +153,11 @@ func runLogin(opts *LoginOptions) error {
 		return fmt.Errorf("authentication failed: %w", err)
 	case <-time.After(5 * time.Minute):
 		return fmt.Errorf("authentication timed out")
+	case <-ctx.Done():
+		return fmt.Errorf("authentication canceled")
 	}
 
 	// Exchange code for tokens
-	ctx := context.Background()
 	tokens, err := flow.ExchangeCode(ctx, code)
 	if err != nil {
 		return fmt.Errorf("failed to exchange code for tokens: %w", err)
@@ -201,3 +221,15 @@ func runLogin(opts *LoginOptions) error {
 
 	return nil
 }
+
+// callbackPort returns the OAuth callback port: the --callback-port flag,
+// then the oauth.callback_port config key, then the default.
+func callbackPort(flagPort int, cfg *config.Config) int {
+	if flagPort != 0 {
+		return flagPort
+	}
+	if cfg.OAuth != nil && cfg.OAuth.CallbackPort != 0 {
+		return cfg.OAuth.CallbackPort
+	}
+	return auth.DefaultCallbackPort
+}
@@ -42,7 +42,7 @@ when the 'offline_access' scope is requested.`,
   # Refresh tokens for a specific host
   atl auth refresh --hostname mycompany.atlassian.net`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runRefresh(opts)
+			return runRefresh(cmd.Context(), opts)
 		},
 	}
 
@@ -51,7 +51,7 @@ when the 'offline_access' scope is requested.`,
 	return cmd
 }
 
-func runRefresh(opts *RefreshOptions) error {
+func runRefresh(ctx context.Context, opts *RefreshOptions) error {
 	// Load config
 	cfg, err := config.Load()
 	if err != nil {
@@ -112,7 +112,6 @@ func runRefresh(opts *RefreshOptions) error {
 	}
 
 	// Refresh tokens
-	ctx := context.Background()
 	newTokens, err := auth.RefreshAccessToken(ctx, hostname, &auth.RefreshConfig{
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
@@ -2,7 +2,10 @@ package auth
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -15,10 +18,17 @@ import (
 
 // SetupOptions holds the options for the setup command.
 type SetupOptions struct {
-	IO           *iostreams.IOStreams
-	ClientID     string
-	ClientSecret string
-	Interactive  bool
+	IO              *iostreams.IOStreams
+	ClientID        string
+	ClientSecret    string
+	CredentialsFile string
+	Interactive     bool
+}
+
+// credentialsFile is the format of --credentials-file.
+type credentialsFile struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
 }
 
 // NewCmdSetup creates the setup command.
@@ -36,13 +46,38 @@ func NewCmdSetup(ios *iostreams.IOStreams) *cobra.Command {
 This command guides you through creating an OAuth 2.0 app in Atlassian
 and storing the credentials. You only need to run this once.
 
-The credentials are stored locally in ~/.config/atlassian/config.yaml`,
+The credentials are stored locally in ~/.config/atlassian/config.yaml
+
+For scripts and CI, the credentials can be given without any prompts:
+with --client-id and --client-secret, with --credentials-file (a JSON
+file with client_id and client_secret; - reads it from stdin), or through
+the ATLASSIAN_CLIENT_ID and ATLASSIAN_CLIENT_SECRET environment variables.
+Flags win over the file, which wins over the environment.`,
 		Example: `  # Interactive setup (recommended)
   atl auth setup
 
   # Non-interactive setup
-  atl auth setup --client-id YOUR_ID --client-secret YOUR_SECRET`,
+  atl auth setup --client-id YOUR_ID --client-secret YOUR_SECRET
+
+  # From a file: {"client_id": "...", "client_secret": "..."}
+  atl auth setup --credentials-file creds.json
+
+  # From the environment
+  ATLASSIAN_CLIENT_ID=... ATLASSIAN_CLIENT_SECRET=... atl auth setup`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.CredentialsFile != "" && (opts.ClientID != "" || opts.ClientSecret != "") {
+				return fmt.Errorf("--credentials-file cannot be combined with --client-id or --client-secret")
+			}
+			if opts.CredentialsFile != "" {
+				creds, err := readCredentialsFile(opts.IO, opts.CredentialsFile)
+				if err != nil {
+					return err
+				}
+				opts.ClientID, opts.ClientSecret = creds.ClientID, creds.ClientSecret
+			} else if opts.ClientID == "" && opts.ClientSecret == "" {
+				opts.ClientID = os.Getenv("ATLASSIAN_CLIENT_ID")
+				opts.ClientSecret = os.Getenv("ATLASSIAN_CLIENT_SECRET")
+			}
 			if opts.ClientID != "" && opts.ClientSecret != "" {
 				opts.Interactive = false
 			}
@@ -52,11 +87,15 @@ The credentials are stored locally in ~/.config/atlassian/config.yaml`,
 
 	cmd.Flags().StringVar(&opts.ClientID, "client-id", "", "OAuth client ID")
 	cmd.Flags().StringVar(&opts.ClientSecret, "client-secret", "", "OAuth client secret")
+	cmd.Flags().StringVar(&opts.CredentialsFile, "credentials-file", "", "JSON file with client_id and client_secret (- for stdin)")
 
 	return cmd
 }
 
 func runSetup(opts *SetupOptions) error {
+	// Setup prompts for input, which a pager would hide.
+	opts.IO.StopPager()
+
 	cfg, err := config.Load()
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
@@ -125,7 +164,7 @@ func runSetup(opts *SetupOptions) error {
 		fmt.Fprintln(opts.IO.Out, "    • Next to \"OAuth 2.0 (3LO)\", click "+output.Bold.Render("Add"))
 		fmt.Fprintln(opts.IO.Out, "    • Enter this callback URL:")
 		fmt.Fprintln(opts.IO.Out, "")
-		fmt.Fprintln(opts.IO.Out, "      "+output.Cyan.Render("http://localhost:8085/callback"))
+		fmt.Fprintln(opts.IO.Out, "      "+output.Cyan.Render(auth.CallbackURL(callbackPort(0, cfg))))
 		fmt.Fprintln(opts.IO.Out, "")
 		fmt.Fprintln(opts.IO.Out, "    • Click "+output.Bold.Render("Save changes"))
 		fmt.Fprintln(opts.IO.Out, "")
@@ -161,7 +200,7 @@ func runSetup(opts *SetupOptions) error {
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("read:confluence-content.all"))
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("write:confluence-content"))
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("search:confluence"))
-		fmt.Fprintln(opts.IO.Out, "    • In the "+output.Bold.Render("Granular Scopes")+" tab, click "+output.Bold.Render("Edit Scopes")+" to enable the following 14 scopes:")
+		fmt.Fprintln(opts.IO.Out, "    • In the "+output.Bold.Render("Granular Scopes")+" tab, click "+output.Bold.Render("Edit Scopes")+" to enable the following 16 scopes:")
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("read:space:confluence"))
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("read:page:confluence"))
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("write:page:confluence"))
@@ -171,6 +210,8 @@ func runSetup(opts *SetupOptions) error {
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("read:content.metadata:confluence"))
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("read:content-details:confluence"))
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("read:hierarchical-content:confluence"))
+		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("read:comment:confluence"))
+		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("write:comment:confluence"))
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("read:folder:confluence"))
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("write:folder:confluence"))
 		fmt.Fprintln(opts.IO.Out, "        "+output.Faint.Render("delete:folder:confluence"))
@@ -206,11 +247,12 @@ func runSetup(opts *SetupOptions) error {
 		}
 	}
 
-	// Save to config
-	cfg.OAuth = &config.OAuthConfig{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
+	// Save to config, keeping other OAuth settings such as the callback port
+	if cfg.OAuth == nil {
+		cfg.OAuth = &config.OAuthConfig{}
 	}
+	cfg.OAuth.ClientID = clientID
+	cfg.OAuth.ClientSecret = clientSecret
 
 	if err := cfg.Save(); err != nil {
 		return fmt.Errorf("failed to save config: %w", err)
@@ -224,3 +266,42 @@ func runSetup(opts *SetupOptions) error {
 
 	return nil
 }
+
+// readCredentialsFile reads OAuth credentials from a JSON file, or from
+// stdin when path is "-". Both fields must be set.
+func readCredentialsFile(ios *iostreams.IOStreams, path string) (*credentialsFile, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(ios.In)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+
+	return parseCredentials(data)
+}
+
+// parseCredentials decodes and validates the contents of a credentials file.
+func parseCredentials(data []byte) (*credentialsFile, error) {
+	var creds credentialsFile
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("invalid credentials file: %w", err)
+	}
+	creds.ClientID = strings.TrimSpace(creds.ClientID)
+	creds.ClientSecret = strings.TrimSpace(creds.ClientSecret)
+
+	var missing []string
+	if creds.ClientID == "" {
+		missing = append(missing, "client_id")
+	}
+	if creds.ClientSecret == "" {
+		missing = append(missing, "client_secret")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("invalid credentials file: missing %s", strings.Join(missing, " and "))
+	}
+	return &creds, nil
+}
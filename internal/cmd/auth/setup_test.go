@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func TestReadCredentialsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "creds.json")
+	if err := os.WriteFile(path, []byte(`{"client_id": "id-123", "client_secret": " secret-456\n"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ios := iostreams.Test()
+	creds, err := readCredentialsFile(ios, path)
+	if err != nil {
+		t.Fatalf("readCredentialsFile() error = %v", err)
+	}
+	if creds.ClientID != "id-123" || creds.ClientSecret != "secret-456" {
+		t.Errorf("credentials = %+v, want id-123 / secret-456", creds)
+	}
+}
+
+func TestParseCredentialsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"missing secret", `{"client_id": "id"}`, "missing client_secret"},
+		{"missing both", `{}`, "missing client_id and client_secret"},
+		{"not JSON", `client_id=id`, "invalid credentials file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseCredentials([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseCredentials() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
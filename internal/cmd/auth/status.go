@@ -66,7 +66,7 @@ func runStatus(opts *StatusOptions) error {
 
 	if len(cfg.Hosts) == 0 {
 		if opts.JSON {
-			return output.JSON(opts.IO.Out, []AuthStatus{})
+			return output.PrintJSON(opts.IO, []AuthStatus{})
 		}
 		fmt.Fprintln(opts.IO.Out, "You are not logged in to any Atlassian hosts.")
 		fmt.Fprintln(opts.IO.Out, "Run 'atl auth login' to authenticate.")
@@ -110,7 +110,7 @@ func runStatus(opts *StatusOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, statuses)
+		return output.PrintJSON(opts.IO, statuses)
 	}
 
 	// Plain text output
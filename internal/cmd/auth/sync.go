@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/auth"
+	"github.com/enthus-appdev/atl-cli/internal/config"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// SyncOptions holds the options for the sync command.
+type SyncOptions struct {
+	IO       *iostreams.IOStreams
+	Hostname string
+	JSON     bool
+}
+
+// NewCmdSync creates the sync command.
+func NewCmdSync(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &SyncOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Re-resolve the cloud ID for a host",
+		Long: `Look up the host's cloud ID again and update the configuration.
+
+Every API request goes through the site's cloud ID. If it goes stale (for
+example after a site migration), requests fail with 404 errors. This command
+asks Atlassian which sites the stored token can access and saves the cloud ID
+of the matching site. No new login is needed.`,
+		Example: `  # Re-resolve the cloud ID for the current host
+  atl auth sync
+
+  # For a specific host
+  atl auth sync --hostname mycompany.atlassian.net`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Hostname, "hostname", "", "The hostname to sync (defaults to current host)")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// SyncOutput represents the result of re-resolving a cloud ID.
+type SyncOutput struct {
+	Hostname        string `json:"hostname"`
+	SiteName        string `json:"site_name"`
+	SiteURL         string `json:"site_url"`
+	CloudID         string `json:"cloud_id"`
+	PreviousCloudID string `json:"previous_cloud_id,omitempty"`
+	Updated         bool   `json:"updated"`
+}
+
+func runSync(ctx context.Context, opts *SyncOptions) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	hostname := cfg.ResolveHost(opts.Hostname)
+	if hostname == "" {
+		hostname = cfg.CurrentHost
+	}
+	if hostname == "" {
+		return fmt.Errorf("no host specified and no current host configured\n\nRun 'atl auth login' first or specify --hostname")
+	}
+
+	hostConfig := cfg.GetHost(hostname)
+	if hostConfig == nil {
+		return fmt.Errorf("no configuration found for host %s\n\nRun 'atl auth login --hostname %s' first", hostname, hostname)
+	}
+
+	tokens, err := auth.GetToken(hostname)
+	if err != nil {
+		return fmt.Errorf("failed to get tokens: %w", err)
+	}
+	if tokens == nil {
+		return fmt.Errorf("no tokens found for %s\n\nRun 'atl auth login' first", hostname)
+	}
+	if tokens.IsExpired() {
+		return fmt.Errorf("token for %s has expired\n\nRun 'atl auth refresh' first", hostname)
+	}
+
+	resources, err := api.GetAccessibleResources(ctx, tokens.AccessToken)
+	if err != nil {
+		return fmt.Errorf("failed to get accessible resources: %w", err)
+	}
+
+	syncOutput, err := syncCloudID(hostConfig, hostname, resources)
+	if err != nil {
+		return err
+	}
+
+	if syncOutput.Updated {
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, syncOutput)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Site: %s (%s)\n", syncOutput.SiteName, syncOutput.SiteURL)
+	if syncOutput.Updated {
+		fmt.Fprintf(opts.IO.Out, "%s Cloud ID updated: %s -> %s\n", output.Success.Render("✓"), syncOutput.PreviousCloudID, syncOutput.CloudID)
+	} else {
+		fmt.Fprintf(opts.IO.Out, "%s Cloud ID is up to date: %s\n", output.Success.Render("✓"), syncOutput.CloudID)
+	}
+
+	return nil
+}
+
+// syncCloudID finds hostname among the accessible resources and sets the
+// host's cloud ID to match. The config is modified in place; the caller saves
+// it when Updated is set.
+func syncCloudID(hostConfig *config.HostConfig, hostname string, resources []*api.AccessibleResource) (*SyncOutput, error) {
+	resource := api.FindAccessibleResource(resources, hostname)
+	if resource == nil {
+		sites := make([]string, 0, len(resources))
+		for _, r := range resources {
+			sites = append(sites, r.URL)
+		}
+		if len(sites) == 0 {
+			return nil, fmt.Errorf("the token for %s cannot access any Atlassian sites\n\nRun 'atl auth login' to grant access again", hostname)
+		}
+		return nil, fmt.Errorf("site %s is not accessible with the stored token. Accessible sites: %s\n\nRun 'atl auth login --hostname %s' to grant access", hostname, strings.Join(sites, ", "), hostname)
+	}
+
+	syncOutput := &SyncOutput{
+		Hostname: hostname,
+		SiteName: resource.Name,
+		SiteURL:  resource.URL,
+		CloudID:  resource.ID,
+	}
+	if hostConfig.CloudID != resource.ID {
+		syncOutput.PreviousCloudID = hostConfig.CloudID
+		syncOutput.Updated = true
+		hostConfig.CloudID = resource.ID
+	}
+	return syncOutput, nil
+}
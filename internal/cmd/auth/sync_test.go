@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/config"
+)
+
+func TestSyncCloudID(t *testing.T) {
+	resources := []*api.AccessibleResource{
+		{ID: "cloud-new", URL: "https://acme.atlassian.net", Name: "Acme"},
+		{ID: "cloud-other", URL: "https://other.atlassian.net", Name: "Other"},
+	}
+
+	t.Run("stale cloud ID is replaced", func(t *testing.T) {
+		host := &config.HostConfig{Hostname: "acme.atlassian.net", CloudID: "cloud-old"}
+		got, err := syncCloudID(host, "acme.atlassian.net", resources)
+		if err != nil {
+			t.Fatalf("syncCloudID() error = %v", err)
+		}
+		if !got.Updated || got.PreviousCloudID != "cloud-old" || got.CloudID != "cloud-new" {
+			t.Errorf("syncCloudID() = %+v, want update from cloud-old to cloud-new", got)
+		}
+		if got.SiteURL != "https://acme.atlassian.net" {
+			t.Errorf("SiteURL = %q, want the matched site", got.SiteURL)
+		}
+		if host.CloudID != "cloud-new" {
+			t.Errorf("host CloudID = %q, want %q", host.CloudID, "cloud-new")
+		}
+	})
+
+	t.Run("current cloud ID is kept", func(t *testing.T) {
+		host := &config.HostConfig{Hostname: "acme.atlassian.net", CloudID: "cloud-new"}
+		got, err := syncCloudID(host, "acme.atlassian.net", resources)
+		if err != nil {
+			t.Fatalf("syncCloudID() error = %v", err)
+		}
+		if got.Updated || got.PreviousCloudID != "" {
+			t.Errorf("syncCloudID() = %+v, want no update", got)
+		}
+	})
+
+	t.Run("site not accessible", func(t *testing.T) {
+		host := &config.HostConfig{Hostname: "gone.atlassian.net", CloudID: "cloud-gone"}
+		_, err := syncCloudID(host, "gone.atlassian.net", resources)
+		if err == nil || !strings.Contains(err.Error(), "https://other.atlassian.net") {
+			t.Errorf("syncCloudID() error = %v, want error listing accessible sites", err)
+		}
+		if host.CloudID != "cloud-gone" {
+			t.Errorf("host CloudID changed to %q on error", host.CloudID)
+		}
+	})
+}
@@ -37,7 +37,7 @@ func NewCmdList(ios *iostreams.IOStreams) *cobra.Command {
   # Output as JSON
   atl board list --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runList(opts)
+			return runList(cmd.Context(), opts)
 		},
 	}
 
@@ -61,13 +61,12 @@ type BoardListOutput struct {
 	Total  int            `json:"total"`
 }
 
-func runList(opts *ListOptions) error {
+func runList(ctx context.Context, opts *ListOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	boards, err := jira.GetBoards(ctx, opts.Project)
@@ -93,7 +92,7 @@ func runList(opts *ListOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, listOutput)
+		return output.PrintJSON(opts.IO, listOutput)
 	}
 
 	if len(listOutput.Boards) == 0 {
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -35,15 +36,12 @@ func newCmdGet(ios *iostreams.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get <key>",
 		Short: "Get a configuration value",
-		Long: `Print the value of a configuration key.
+		Long: `Print the value of a configuration key. Secrets are masked.
 
-Available keys:
-  current_host          - The current active Atlassian host
-  default_output_format - Default output format (text or json)
-  editor                - Editor to use for editing content
-  pager                 - Pager to use for long output`,
+` + settingsHelp(),
 		Example: `  atl config get current_host
-  atl config get editor`,
+  atl config get default_project
+  atl config get oauth.client_secret`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runGet(ios, args[0], jsonOutput)
@@ -61,10 +59,15 @@ func runGet(ios *iostreams.IOStreams, key string, jsonOutput bool) error {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
-	value := cfg.Get(key)
+	setting, err := config.LookupSetting(key)
+	if err != nil {
+		return err
+	}
+
+	value := displayValue(setting, cfg.Get(key))
 
 	if jsonOutput {
-		return output.JSON(ios.Out, map[string]string{key: value})
+		return output.PrintJSON(ios, map[string]string{key: value})
 	}
 
 	if value == "" {
@@ -82,14 +85,14 @@ func newCmdSet(ios *iostreams.IOStreams) *cobra.Command {
 		Short: "Set a configuration value",
 		Long: `Set a configuration value.
 
-Available keys:
-  current_host          - The current active Atlassian host
-  default_output_format - Default output format (text or json)
-  editor                - Editor to use for editing content
-  pager                 - Pager to use for long output`,
+` + settingsHelp(),
 		Example: `  atl config set current_host mycompany.atlassian.net
   atl config set editor vim
-  atl config set default_output_format json`,
+  atl config set default_output_format json
+  atl config set default_project PROJ
+  atl config set browse_base_url https://jira.mycompany.com
+  atl config set create.assign_self true
+  atl config set color never`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runSet(ios, args[0], args[1])
@@ -103,6 +106,11 @@ func runSet(ios *iostreams.IOStreams, key, value string) error {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
+	setting, err := config.LookupSetting(key)
+	if err != nil {
+		return err
+	}
+
 	if err := cfg.Set(key, value); err != nil {
 		return err
 	}
@@ -111,7 +119,7 @@ func runSet(ios *iostreams.IOStreams, key, value string) error {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 
-	fmt.Fprintf(ios.Out, "Set %s = %s\n", key, value)
+	fmt.Fprintf(ios.Out, "Set %s = %s\n", key, displayValue(setting, cfg.Get(key)))
 	return nil
 }
 
@@ -139,6 +147,11 @@ type ConfigListOutput struct {
 	DefaultOutputFormat string                     `json:"default_output_format,omitempty"`
 	Editor              string                     `json:"editor,omitempty"`
 	Pager               string                     `json:"pager,omitempty"`
+	Color               string                     `json:"color,omitempty"`
+	CreateAssignSelf    string                     `json:"create_assign_self,omitempty"`
+	OAuthClientID       string                     `json:"oauth_client_id,omitempty"`
+	OAuthClientSecret   string                     `json:"oauth_client_secret,omitempty"` // masked
+	OAuthCallbackPort   string                     `json:"oauth_callback_port,omitempty"`
 	Aliases             map[string]string          `json:"aliases,omitempty"`
 	Hosts               map[string]*HostInfoOutput `json:"hosts,omitempty"`
 	ConfigFile          string                     `json:"config_file"`
@@ -149,6 +162,7 @@ type HostInfoOutput struct {
 	Hostname       string `json:"hostname"`
 	CloudID        string `json:"cloud_id,omitempty"`
 	DefaultProject string `json:"default_project,omitempty"`
+	BrowseBaseURL  string `json:"browse_base_url,omitempty"`
 }
 
 func runList(ios *iostreams.IOStreams, jsonOutput bool) error {
@@ -162,6 +176,11 @@ func runList(ios *iostreams.IOStreams, jsonOutput bool) error {
 		DefaultOutputFormat: cfg.DefaultOutputFormat,
 		Editor:              cfg.Editor,
 		Pager:               cfg.Pager,
+		Color:               cfg.Color,
+		OAuthClientID:       cfg.Get("oauth.client_id"),
+		OAuthClientSecret:   config.MaskSecret(cfg.Get("oauth.client_secret")),
+		OAuthCallbackPort:   cfg.Get("oauth.callback_port"),
+		CreateAssignSelf:    cfg.Get("create.assign_self"),
 		ConfigFile:          config.ConfigFile(),
 	}
 
@@ -176,12 +195,13 @@ func runList(ios *iostreams.IOStreams, jsonOutput bool) error {
 				Hostname:       host.Hostname,
 				CloudID:        host.CloudID,
 				DefaultProject: host.DefaultProject,
+				BrowseBaseURL:  host.BrowseBaseURL,
 			}
 		}
 	}
 
 	if jsonOutput {
-		return output.JSON(ios.Out, listOutput)
+		return output.PrintJSON(ios, listOutput)
 	}
 
 	fmt.Fprintf(ios.Out, "Config file: %s\n\n", listOutput.ConfigFile)
@@ -191,6 +211,11 @@ func runList(ios *iostreams.IOStreams, jsonOutput bool) error {
 	printConfigValue(ios, "  default_output_format", listOutput.DefaultOutputFormat)
 	printConfigValue(ios, "  editor", listOutput.Editor)
 	printConfigValue(ios, "  pager", listOutput.Pager)
+	printConfigValue(ios, "  color", listOutput.Color)
+	printConfigValue(ios, "  create.assign_self", listOutput.CreateAssignSelf)
+	printConfigValue(ios, "  oauth.client_id", listOutput.OAuthClientID)
+	printConfigValue(ios, "  oauth.client_secret", listOutput.OAuthClientSecret)
+	printConfigValue(ios, "  oauth.callback_port", listOutput.OAuthCallbackPort)
 
 	if len(listOutput.Aliases) > 0 {
 		fmt.Fprintln(ios.Out, "")
@@ -215,6 +240,9 @@ func runList(ios *iostreams.IOStreams, jsonOutput bool) error {
 			if host.DefaultProject != "" {
 				fmt.Fprintf(ios.Out, "    default_project: %s\n", host.DefaultProject)
 			}
+			if host.BrowseBaseURL != "" {
+				fmt.Fprintf(ios.Out, "    browse_base_url: %s\n", host.BrowseBaseURL)
+			}
 		}
 	}
 
@@ -228,3 +256,26 @@ func printConfigValue(ios *iostreams.IOStreams, key, value string) {
 		fmt.Fprintf(ios.Out, "%s: %s\n", key, value)
 	}
 }
+
+// settingsHelp lists the available keys for command help.
+func settingsHelp() string {
+	width := 0
+	for _, s := range config.Settings {
+		width = max(width, len(s.Key))
+	}
+
+	var b strings.Builder
+	b.WriteString("Available keys:")
+	for _, s := range config.Settings {
+		fmt.Fprintf(&b, "\n  %-*s - %s", width, s.Key, s.Description)
+	}
+	return b.String()
+}
+
+// displayValue masks the value of secret settings.
+func displayValue(setting config.Setting, value string) string {
+	if setting.Secret {
+		return config.MaskSecret(value)
+	}
+	return value
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/config"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func TestGetAndListMaskClientSecret(t *testing.T) {
+	t.Setenv(config.ConfigFileEnv, filepath.Join(t.TempDir(), "config.yaml"))
+
+	ios := iostreams.Test()
+	var out bytes.Buffer
+	ios.Out = &out
+
+	if err := runSet(ios, "oauth.client_secret", "super-secret-value"); err != nil {
+		t.Fatalf("runSet() error = %v", err)
+	}
+	if err := runGet(ios, "oauth.client_secret", false); err != nil {
+		t.Fatalf("runGet() error = %v", err)
+	}
+	if err := runList(ios, true); err != nil {
+		t.Fatalf("runList() error = %v", err)
+	}
+
+	if strings.Contains(out.String(), "super-secret") {
+		t.Errorf("output leaks the client secret:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "**************alue") {
+		t.Errorf("output does not show the masked secret:\n%s", out.String())
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := cfg.Get("oauth.client_secret"); got != "super-secret-value" {
+		t.Errorf("saved secret = %q, want it unmasked", got)
+	}
+}
+
+func TestGetUnknownKey(t *testing.T) {
+	t.Setenv(config.ConfigFileEnv, filepath.Join(t.TempDir(), "config.yaml"))
+
+	err := runGet(iostreams.Test(), "colour", false)
+	if err == nil || !strings.Contains(err.Error(), "unknown configuration key: colour") {
+		t.Errorf("runGet() error = %v, want unknown key", err)
+	}
+}
@@ -41,7 +41,7 @@ func runCurrentContext(ios *iostreams.IOStreams, jsonOutput bool) error {
 
 	if cfg.CurrentHost == "" {
 		if jsonOutput {
-			return output.JSON(ios.Out, CurrentContextOutput{})
+			return output.PrintJSON(ios, CurrentContextOutput{})
 		}
 		fmt.Fprintln(ios.Out, "No current context set.")
 		fmt.Fprintln(ios.Out, "Run 'atl auth login' to authenticate or 'atl config use-context' to switch.")
@@ -51,7 +51,7 @@ func runCurrentContext(ios *iostreams.IOStreams, jsonOutput bool) error {
 	alias := cfg.AliasForHost(cfg.CurrentHost)
 
 	if jsonOutput {
-		return output.JSON(ios.Out, CurrentContextOutput{
+		return output.PrintJSON(ios, CurrentContextOutput{
 			Hostname: cfg.CurrentHost,
 			Alias:    alias,
 		})
@@ -0,0 +1,21 @@
+package blog
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+// NewCmdBlog creates the blog command group.
+func NewCmdBlog(ios *iostreams.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blog",
+		Short: "Work with Confluence blog posts",
+		Long:  `List and create blog posts in Confluence spaces.`,
+	}
+
+	cmd.AddCommand(NewCmdList(ios))
+	cmd.AddCommand(NewCmdCreate(ios))
+
+	return cmd
+}
@@ -0,0 +1,122 @@
+package blog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/auth"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// CreateOptions holds the options for the create command.
+type CreateOptions struct {
+	IO    *iostreams.IOStreams
+	Space string
+	Title string
+	Body  string
+	Web   bool
+	JSON  bool
+}
+
+// NewCmdCreate creates the create command.
+func NewCmdCreate(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &CreateOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Publish a new blog post",
+		Long: `Publish a new blog post in a Confluence space.
+
+The post is published immediately and dated today.`,
+		Example: `  # Publish a blog post
+  atl confluence blog create --space DOCS --title "Release 2.4" --body "What's new this sprint"
+
+  # Publish and open in browser
+  atl confluence blog create --space DOCS --title "Release 2.4" --web
+
+  # Output as JSON
+  atl confluence blog create --space DOCS --title "Release 2.4" --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var missing []string
+			if opts.Space == "" {
+				missing = append(missing, "--space")
+			}
+			if opts.Title == "" {
+				missing = append(missing, "--title")
+			}
+			if len(missing) > 0 {
+				return fmt.Errorf("required flags not set: %v\n\nExample: atl confluence blog create --space DOCS --title \"Post Title\"\n\nUse 'atl confluence space list' to see available spaces", missing)
+			}
+			return runCreate(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Space, "space", "s", "", "Space key (required)")
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Post title (required)")
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Post body content")
+	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open created post in browser")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// BlogCreateOutput represents the output after creating a blog post.
+type BlogCreateOutput struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	SpaceID   string `json:"space_id"`
+	CreatedAt string `json:"created_at,omitempty"`
+	URL       string `json:"url"`
+}
+
+func runCreate(ctx context.Context, opts *CreateOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	confluence := api.NewConfluenceService(client)
+
+	space, err := confluence.GetSpaceByKey(ctx, opts.Space)
+	if err != nil {
+		return fmt.Errorf("failed to get space: %w", err)
+	}
+
+	post, err := confluence.CreateBlogPost(ctx, space.ID, opts.Title, api.TextToStorage(opts.Body))
+	if err != nil {
+		return fmt.Errorf("failed to create blog post: %w", err)
+	}
+
+	url := fmt.Sprintf("https://%s/wiki/spaces/%s/blog/%s", client.Hostname(), opts.Space, post.ID)
+	if post.Links != nil && post.Links.WebUI != "" {
+		url = fmt.Sprintf("https://%s/wiki%s", client.Hostname(), post.Links.WebUI)
+	}
+
+	if opts.Web {
+		auth.OpenBrowser(url)
+	}
+
+	createOutput := &BlogCreateOutput{
+		ID:        post.ID,
+		Title:     post.Title,
+		SpaceID:   post.SpaceID,
+		CreatedAt: post.CreatedAt,
+		URL:       url,
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, createOutput)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Published blog post: %s\n", createOutput.Title)
+	fmt.Fprintf(opts.IO.Out, "ID: %s\n", createOutput.ID)
+	fmt.Fprintf(opts.IO.Out, "URL: %s\n", createOutput.URL)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package blog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// ListOptions holds the options for the list command.
+type ListOptions struct {
+	IO     *iostreams.IOStreams
+	Space  string
+	Limit  int
+	Cursor string
+	JSON   bool
+}
+
+// NewCmdList creates the list command.
+func NewCmdList(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &ListOptions{
+		IO:    ios,
+		Limit: 25,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List blog posts in a space",
+		Long: `List blog posts in a Confluence space, newest first.
+
+The --space flag is required. Use 'atl confluence space list' to see available spaces.`,
+		Example: `  # List recent blog posts
+  atl confluence blog list --space DOCS
+
+  # Get next page using cursor
+  atl confluence blog list --space DOCS --cursor <cursor>
+
+  # Output as JSON
+  atl confluence blog list --space DOCS --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Space == "" {
+				return fmt.Errorf("--space flag is required\n\nUse 'atl confluence space list' to see available spaces")
+			}
+			return runList(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Space, "space", "s", "", "Space key (required)")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 25, "Maximum number of posts per page")
+	cmd.Flags().StringVar(&opts.Cursor, "cursor", "", "Pagination cursor for next page")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// BlogListOutput represents the output for blog list.
+type BlogListOutput struct {
+	SpaceKey   string        `json:"space_key"`
+	Posts      []*BlogOutput `json:"posts"`
+	Total      int           `json:"total"`
+	HasMore    bool          `json:"has_more"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+// BlogOutput represents a single blog post in the list.
+type BlogOutput struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+func runList(ctx context.Context, opts *ListOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	confluence := api.NewConfluenceService(client)
+
+	space, err := confluence.GetSpaceByKey(ctx, opts.Space)
+	if err != nil {
+		return fmt.Errorf("failed to get space: %w", err)
+	}
+
+	result, err := confluence.GetBlogPosts(ctx, space.ID, opts.Limit, opts.Cursor)
+	if err != nil {
+		return fmt.Errorf("failed to get blog posts: %w", err)
+	}
+
+	nextCursor := result.NextCursor()
+	listOutput := &BlogListOutput{
+		SpaceKey:   opts.Space,
+		Posts:      make([]*BlogOutput, 0, len(result.Results)),
+		Total:      len(result.Results),
+		HasMore:    nextCursor != "",
+		NextCursor: nextCursor,
+	}
+
+	for _, post := range result.Results {
+		listOutput.Posts = append(listOutput.Posts, &BlogOutput{
+			ID:        post.ID,
+			Title:     post.Title,
+			Status:    post.Status,
+			CreatedAt: post.CreatedAt,
+		})
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, listOutput)
+	}
+
+	if len(listOutput.Posts) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No blog posts found in space %s\n", opts.Space)
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Found %d blog posts in space %s\n\n", listOutput.Total, opts.Space)
+
+	headers := []string{"ID", "CREATED", "TITLE"}
+	rows := make([][]string, 0, len(listOutput.Posts))
+
+	for _, post := range listOutput.Posts {
+		title := post.Title
+		if len(title) > 60 {
+			title = title[:57] + "..."
+		}
+		rows = append(rows, []string{
+			post.ID,
+			output.FormatDate(post.CreatedAt),
+			title,
+		})
+	}
+
+	output.SimpleTable(opts.IO.Out, headers, rows)
+
+	if listOutput.HasMore {
+		fmt.Fprintf(opts.IO.Out, "\nMore posts available. Use --cursor %s to see next page\n", nextCursor)
+	}
+
+	return nil
+}
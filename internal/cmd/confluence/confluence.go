@@ -3,6 +3,7 @@ package confluence
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/enthus-appdev/atl-cli/internal/cmd/confluence/blog"
 	"github.com/enthus-appdev/atl-cli/internal/cmd/confluence/page"
 	"github.com/enthus-appdev/atl-cli/internal/cmd/confluence/space"
 	"github.com/enthus-appdev/atl-cli/internal/cmd/confluence/template"
@@ -15,10 +16,11 @@ func NewCmdConfluence(ios *iostreams.IOStreams) *cobra.Command {
 		Use:     "confluence",
 		Aliases: []string{"conf", "c"},
 		Short:   "Work with Confluence",
-		Long:    `Read and manage Confluence pages, spaces, and templates.`,
+		Long:    `Read and manage Confluence pages, blog posts, spaces, and templates.`,
 	}
 
 	cmd.AddCommand(page.NewCmdPage(ios))
+	cmd.AddCommand(blog.NewCmdBlog(ios))
 	cmd.AddCommand(space.NewCmdSpace(ios))
 	cmd.AddCommand(template.NewCmdTemplate(ios))
 
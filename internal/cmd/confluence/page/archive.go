@@ -17,42 +17,91 @@ type ArchiveOptions struct {
 	PageIDs   []string
 	Unarchive bool
 	JSON      bool
+
+	// CQL selects the pages to archive by search instead of by ID.
+	CQL       string
+	DryRun    bool
+	Force     bool
+	BatchSize int
 }
 
+// cqlSearchPageSize is the number of search results fetched per request
+// when collecting pages for --cql.
+const cqlSearchPageSize = 100
+
 // NewCmdArchive creates the archive command.
 func NewCmdArchive(ios *iostreams.IOStreams) *cobra.Command {
 	opts := &ArchiveOptions{
-		IO: ios,
+		IO:        ios,
+		BatchSize: 100,
 	}
 
 	cmd := &cobra.Command{
-		Use:   "archive <page-id> [page-id...]",
+		Use:   "archive [<page-id>...]",
 		Short: "Archive or unarchive Confluence pages",
 		Long: `Archive one or more Confluence pages.
 
 Archived pages are hidden from normal searches and navigation but can be
-restored later using the --unarchive flag.`,
+restored later using the --unarchive flag.
+
+With --cql, the pages matching a CQL query are archived instead, e.g. to
+clean up stale pages by label or date. Only pages are archived (other
+content types in the results are skipped), in batches of --batch-size.
+The matching pages are counted and you are asked to confirm unless
+--force is given; --dry-run lists them without archiving anything.
+Confluence Free and Standard sites only archive one page per request; use
+--batch-size 1 there.`,
 		Example: `  # Archive a single page
   atl confluence page archive 123456
 
   # Archive multiple pages
   atl confluence page archive 123456 789012 345678
 
+  # Preview which pages a query would archive
+  atl confluence page archive --cql 'space = DOCS AND label = "deprecated"' --dry-run
+
+  # Archive pages not updated for two years, without prompting
+  atl confluence page archive --cql 'space = DOCS AND lastmodified < now("-104w")' --force
+
   # Unarchive (restore) a page
   atl confluence page archive 123456 --unarchive
 
   # Output as JSON
   atl confluence page archive 123456 --json`,
-		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.PageIDs = args
-			return runArchive(opts)
+			if opts.CQL != "" {
+				if len(args) > 0 {
+					return fmt.Errorf("page IDs cannot be combined with --cql")
+				}
+				if opts.Unarchive {
+					return fmt.Errorf("--cql cannot be used with --unarchive")
+				}
+				if opts.BatchSize < 1 {
+					return fmt.Errorf("--batch-size must be at least 1")
+				}
+				return runArchiveCQL(cmd.Context(), opts)
+			}
+			if len(args) == 0 {
+				return fmt.Errorf("at least one page ID or --cql is required")
+			}
+			if opts.DryRun {
+				return fmt.Errorf("--dry-run requires --cql")
+			}
+			return runArchive(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&opts.Unarchive, "unarchive", "u", false, "Unarchive (restore) pages instead of archiving")
+	cmd.Flags().StringVar(&opts.CQL, "cql", "", "Archive the pages matching a CQL query")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "With --cql, list the matching pages without archiving them")
+	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "With --cql, skip the confirmation prompt")
+	cmd.Flags().IntVar(&opts.BatchSize, "batch-size", 100, "With --cql, number of pages archived per request")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
 
+	// --json skips the confirmation, so the default output format must not set it.
+	output.MarkConfirms(cmd)
+
 	return cmd
 }
 
@@ -61,15 +110,28 @@ type ArchiveOutput struct {
 	PageIDs []string `json:"page_ids"`
 	Action  string   `json:"action"`
 	Success bool     `json:"success"`
+
+	// Set with --cql.
+	CQL     string          `json:"cql,omitempty"`
+	DryRun  bool            `json:"dry_run,omitempty"`
+	Pages   []*ArchivedPage `json:"pages,omitempty"`
+	Failed  []string        `json:"failed_page_ids,omitempty"`
+	Skipped int             `json:"skipped_non_pages,omitempty"`
+}
+
+// ArchivedPage is a page matched by --cql.
+type ArchivedPage struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	SpaceKey string `json:"space_key,omitempty"`
 }
 
-func runArchive(opts *ArchiveOptions) error {
+func runArchive(ctx context.Context, opts *ArchiveOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	action := "archived"
@@ -115,7 +177,7 @@ func runArchive(opts *ArchiveOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, archiveOutput)
+		return output.PrintJSON(opts.IO, archiveOutput)
 	}
 
 	if len(successPages) > 0 {
@@ -132,3 +194,146 @@ func runArchive(opts *ArchiveOptions) error {
 
 	return nil
 }
+
+func runArchiveCQL(ctx context.Context, opts *ArchiveOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	confluence := api.NewConfluenceService(client)
+
+	pages, skipped, err := collectCQLPages(ctx, confluence.SearchWithCQL, opts.CQL)
+	if err != nil {
+		return fmt.Errorf("failed to search pages: %w", err)
+	}
+
+	archiveOutput := &ArchiveOutput{
+		PageIDs: []string{},
+		Action:  "archived",
+		CQL:     opts.CQL,
+		DryRun:  opts.DryRun,
+		Pages:   pages,
+		Skipped: skipped,
+	}
+	if skipped > 0 && !opts.JSON {
+		fmt.Fprintf(opts.IO.ErrOut, "Skipping %d results that are not pages\n", skipped)
+	}
+
+	if len(pages) == 0 {
+		archiveOutput.Success = true
+		if opts.JSON {
+			return output.PrintJSON(opts.IO, archiveOutput)
+		}
+		fmt.Fprintln(opts.IO.Out, "No pages match the query.")
+		return nil
+	}
+
+	if opts.DryRun {
+		archiveOutput.Action = "would archive"
+		archiveOutput.Success = true
+		if opts.JSON {
+			return output.PrintJSON(opts.IO, archiveOutput)
+		}
+		fmt.Fprintf(opts.IO.Out, "Would archive %d pages:\n\n", len(pages))
+		printArchivePages(opts.IO, pages)
+		return nil
+	}
+
+	if !opts.Force && !opts.JSON {
+		opts.IO.StopPager()
+		printArchivePages(opts.IO, pages)
+		fmt.Fprintf(opts.IO.Out, "\nThis will archive %d page(s).\n", len(pages))
+		fmt.Fprint(opts.IO.Out, "Type 'yes' to confirm: ")
+
+		var confirm string
+		fmt.Fscanln(opts.IO.In, &confirm)
+		if confirm != "yes" {
+			return fmt.Errorf("archive canceled")
+		}
+	}
+
+	ids := make([]string, len(pages))
+	for i, page := range pages {
+		ids[i] = page.ID
+	}
+
+	archived, failed := archiveInBatches(ctx, confluence.ArchivePages, ids, opts.BatchSize, func(batch []string, err error) {
+		if !opts.JSON {
+			fmt.Fprintf(opts.IO.ErrOut, "Failed to archive %d page(s) %v: %v\n", len(batch), batch, err)
+		}
+	})
+	archiveOutput.PageIDs = append(archiveOutput.PageIDs, archived...)
+	archiveOutput.Failed = failed
+	archiveOutput.Success = len(failed) == 0
+
+	if opts.JSON {
+		if err := output.PrintJSON(opts.IO, archiveOutput); err != nil {
+			return err
+		}
+	} else if len(archived) > 0 {
+		fmt.Fprintf(opts.IO.Out, "Successfully archived %d pages\n", len(archived))
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to archive %d page(s)", len(failed))
+	}
+	return nil
+}
+
+// collectCQLPages runs a CQL search through all result pages, following the
+// next link until there is none, and returns the matching pages along with
+// the number of results that were not pages.
+func collectCQLPages(ctx context.Context, search func(ctx context.Context, cql string, limit int, cursor string) (*api.ConfluenceSearchResponse, error), cql string) ([]*ArchivedPage, int, error) {
+	var pages []*ArchivedPage
+	skipped := 0
+	seen := make(map[string]bool)
+
+	cursor := ""
+	for {
+		result, err := search(ctx, cql, cqlSearchPageSize, cursor)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, r := range result.Results {
+			if r.Type != "page" {
+				skipped++
+				continue
+			}
+			if seen[r.ID] {
+				continue
+			}
+			seen[r.ID] = true
+			pages = append(pages, &ArchivedPage{ID: r.ID, Title: r.Title, SpaceKey: r.SpaceKey})
+		}
+
+		cursor = result.NextCursor()
+		if cursor == "" {
+			return pages, skipped, nil
+		}
+	}
+}
+
+// archiveInBatches archives ids in batches of batchSize. A failed batch is
+// reported through onError and does not stop the remaining batches.
+func archiveInBatches(ctx context.Context, archive func(context.Context, []string) error, ids []string, batchSize int, onError func(batch []string, err error)) (archived, failed []string) {
+	for start := 0; start < len(ids); start += batchSize {
+		batch := ids[start:min(start+batchSize, len(ids))]
+		if err := archive(ctx, batch); err != nil {
+			onError(batch, err)
+			failed = append(failed, batch...)
+			continue
+		}
+		archived = append(archived, batch...)
+	}
+	return archived, failed
+}
+
+func printArchivePages(ios *iostreams.IOStreams, pages []*ArchivedPage) {
+	rows := make([][]string, 0, len(pages))
+	for _, page := range pages {
+		rows = append(rows, []string{page.ID, page.SpaceKey, page.Title})
+	}
+	output.SimpleTable(ios.Out, []string{"ID", "SPACE", "TITLE"}, rows)
+}
@@ -0,0 +1,79 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestCollectCQLPagesFeedsArchive(t *testing.T) {
+	// Three result pages linked by _links.next: 100 pages, 50 (a short page
+	// Confluence still continues after, e.g. when results are filtered by
+	// permissions), then 2 pages and a blog post.
+	var cursors []string
+	search := func(_ context.Context, cql string, limit int, cursor string) (*api.ConfluenceSearchResponse, error) {
+		if cql != "label = stale" {
+			t.Errorf("cql = %q", cql)
+		}
+		cursors = append(cursors, cursor)
+		start := len(cursors) - 1
+		n := []int{limit, 50, 2}[start]
+		result := &api.ConfluenceSearchResponse{}
+		for i := range n {
+			result.Results = append(result.Results, &api.ConfluenceSearchResult{
+				ID:    fmt.Sprintf("%d", start*limit+i+1),
+				Type:  "page",
+				Title: "Stale",
+			})
+		}
+		if start < 2 {
+			result.Links = &api.PaginationLinks{Next: fmt.Sprintf("/rest/api/search?next=true&cursor=c%d&limit=%d", start+1, limit)}
+		} else {
+			result.Results = append(result.Results, &api.ConfluenceSearchResult{ID: "999", Type: "blogpost"})
+		}
+		return result, nil
+	}
+
+	pages, skipped, err := collectCQLPages(context.Background(), search, "label = stale")
+	if err != nil {
+		t.Fatalf("collectCQLPages() error = %v", err)
+	}
+	if !slices.Equal(cursors, []string{"", "c1", "c2"}) {
+		t.Errorf("cursors = %v, want [ c1 c2]", cursors)
+	}
+	if len(pages) != 152 || skipped != 1 {
+		t.Fatalf("got %d pages, %d skipped; want 152 pages, 1 skipped", len(pages), skipped)
+	}
+
+	ids := make([]string, len(pages))
+	for i, page := range pages {
+		ids[i] = page.ID
+	}
+
+	var batches [][]string
+	archive := func(_ context.Context, batch []string) error {
+		batches = append(batches, batch)
+		if batch[0] == "101" {
+			return errors.New("forbidden")
+		}
+		return nil
+	}
+	var reported []string
+	archived, failed := archiveInBatches(context.Background(), archive, ids, 100, func(batch []string, err error) {
+		reported = append(reported, batch[0])
+	})
+
+	if len(batches) != 2 || len(batches[1]) != 52 || batches[1][51] != "202" {
+		t.Errorf("batches = %d (last %v), want 100, 52", len(batches), batches[len(batches)-1])
+	}
+	if len(archived) != 100 || len(failed) != 52 || failed[0] != "101" {
+		t.Errorf("archived %d, failed %d (first %v); want 100 archived, batch from 101 failed", len(archived), len(failed), failed[:1])
+	}
+	if !slices.Equal(reported, []string{"101"}) {
+		t.Errorf("reported = %v, want the failed batch", reported)
+	}
+}
@@ -51,7 +51,7 @@ all nested pages (grandchildren, etc.).`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.PageID = args[0]
-			return runChildren(opts)
+			return runChildren(cmd.Context(), opts)
 		},
 	}
 
@@ -80,7 +80,7 @@ type ChildrenOutput struct {
 	Total    int            `json:"total"`
 }
 
-func runChildren(opts *ChildrenOptions) error {
+func runChildren(ctx context.Context, opts *ChildrenOptions) error {
 	// Validate type filter
 	if opts.Type != "" && opts.Type != "page" && opts.Type != "folder" {
 		return fmt.Errorf("--type must be 'page' or 'folder', got '%s'", opts.Type)
@@ -91,7 +91,6 @@ func runChildren(opts *ChildrenOptions) error {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	var children []*api.PageChild
@@ -105,6 +104,9 @@ func runChildren(opts *ChildrenOptions) error {
 			if !opts.JSON {
 				fmt.Fprintln(opts.IO.Out, " done")
 			}
+			if msg := confluence.LastRateLimit().Warning(); msg != "" {
+				fmt.Fprintf(opts.IO.ErrOut, "Warning: %s\n", msg)
+			}
 		} else {
 			result, err := confluence.GetPageDescendants(ctx, opts.PageID, 100, "")
 			if err != nil {
@@ -153,7 +155,7 @@ func runChildren(opts *ChildrenOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, childrenOutput)
+		return output.PrintJSON(opts.IO, childrenOutput)
 	}
 
 	if len(childrenOutput.Children) == 0 {
@@ -0,0 +1,184 @@
+package page
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// CommentOptions holds the options for the comment command.
+type CommentOptions struct {
+	IO     *iostreams.IOStreams
+	PageID string
+	Body   string
+	List   bool
+	JSON   bool
+}
+
+// NewCmdComment creates the comment command.
+func NewCmdComment(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &CommentOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "comment <page-id>",
+		Short: "List or add comments on a page",
+		Long: `List footer and inline comments on a Confluence page, or add a footer comment.
+
+Inline comments are attached to highlighted text and can be resolved; the list
+shows whether each one is open or resolved. The comment body supports Markdown,
+like Jira comments.`,
+		Example: `  # List all comments on a page
+  atl confluence page comment 123456 --list
+
+  # Add a footer comment
+  atl confluence page comment 123456 --body "Looks good to me"
+
+  # Output as JSON
+  atl confluence page comment 123456 --list --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.PageID = args[0]
+			if opts.List == (opts.Body != "") {
+				return fmt.Errorf("specify exactly one of --list or --body")
+			}
+			if opts.List {
+				return runCommentList(cmd.Context(), opts)
+			}
+			return runCommentAdd(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Add a footer comment with this text")
+	cmd.Flags().BoolVarP(&opts.List, "list", "l", false, "List footer and inline comments")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// PageCommentListOutput represents the comments on a page.
+type PageCommentListOutput struct {
+	PageID   string               `json:"page_id"`
+	Comments []*PageCommentOutput `json:"comments"`
+	Total    int                  `json:"total"`
+}
+
+// PageCommentOutput represents a single page comment.
+type PageCommentOutput struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Resolution string `json:"resolution,omitempty"`
+	Selection  string `json:"selection,omitempty"`
+	AuthorID   string `json:"author_id,omitempty"`
+	Created    string `json:"created,omitempty"`
+	Body       string `json:"body"`
+}
+
+func runCommentList(ctx context.Context, opts *CommentOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	confluence := api.NewConfluenceService(client)
+
+	footer, err := confluence.GetPageFooterComments(ctx, opts.PageID)
+	if err != nil {
+		return fmt.Errorf("failed to get footer comments: %w", err)
+	}
+	inline, err := confluence.GetPageInlineComments(ctx, opts.PageID)
+	if err != nil {
+		return fmt.Errorf("failed to get inline comments: %w", err)
+	}
+
+	listOutput := &PageCommentListOutput{
+		PageID:   opts.PageID,
+		Comments: make([]*PageCommentOutput, 0, len(footer)+len(inline)),
+	}
+	for _, c := range footer {
+		listOutput.Comments = append(listOutput.Comments, formatPageComment(c, "footer"))
+	}
+	for _, c := range inline {
+		listOutput.Comments = append(listOutput.Comments, formatPageComment(c, "inline"))
+	}
+	listOutput.Total = len(listOutput.Comments)
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, listOutput)
+	}
+
+	if listOutput.Total == 0 {
+		fmt.Fprintf(opts.IO.Out, "No comments on page %s\n", opts.PageID)
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.Out, "# Comments on page %s (%d total)\n\n", opts.PageID, listOutput.Total)
+	for i, c := range listOutput.Comments {
+		if i > 0 {
+			fmt.Fprintln(opts.IO.Out, "---")
+		}
+		label := c.Type
+		if c.Resolution != "" {
+			label += ", " + c.Resolution
+		}
+		fmt.Fprintf(opts.IO.Out, "**%s** (%s) [ID: %s]\n\n", label, output.FormatDate(c.Created), c.ID)
+		if c.Selection != "" {
+			fmt.Fprintf(opts.IO.Out, "> %s\n\n", c.Selection)
+		}
+		fmt.Fprintln(opts.IO.Out, c.Body)
+		fmt.Fprintln(opts.IO.Out)
+	}
+
+	return nil
+}
+
+func runCommentAdd(ctx context.Context, opts *CommentOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	confluence := api.NewConfluenceService(client)
+
+	comment, err := confluence.AddPageFooterComment(ctx, opts.PageID, opts.Body)
+	if err != nil {
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	commentOutput := formatPageComment(comment, "footer")
+	if commentOutput.Body == "" {
+		commentOutput.Body = opts.Body
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, commentOutput)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Added comment to page %s\n", opts.PageID)
+	fmt.Fprintf(opts.IO.Out, "Comment ID: %s\n", commentOutput.ID)
+
+	return nil
+}
+
+func formatPageComment(c *api.PageComment, commentType string) *PageCommentOutput {
+	out := &PageCommentOutput{
+		ID:         c.ID,
+		Type:       commentType,
+		Resolution: c.ResolutionStatus,
+		Body:       c.Text(),
+	}
+	if c.Properties != nil {
+		out.Selection = c.Properties.OriginalSelection
+	}
+	if c.Version != nil {
+		out.AuthorID = c.Version.AuthorID
+		out.Created = c.Version.CreatedAt
+	}
+	return out
+}
@@ -22,6 +22,7 @@ type CreateOptions struct {
 	Draft    bool
 	Web      bool
 	JSON     bool
+	DryRun   bool
 }
 
 // NewCmdCreate creates the create command.
@@ -36,7 +37,10 @@ func NewCmdCreate(ios *iostreams.IOStreams) *cobra.Command {
 		Long: `Create a new page in a Confluence space.
 
 Use --draft to create a draft page that is not yet published.
-Draft pages can later be published using 'atl confluence page publish'.`,
+Draft pages can later be published using 'atl confluence page publish'.
+
+Use --dry-run to print the title, space, parent, and storage body that would be
+sent without contacting Confluence.`,
 		Example: `  # Create a page
   atl confluence page create --space DOCS --title "New Page"
 
@@ -49,6 +53,9 @@ Draft pages can later be published using 'atl confluence page publish'.`,
   # Create a child page
   atl confluence page create --space DOCS --title "Child Page" --parent 123456
 
+  # Preview the storage body without creating anything
+  atl confluence page create --space DOCS --title "New Page" --body "Content" --dry-run
+
   # Create and open in browser
   atl confluence page create --space DOCS --title "New Page" --web
 
@@ -65,7 +72,7 @@ Draft pages can later be published using 'atl confluence page publish'.`,
 			if len(missing) > 0 {
 				return fmt.Errorf("required flags not set: %v\n\nExample: atl confluence page create --space DOCS --title \"Page Title\"\n\nUse 'atl confluence space list' to see available spaces", missing)
 			}
-			return runCreate(opts)
+			return runCreate(cmd.Context(), opts)
 		},
 	}
 
@@ -76,6 +83,7 @@ Draft pages can later be published using 'atl confluence page publish'.`,
 	cmd.Flags().BoolVarP(&opts.Draft, "draft", "d", false, "Create as draft (not published)")
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open created page in browser")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would be created without creating it")
 
 	return cmd
 }
@@ -87,15 +95,31 @@ type PageCreateOutput struct {
 	SpaceID string `json:"space_id"`
 	Status  string `json:"status"`
 	URL     string `json:"url"`
+
+	// Only set with --dry-run.
+	DryRun   bool   `json:"dry_run,omitempty"`
+	SpaceKey string `json:"space_key,omitempty"`
+	ParentID string `json:"parent_id,omitempty"`
+	Body     string `json:"body,omitempty"`
 }
 
-func runCreate(opts *CreateOptions) error {
+func runCreate(ctx context.Context, opts *CreateOptions) error {
+	body := api.TextToStorage(opts.Body)
+
+	status := ""
+	if opts.Draft {
+		status = "draft"
+	}
+
+	if opts.DryRun {
+		return printCreateDryRun(opts, body, status)
+	}
+
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	// Get space ID from key
@@ -104,17 +128,8 @@ func runCreate(opts *CreateOptions) error {
 		return fmt.Errorf("failed to get space: %w", err)
 	}
 
-	body := opts.Body
-	if body == "" {
-		body = "<p></p>" // Empty paragraph
-	} else {
-		// Wrap plain text in paragraph tags
-		body = "<p>" + body + "</p>"
-	}
-
-	status := ""
-	if opts.Draft {
-		status = "draft"
+	if err := confluence.CheckCanCreatePage(ctx, space.ID, opts.Space); err != nil {
+		return err
 	}
 
 	page, err := confluence.CreatePage(ctx, space.ID, opts.Title, body, opts.ParentID, status)
@@ -140,7 +155,7 @@ func runCreate(opts *CreateOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, createOutput)
+		return output.PrintJSON(opts.IO, createOutput)
 	}
 
 	if page.Status == "draft" {
@@ -154,3 +169,38 @@ func runCreate(opts *CreateOptions) error {
 
 	return nil
 }
+
+// printCreateDryRun shows the page that would be created. It makes no API
+// calls, so the space key is shown as given rather than resolved to an ID.
+func printCreateDryRun(opts *CreateOptions, body, status string) error {
+	if status == "" {
+		status = "current"
+	}
+
+	createOutput := &PageCreateOutput{
+		Title:    opts.Title,
+		Status:   status,
+		DryRun:   true,
+		SpaceKey: opts.Space,
+		ParentID: opts.ParentID,
+		Body:     body,
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, createOutput)
+	}
+
+	parent := createOutput.ParentID
+	if parent == "" {
+		parent = "(space root)"
+	}
+
+	fmt.Fprintln(opts.IO.Out, "Dry run: no page was created.")
+	fmt.Fprintf(opts.IO.Out, "Title: %s\n", createOutput.Title)
+	fmt.Fprintf(opts.IO.Out, "Space: %s\n", createOutput.SpaceKey)
+	fmt.Fprintf(opts.IO.Out, "Parent: %s\n", parent)
+	fmt.Fprintf(opts.IO.Out, "Status: %s\n", createOutput.Status)
+	fmt.Fprintf(opts.IO.Out, "\nBody (storage format):\n%s\n", createOutput.Body)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package page
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func TestCreateDryRunMakesNoAPICall(t *testing.T) {
+	var out bytes.Buffer
+	ios := iostreams.Test()
+	ios.Out = &out
+
+	// runCreate must return before creating an API client.
+	opts := &CreateOptions{
+		IO:       ios,
+		Space:    "DOCS",
+		Title:    "Release notes",
+		ParentID: "123",
+		Body:     "Hello",
+		Draft:    true,
+		JSON:     true,
+		DryRun:   true,
+	}
+	if err := runCreate(context.Background(), opts); err != nil {
+		t.Fatalf("runCreate(--dry-run) error = %v", err)
+	}
+
+	var got PageCreateOutput
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", out.String(), err)
+	}
+	want := PageCreateOutput{
+		Title:    "Release notes",
+		Status:   "draft",
+		DryRun:   true,
+		SpaceKey: "DOCS",
+		ParentID: "123",
+		Body:     "<p>Hello</p>",
+	}
+	if got != want {
+		t.Errorf("dry run output = %+v, want %+v", got, want)
+	}
+}
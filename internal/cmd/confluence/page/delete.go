@@ -27,13 +27,15 @@ func NewCmdDelete(ios *iostreams.IOStreams) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "delete <page-id> [page-id...]",
+		Use:   "delete <page-id|url> [page-id|url...]",
 		Short: "Delete Confluence pages or folders",
 		Long: `Permanently delete one or more Confluence pages or folders.
 
 WARNING: This action cannot be undone. Deleted pages are moved to trash
 and will be permanently removed after the retention period.
 
+Pages can be given as IDs, page URLs, or tiny links (/wiki/x/...).
+
 For a reversible option, consider using 'atl confluence page archive' instead.`,
 		Example: `  # Delete a single page (will prompt for confirmation)
   atl confluence page delete 123456
@@ -41,6 +43,9 @@ For a reversible option, consider using 'atl confluence page archive' instead.`,
   # Delete multiple pages
   atl confluence page delete 123456 789012
 
+  # Delete a page by URL
+  atl confluence page delete https://mycompany.atlassian.net/wiki/spaces/DOCS/pages/123456/Old+Notes
+
   # Delete without confirmation prompt
   atl confluence page delete 123456 --force
 
@@ -51,8 +56,15 @@ For a reversible option, consider using 'atl confluence page archive' instead.`,
   atl confluence page delete 123456 --force --json`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.PageIDs = args
-			return runDelete(opts)
+			opts.PageIDs = make([]string, 0, len(args))
+			for _, arg := range args {
+				pageID, err := api.ParsePageRef(arg)
+				if err != nil {
+					return err
+				}
+				opts.PageIDs = append(opts.PageIDs, pageID)
+			}
+			return runDelete(cmd.Context(), opts)
 		},
 	}
 
@@ -60,6 +72,9 @@ For a reversible option, consider using 'atl confluence page archive' instead.`,
 	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Skip confirmation prompt")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
 
+	// --json skips the confirmation, so the default output format must not set it.
+	output.MarkConfirms(cmd)
+
 	return cmd
 }
 
@@ -71,9 +86,10 @@ type DeleteOutput struct {
 	Success bool     `json:"success"`
 }
 
-func runDelete(opts *DeleteOptions) error {
+func runDelete(ctx context.Context, opts *DeleteOptions) error {
 	// Confirm deletion unless --force is specified
 	if !opts.Force && !opts.JSON {
+		opts.IO.StopPager()
 		fmt.Fprintf(opts.IO.Out, "WARNING: This will permanently delete %d page(s)/folder(s).\n", len(opts.PageIDs))
 		fmt.Fprintf(opts.IO.Out, "Page IDs: %v\n", opts.PageIDs)
 		fmt.Fprint(opts.IO.Out, "Type 'yes' to confirm: ")
@@ -90,7 +106,6 @@ func runDelete(opts *DeleteOptions) error {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	// Process each page
@@ -117,7 +132,7 @@ func runDelete(opts *DeleteOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, deleteOutput)
+		return output.PrintJSON(opts.IO, deleteOutput)
 	}
 
 	if len(deletedPages) > 0 {
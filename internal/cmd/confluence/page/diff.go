@@ -0,0 +1,152 @@
+package page
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// DiffOptions holds the options for the diff command.
+type DiffOptions struct {
+	IO      *iostreams.IOStreams
+	PageID  string
+	From    int
+	To      int
+	Context int
+}
+
+// NewCmdDiff creates the diff command.
+func NewCmdDiff(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &DiffOptions{
+		IO:      ios,
+		Context: 3,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff <page-id>",
+		Short: "Show changes between two versions of a page",
+		Long: `Show a unified diff of the text of two page versions.
+
+Both versions are converted to plain text the same way 'atl confluence page view'
+does, so formatting-only changes may not appear. By default the current version
+is compared with the one before it.
+
+Output is colored when writing to a terminal; set NO_COLOR or pass --no-color
+to disable it.`,
+		Example: `  # What changed in the latest edit
+  atl confluence page diff 123456
+
+  # Compare two specific versions
+  atl confluence page diff 123456 --from 3 --to 7
+
+  # Compare version 3 with the current version
+  atl confluence page diff 123456 --from 3`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.PageID = args[0]
+			if opts.From < 0 || opts.To < 0 {
+				return fmt.Errorf("--from and --to must be positive version numbers")
+			}
+			if opts.Context < 0 {
+				return fmt.Errorf("--context cannot be negative")
+			}
+			return runDiff(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.From, "from", 0, "Older version number (default: version before --to)")
+	cmd.Flags().IntVar(&opts.To, "to", 0, "Newer version number (default: current)")
+	cmd.Flags().IntVarP(&opts.Context, "context", "U", 3, "Number of unchanged lines to show around each change")
+
+	return cmd
+}
+
+func runDiff(ctx context.Context, opts *DiffOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	confluence := api.NewConfluenceService(client)
+
+	toPage, err := confluence.GetPageVersion(ctx, opts.PageID, opts.To)
+	if err != nil {
+		return fmt.Errorf("failed to get page: %w", err)
+	}
+	toVersion := opts.To
+	if toVersion == 0 && toPage.Version != nil {
+		toVersion = toPage.Version.Number
+	}
+
+	fromVersion := opts.From
+	if fromVersion == 0 {
+		fromVersion = toVersion - 1
+	}
+	if fromVersion < 1 {
+		return fmt.Errorf("page %s has no version before %d", opts.PageID, toVersion)
+	}
+	if fromVersion == toVersion {
+		return fmt.Errorf("--from and --to are the same version (%d)", fromVersion)
+	}
+
+	fromPage, err := confluence.GetPageVersion(ctx, opts.PageID, fromVersion)
+	if err != nil {
+		return fmt.Errorf("failed to get version %d: %w", fromVersion, err)
+	}
+
+	fromLines := splitLines(pageBodyText(fromPage))
+	toLines := splitLines(pageBodyText(toPage))
+	if fromPage.Title != toPage.Title {
+		fromLines = append([]string{"# " + fromPage.Title, ""}, fromLines...)
+		toLines = append([]string{"# " + toPage.Title, ""}, toLines...)
+	}
+
+	hunks := output.UnifiedDiff(fromLines, toLines, opts.Context)
+	if len(hunks) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No text changes between version %d and %d\n", fromVersion, toVersion)
+		return nil
+	}
+
+	color := opts.IO.ColorEnabled()
+	printDiffLine(opts.IO, color, fmt.Sprintf("--- version %d", fromVersion))
+	printDiffLine(opts.IO, color, fmt.Sprintf("+++ version %d", toVersion))
+	for _, line := range hunks {
+		printDiffLine(opts.IO, color, line)
+	}
+
+	return nil
+}
+
+func printDiffLine(ios *iostreams.IOStreams, color bool, line string) {
+	if color {
+		line = output.StyleDiffLine(line)
+	}
+	fmt.Fprintln(ios.Out, line)
+}
+
+// pageBodyText returns the plain text of a page body in whichever format it has.
+func pageBodyText(page *api.Page) string {
+	if page.Body == nil {
+		return ""
+	}
+	if page.Body.Storage != nil && page.Body.Storage.Value != "" {
+		return storageToPlainText(page.Body.Storage.Value)
+	}
+	if page.Body.AtlasDocFormat != nil && page.Body.AtlasDocFormat.Value != "" {
+		return adfToPlainText(page.Body.AtlasDocFormat.Value)
+	}
+	return ""
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
@@ -19,6 +19,7 @@ type EditOptions struct {
 	Body   string
 	Append bool
 	JSON   bool
+	DryRun bool
 }
 
 // NewCmdEdit creates the edit command.
@@ -28,12 +29,17 @@ func NewCmdEdit(ios *iostreams.IOStreams) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "edit <page-id>",
+		Use:   "edit <page-id|url>",
 		Short: "Edit a Confluence page",
 		Long: `Edit the content of an existing Confluence page.
 
 By default, --body replaces the entire page content.
-Use --append to add content to the end of the existing page instead.`,
+Use --append to add content to the end of the existing page instead.
+
+Use --dry-run to print the resulting title, version, and storage body without
+saving. The current page is still read to compute them.
+
+The page can be given as an ID, a page URL, or a tiny link (/wiki/x/...).`,
 		Example: `  # Edit page title
   atl confluence page edit 123456 --title "Updated Title"
 
@@ -43,15 +49,25 @@ Use --append to add content to the end of the existing page instead.`,
   # Append to existing content
   atl confluence page edit 123456 --body "<p>Additional content</p>" --append
 
+  # Preview the result of an append without saving
+  atl confluence page edit 123456 --body "<p>Additional content</p>" --append --dry-run
+
   # Edit both title and content
   atl confluence page edit 123456 --title "New Title" --body "<p>New content</p>"
 
+  # Edit a page by URL
+  atl confluence page edit https://mycompany.atlassian.net/wiki/spaces/DOCS/pages/123456 --title "New Title"
+
   # Output as JSON
   atl confluence page edit 123456 --title "New Title" --json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.PageID = args[0]
-			return runEdit(opts)
+			pageID, err := api.ParsePageRef(args[0])
+			if err != nil {
+				return err
+			}
+			opts.PageID = pageID
+			return runEdit(cmd.Context(), opts)
 		},
 	}
 
@@ -59,6 +75,7 @@ Use --append to add content to the end of the existing page instead.`,
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "New page body content")
 	cmd.Flags().BoolVarP(&opts.Append, "append", "a", false, "Append to existing content instead of replacing")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the update without saving it")
 
 	return cmd
 }
@@ -69,9 +86,14 @@ type PageEditOutput struct {
 	Title   string `json:"title"`
 	Version int    `json:"version"`
 	URL     string `json:"url"`
+
+	// Only set with --dry-run.
+	DryRun          bool   `json:"dry_run,omitempty"`
+	PreviousVersion int    `json:"previous_version,omitempty"`
+	Body            string `json:"body,omitempty"`
 }
 
-func runEdit(opts *EditOptions) error {
+func runEdit(ctx context.Context, opts *EditOptions) error {
 	if opts.Title == "" && opts.Body == "" {
 		return fmt.Errorf("either --title or --body must be specified")
 	}
@@ -81,7 +103,6 @@ func runEdit(opts *EditOptions) error {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	// Get current page to get version and current values
@@ -119,6 +140,33 @@ func runEdit(opts *EditOptions) error {
 		currentVersion = currentPage.Version.Number
 	}
 
+	if opts.DryRun {
+		editOutput := &PageEditOutput{
+			ID:              currentPage.ID,
+			Title:           title,
+			Version:         currentVersion + 1,
+			URL:             fmt.Sprintf("https://%s/wiki/pages/viewpage.action?pageId=%s", client.Hostname(), currentPage.ID),
+			DryRun:          true,
+			PreviousVersion: currentVersion,
+			Body:            body,
+		}
+
+		if opts.JSON {
+			return output.PrintJSON(opts.IO, editOutput)
+		}
+
+		fmt.Fprintln(opts.IO.Out, "Dry run: page was not updated.")
+		fmt.Fprintf(opts.IO.Out, "Title: %s\n", editOutput.Title)
+		fmt.Fprintf(opts.IO.Out, "ID: %s\n", editOutput.ID)
+		fmt.Fprintf(opts.IO.Out, "Version: %d -> %d\n", editOutput.PreviousVersion, editOutput.Version)
+		fmt.Fprintf(opts.IO.Out, "\nBody (storage format):\n%s\n", editOutput.Body)
+		return nil
+	}
+
+	if err := confluence.CheckCanUpdatePage(ctx, opts.PageID); err != nil {
+		return err
+	}
+
 	page, err := confluence.UpdatePage(ctx, opts.PageID, title, body, currentVersion, "Updated via atl CLI")
 	if err != nil {
 		return fmt.Errorf("failed to update page: %w", err)
@@ -142,7 +190,7 @@ func runEdit(opts *EditOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, editOutput)
+		return output.PrintJSON(opts.IO, editOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Updated page: %s\n", editOutput.Title)
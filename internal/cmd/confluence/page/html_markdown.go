@@ -0,0 +1,306 @@
+package page
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// htmlNode is an element or, when tag is empty, a text node.
+type htmlNode struct {
+	tag      string
+	attrs    map[string]string
+	text     string
+	children []*htmlNode
+}
+
+// htmlBlockTags are rendered as their own paragraphs; everything else is
+// treated as inline content.
+var htmlBlockTags = map[string]bool{
+	"p": true, "div": true, "section": true, "article": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "table": true, "pre": true, "blockquote": true, "hr": true,
+}
+
+// htmlSkipTags are dropped together with their content.
+var htmlSkipTags = map[string]bool{"script": true, "style": true, "head": true}
+
+var (
+	htmlSpaceRegex    = regexp.MustCompile(`[ \t\r\n\f]+`)
+	markdownGapsRegex = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToMarkdown converts the rendered HTML of a page (the view body) to
+// Markdown: headings, paragraphs, nested lists, tables, links, images,
+// bold/italic, inline code, and code blocks. Unknown elements keep their
+// text. Malformed HTML is converted as far as it can be parsed.
+func htmlToMarkdown(html string) string {
+	var b strings.Builder
+	renderMarkdownBlocks(&b, parseHTML(html).children)
+
+	text := markdownGapsRegex.ReplaceAllString(b.String(), "\n\n")
+	return strings.TrimSpace(text)
+}
+
+// parseHTML builds a node tree with encoding/xml in its lenient HTML mode,
+// which handles void elements (<br>, <img>) and named entities.
+func parseHTML(html string) *htmlNode {
+	decoder := xml.NewDecoder(strings.NewReader("<root>" + html + "</root>"))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	// The document node; the wrapping <root> element becomes its only child.
+	doc := &htmlNode{}
+	stack := []*htmlNode{doc}
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		top := stack[len(stack)-1]
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &htmlNode{tag: strings.ToLower(t.Name.Local), attrs: make(map[string]string)}
+			for _, a := range t.Attr {
+				n.attrs[strings.ToLower(a.Name.Local)] = a.Value
+			}
+			top.children = append(top.children, n)
+			stack = append(stack, n)
+		case xml.EndElement:
+			// Close the nearest matching element; stray end tags are ignored.
+			name := strings.ToLower(t.Name.Local)
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].tag == name {
+					stack = stack[:i]
+					break
+				}
+			}
+		case xml.CharData:
+			top.children = append(top.children, &htmlNode{text: strings.ReplaceAll(string(t), "\u00a0", " ")})
+		}
+	}
+	if len(doc.children) == 0 {
+		return &htmlNode{tag: "root"}
+	}
+	return doc.children[0]
+}
+
+// renderMarkdownBlocks writes nodes as blocks separated by blank lines.
+// Runs of inline nodes between blocks form a paragraph.
+func renderMarkdownBlocks(b *strings.Builder, nodes []*htmlNode) {
+	var inline []*htmlNode
+	flush := func() {
+		if text := trimLines(renderMarkdownInline(inline)); text != "" {
+			b.WriteString(text + "\n\n")
+		}
+		inline = nil
+	}
+
+	for _, n := range nodes {
+		if n.tag == "" || !htmlBlockTags[n.tag] {
+			if !htmlSkipTags[n.tag] {
+				inline = append(inline, n)
+			}
+			continue
+		}
+		flush()
+
+		switch n.tag {
+		case "h1", "h2", "h3", "h4", "h5", "h6":
+			level := int(n.tag[1] - '0')
+			if text := strings.TrimSpace(renderMarkdownInline(n.children)); text != "" {
+				b.WriteString(strings.Repeat("#", level) + " " + text + "\n\n")
+			}
+		case "ul", "ol":
+			renderMarkdownList(b, n, "")
+			b.WriteString("\n")
+		case "table":
+			renderMarkdownTable(b, n)
+		case "pre":
+			code := strings.TrimRight(htmlTextContent(n), "\n")
+			b.WriteString("```\n" + code + "\n```\n\n")
+		case "blockquote":
+			var inner strings.Builder
+			renderMarkdownBlocks(&inner, n.children)
+			for _, line := range strings.Split(strings.TrimSpace(inner.String()), "\n") {
+				b.WriteString(strings.TrimRight("> "+line, " ") + "\n")
+			}
+			b.WriteString("\n")
+		case "hr":
+			b.WriteString("---\n\n")
+		default: // p, div and other containers
+			renderMarkdownBlocks(b, n.children)
+		}
+	}
+	flush()
+}
+
+// renderMarkdownList writes the items of a ul or ol, one per line, with
+// nested lists indented below their item.
+func renderMarkdownList(b *strings.Builder, list *htmlNode, indent string) {
+	number := 0
+	for _, item := range list.children {
+		if item.tag != "li" {
+			continue
+		}
+		number++
+		marker := "- "
+		if list.tag == "ol" {
+			marker = fmt.Sprintf("%d. ", number)
+		}
+
+		var content, nested []*htmlNode
+		for _, c := range item.children {
+			if c.tag == "ul" || c.tag == "ol" {
+				nested = append(nested, c)
+			} else {
+				content = append(content, c)
+			}
+		}
+
+		text := strings.TrimSpace(renderMarkdownInline(content))
+		text = strings.ReplaceAll(text, "\n", "\n"+indent+strings.Repeat(" ", len(marker)))
+		b.WriteString(indent + marker + text + "\n")
+		for _, n := range nested {
+			renderMarkdownList(b, n, indent+strings.Repeat(" ", len(marker)))
+		}
+	}
+}
+
+// renderMarkdownTable writes a table as a Markdown table with the first row
+// as the header.
+func renderMarkdownTable(b *strings.Builder, table *htmlNode) {
+	var rows [][]string
+	width := 0
+	var collect func(n *htmlNode)
+	collect = func(n *htmlNode) {
+		for _, c := range n.children {
+			switch c.tag {
+			case "tr":
+				var cells []string
+				for _, cell := range c.children {
+					if cell.tag == "td" || cell.tag == "th" {
+						text := strings.TrimSpace(renderMarkdownInline(cell.children))
+						text = strings.ReplaceAll(text, "\n", " ")
+						cells = append(cells, strings.ReplaceAll(text, "|", `\|`))
+					}
+				}
+				rows = append(rows, cells)
+				width = max(width, len(cells))
+			case "thead", "tbody", "tfoot":
+				collect(c)
+			}
+		}
+	}
+	collect(table)
+	if len(rows) == 0 || width == 0 {
+		return
+	}
+
+	writeRow := func(cells []string) {
+		for len(cells) < width {
+			cells = append(cells, "")
+		}
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+	writeRow(rows[0])
+	separator := make([]string, width)
+	for i := range separator {
+		separator[i] = "---"
+	}
+	writeRow(separator)
+	for _, row := range rows[1:] {
+		writeRow(row)
+	}
+	b.WriteString("\n")
+}
+
+// renderMarkdownInline renders nodes as inline Markdown. Nested blocks
+// (e.g. a paragraph inside a list item) are joined with line breaks.
+func renderMarkdownInline(nodes []*htmlNode) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		if n.tag == "" {
+			b.WriteString(htmlSpaceRegex.ReplaceAllString(n.text, " "))
+			continue
+		}
+		if htmlSkipTags[n.tag] {
+			continue
+		}
+
+		inner := renderMarkdownInline(n.children)
+		switch n.tag {
+		case "strong", "b":
+			b.WriteString(wrapMarkdown(inner, "**"))
+		case "em", "i":
+			b.WriteString(wrapMarkdown(inner, "_"))
+		case "code":
+			if code := htmlTextContent(n); code != "" {
+				b.WriteString("`" + code + "`")
+			}
+		case "a":
+			href := n.attrs["href"]
+			text := strings.TrimSpace(inner)
+			switch {
+			case href == "":
+				b.WriteString(inner)
+			case text == "":
+				b.WriteString(href)
+			default:
+				b.WriteString("[" + text + "](" + href + ")")
+			}
+		case "img":
+			if src := n.attrs["src"]; src != "" {
+				b.WriteString("![" + n.attrs["alt"] + "](" + src + ")")
+			}
+		case "br":
+			b.WriteString("\n")
+		default:
+			if htmlBlockTags[n.tag] {
+				b.WriteString("\n" + strings.TrimSpace(inner) + "\n")
+			} else {
+				b.WriteString(inner)
+			}
+		}
+	}
+	return b.String()
+}
+
+// trimLines trims each line, removing the spaces left around <br> breaks,
+// and the text as a whole.
+func trimLines(text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrapMarkdown surrounds text with a marker, keeping surrounding spaces
+// outside it so "<b>bold </b>text" becomes "**bold** text".
+func wrapMarkdown(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	start := strings.Index(text, trimmed)
+	return text[:start] + marker + trimmed + marker + text[start+len(trimmed):]
+}
+
+// htmlTextContent returns the text of a node and its descendants as-is.
+func htmlTextContent(n *htmlNode) string {
+	if n.tag == "" {
+		return n.text
+	}
+	var b strings.Builder
+	for _, c := range n.children {
+		if c.tag == "br" {
+			b.WriteString("\n")
+			continue
+		}
+		b.WriteString(htmlTextContent(c))
+	}
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package page
+
+import "testing"
+
+func TestHTMLToMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{
+			name: "nested lists",
+			html: `<ul><li>First <strong>bold</strong></li><li>Second<ol><li>One</li><li>Two &amp; more</li></ol></li></ul>`,
+			want: "- First **bold**\n- Second\n  1. One\n  2. Two & more",
+		},
+		{
+			name: "table with header and pipes",
+			html: `<div class="table-wrap"><table><thead><tr><th>Name</th><th>Status</th></tr></thead>` +
+				`<tbody><tr><td><a href="https://example.com/api">API</a></td><td>a | b</td></tr>` +
+				`<tr><td>Worker</td></tr></tbody></table></div>`,
+			want: "| Name | Status |\n| --- | --- |\n| [API](https://example.com/api) | a \\| b |\n| Worker |  |",
+		},
+		{
+			name: "headings, inline formatting, and line breaks",
+			html: `<h2>Setup</h2><p>Run <code>make</code> then <em>wait</em>.<br/>Done&nbsp;now.</p>`,
+			want: "## Setup\n\nRun `make` then _wait_.\nDone now.",
+		},
+		{
+			name: "code block keeps whitespace",
+			html: "<pre>func main() {\n    fmt.Println(1)\n}</pre><p>after</p>",
+			want: "```\nfunc main() {\n    fmt.Println(1)\n}\n```\n\nafter",
+		},
+		{
+			name: "unclosed paragraph and void image",
+			html: `<p>Diagram: <img src="/wiki/download/a.png" alt="arch"><p>Next`,
+			want: "Diagram: ![arch](/wiki/download/a.png)\n\nNext",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := htmlToMarkdown(tt.html); got != tt.want {
+				t.Errorf("htmlToMarkdown() =\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -62,7 +62,7 @@ Filter by status to see draft or archived pages.`,
 			if opts.Space == "" {
 				return fmt.Errorf("--space flag is required\n\nUse 'atl confluence space list' to see available spaces")
 			}
-			return runList(opts)
+			return runList(cmd.Context(), opts)
 		},
 	}
 
@@ -93,13 +93,12 @@ type PageOutput struct {
 	CreatedAt string `json:"created_at,omitempty"`
 }
 
-func runList(opts *ListOptions) error {
+func runList(ctx context.Context, opts *ListOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	// First get the space to get its ID
@@ -124,6 +123,9 @@ func runList(opts *ListOptions) error {
 		if !opts.JSON {
 			fmt.Fprintln(opts.IO.Out, " done")
 		}
+		if msg := confluence.LastRateLimit().Warning(); msg != "" {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: %s\n", msg)
+		}
 	} else {
 		// Single page fetch
 		result, err := confluence.GetPages(ctx, space.ID, opts.Limit, opts.Cursor, opts.Status)
@@ -131,11 +133,8 @@ func runList(opts *ListOptions) error {
 			return fmt.Errorf("failed to get pages: %w", err)
 		}
 		pages = result.Results
-
-		if result.Links != nil && result.Links.Next != "" {
-			hasMore = true
-			nextCursor = extractCursorFromURL(result.Links.Next)
-		}
+		nextCursor = result.NextCursor()
+		hasMore = nextCursor != ""
 	}
 
 	listOutput := &PageListOutput{
@@ -156,7 +155,7 @@ func runList(opts *ListOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, listOutput)
+		return output.PrintJSON(opts.IO, listOutput)
 	}
 
 	if len(listOutput.Pages) == 0 {
@@ -190,23 +189,3 @@ func runList(opts *ListOptions) error {
 
 	return nil
 }
-
-// extractCursorFromURL extracts the cursor parameter from a pagination URL.
-func extractCursorFromURL(nextURL string) string {
-	const prefix = "cursor="
-	start := 0
-	for i := 0; i < len(nextURL)-len(prefix); i++ {
-		if nextURL[i:i+len(prefix)] == prefix {
-			start = i + len(prefix)
-			break
-		}
-	}
-	if start == 0 {
-		return ""
-	}
-	end := start
-	for end < len(nextURL) && nextURL[end] != '&' {
-		end++
-	}
-	return nextURL[start:end]
-}
@@ -60,7 +60,7 @@ before/after a sibling page. You can also move pages between spaces.`,
 				return fmt.Errorf("cannot use both --target and --space")
 			}
 
-			return runMove(opts)
+			return runMove(cmd.Context(), opts)
 		},
 	}
 
@@ -81,13 +81,12 @@ type MoveOutput struct {
 	Success  bool   `json:"success"`
 }
 
-func runMove(opts *MoveOptions) error {
+func runMove(ctx context.Context, opts *MoveOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	var moveOutput *MoveOutput
@@ -131,7 +130,7 @@ func runMove(opts *MoveOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, moveOutput)
+		return output.PrintJSON(opts.IO, moveOutput)
 	}
 
 	if opts.Space != "" {
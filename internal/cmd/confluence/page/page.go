@@ -11,12 +11,13 @@ func NewCmdPage(ios *iostreams.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "page",
 		Short: "Work with Confluence pages",
-		Long:  `View, create, and edit Confluence pages.`,
+		Long:  `View, create, edit, and comment on Confluence pages.`,
 	}
 
 	cmd.AddCommand(NewCmdView(ios))
 	cmd.AddCommand(NewCmdList(ios))
 	cmd.AddCommand(NewCmdCreate(ios))
+	cmd.AddCommand(NewCmdUpsert(ios))
 	cmd.AddCommand(NewCmdEdit(ios))
 	cmd.AddCommand(NewCmdDelete(ios))
 	cmd.AddCommand(NewCmdPublish(ios))
@@ -24,6 +25,10 @@ func NewCmdPage(ios *iostreams.IOStreams) *cobra.Command {
 	cmd.AddCommand(NewCmdSearch(ios))
 	cmd.AddCommand(NewCmdArchive(ios))
 	cmd.AddCommand(NewCmdMove(ios))
+	cmd.AddCommand(NewCmdComment(ios))
+	cmd.AddCommand(NewCmdProperty(ios))
+	cmd.AddCommand(NewCmdDiff(ios))
+	cmd.AddCommand(NewCmdStats(ios))
 
 	return cmd
 }
@@ -0,0 +1,176 @@
+package page
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// PropertyOptions holds the options for the property command.
+type PropertyOptions struct {
+	IO     *iostreams.IOStreams
+	PageID string
+	List   bool
+	Get    string
+	Set    string
+	Delete string
+	JSON   bool
+}
+
+// NewCmdProperty creates the property command.
+func NewCmdProperty(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &PropertyOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "property <page-id>",
+		Short: "Get, set, or delete page properties",
+		Long: `Manage content properties on a Confluence page.
+
+Properties are JSON values that apps and integrations store on a page under
+a key. They are not shown in the page content.
+
+The value for --set is JSON given inline, read from a file with @file, or
+read from stdin with @-. It must parse as JSON before it is sent.`,
+		Example: `  # List properties on a page
+  atl confluence page property 123456 --list
+
+  # Show a property value
+  atl confluence page property 123456 --get review-state
+
+  # Set a property from inline JSON
+  atl confluence page property 123456 --set 'review-state={"approved":true}'
+
+  # Set a property from a file
+  atl confluence page property 123456 --set review-state=@value.json
+
+  # Delete a property
+  atl confluence page property 123456 --delete review-state`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.PageID = args[0]
+
+			modes := 0
+			for _, set := range []bool{opts.List, opts.Get != "", opts.Set != "", opts.Delete != ""} {
+				if set {
+					modes++
+				}
+			}
+			if modes != 1 {
+				return fmt.Errorf("specify exactly one of --list, --get, --set, or --delete")
+			}
+
+			return runProperty(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.List, "list", "l", false, "List properties")
+	cmd.Flags().StringVarP(&opts.Get, "get", "g", "", "Show the value of a property")
+	cmd.Flags().StringVarP(&opts.Set, "set", "s", "", "Set a property: name=JSON, name=@file, or name=@-")
+	cmd.Flags().StringVarP(&opts.Delete, "delete", "d", "", "Delete a property")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// PropertyOutput represents a page property in output.
+type PropertyOutput struct {
+	PageID  string          `json:"page_id"`
+	Key     string          `json:"key"`
+	Value   json.RawMessage `json:"value,omitempty"`
+	Version int             `json:"version,omitempty"`
+	Action  string          `json:"action,omitempty"`
+}
+
+func runProperty(ctx context.Context, opts *PropertyOptions) error {
+	var setKey string
+	var setValue json.RawMessage
+	if opts.Set != "" {
+		key, value, err := opts.IO.ReadJSONAssignment(opts.Set)
+		if err != nil {
+			return err
+		}
+		setKey, setValue = key, value
+	}
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	confluence := api.NewConfluenceService(client)
+
+	switch {
+	case opts.List:
+		properties, err := confluence.GetPageProperties(ctx, opts.PageID)
+		if err != nil {
+			return fmt.Errorf("failed to list properties: %w", err)
+		}
+		listOutput := make([]*PropertyOutput, 0, len(properties))
+		for _, p := range properties {
+			listOutput = append(listOutput, formatProperty(opts.PageID, p))
+		}
+		if opts.JSON {
+			return output.PrintJSON(opts.IO, listOutput)
+		}
+		if len(listOutput) == 0 {
+			fmt.Fprintf(opts.IO.Out, "No properties on page %s\n", opts.PageID)
+			return nil
+		}
+		for _, p := range listOutput {
+			fmt.Fprintln(opts.IO.Out, p.Key)
+		}
+		return nil
+
+	case opts.Get != "":
+		property, err := confluence.GetPageProperty(ctx, opts.PageID, opts.Get)
+		if err != nil {
+			return fmt.Errorf("failed to get property %s: %w", opts.Get, err)
+		}
+		if opts.JSON {
+			return output.PrintJSON(opts.IO, formatProperty(opts.PageID, property))
+		}
+		return output.PrintJSON(opts.IO, property.Value)
+
+	case opts.Delete != "":
+		if err := confluence.DeletePageProperty(ctx, opts.PageID, opts.Delete); err != nil {
+			return fmt.Errorf("failed to delete property %s: %w", opts.Delete, err)
+		}
+		if opts.JSON {
+			return output.PrintJSON(opts.IO, &PropertyOutput{PageID: opts.PageID, Key: opts.Delete, Action: "deleted"})
+		}
+		fmt.Fprintf(opts.IO.Out, "Deleted property %s from page %s\n", opts.Delete, opts.PageID)
+		return nil
+	}
+
+	property, err := confluence.SetPageProperty(ctx, opts.PageID, setKey, setValue)
+	if err != nil {
+		return fmt.Errorf("failed to set property %s: %w", setKey, err)
+	}
+	if opts.JSON {
+		propertyOutput := formatProperty(opts.PageID, property)
+		propertyOutput.Action = "set"
+		return output.PrintJSON(opts.IO, propertyOutput)
+	}
+	fmt.Fprintf(opts.IO.Out, "Set property %s on page %s\n", setKey, opts.PageID)
+	return nil
+}
+
+func formatProperty(pageID string, p *api.ContentProperty) *PropertyOutput {
+	out := &PropertyOutput{
+		PageID: pageID,
+		Key:    p.Key,
+		Value:  p.Value,
+	}
+	if p.Version != nil {
+		out.Version = p.Version.Number
+	}
+	return out
+}
@@ -47,7 +47,7 @@ Use 'atl confluence page list --status draft' to find draft pages.`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.PageIDs = args
-			return runPublish(opts)
+			return runPublish(cmd.Context(), opts)
 		},
 	}
 
@@ -72,13 +72,12 @@ type PublishedPage struct {
 	URL   string `json:"url"`
 }
 
-func runPublish(opts *PublishOptions) error {
+func runPublish(ctx context.Context, opts *PublishOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	var publishedPages []*PublishedPage
@@ -118,7 +117,7 @@ func runPublish(opts *PublishOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, publishOutput)
+		return output.PrintJSON(opts.IO, publishOutput)
 	}
 
 	for _, page := range publishedPages {
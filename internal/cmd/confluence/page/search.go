@@ -49,7 +49,7 @@ By default, searches page titles. Use --cql for advanced searches.`,
 			if opts.Query == "" && opts.CQL == "" {
 				return fmt.Errorf("either --query or --cql flag is required")
 			}
-			return runSearch(opts)
+			return runSearch(cmd.Context(), opts)
 		},
 	}
 
@@ -79,13 +79,12 @@ type SearchOutput struct {
 	Total   int                   `json:"total"`
 }
 
-func runSearch(opts *SearchOptions) error {
+func runSearch(ctx context.Context, opts *SearchOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	var result *api.ConfluenceSearchResponse
@@ -124,7 +123,7 @@ func runSearch(opts *SearchOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, searchOutput)
+		return output.PrintJSON(opts.IO, searchOutput)
 	}
 
 	if len(searchOutput.Results) == 0 {
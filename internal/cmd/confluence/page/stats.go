@@ -0,0 +1,209 @@
+package page
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// StatsOptions holds the options for the stats command.
+type StatsOptions struct {
+	IO     *iostreams.IOStreams
+	PageID string
+	JSON   bool
+}
+
+// NewCmdStats creates the stats command.
+func NewCmdStats(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &StatsOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stats <page-id|url>",
+		Short: "Show word count and other statistics of a page",
+		Long: `Show content statistics of a Confluence page, for docs audits.
+
+Words and characters are counted on the page text, without macro
+parameters and code blocks. Headings (h1-h6) and macros are counted in
+the storage body, and attachments on the page. The last edit is the
+current version's date and author account ID.`,
+		Example: `  # Show page statistics
+  atl confluence page stats 123456
+
+  # Output as JSON
+  atl confluence page stats 123456 --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pageID, err := api.ParsePageRef(args[0])
+			if err != nil {
+				return err
+			}
+			opts.PageID = pageID
+			return runStats(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// PageStatsOutput represents the statistics of a page.
+type PageStatsOutput struct {
+	ID           string         `json:"id"`
+	Title        string         `json:"title"`
+	Version      int            `json:"version"`
+	LastEdited   string         `json:"last_edited,omitempty"`
+	LastEditedBy string         `json:"last_edited_by,omitempty"`
+	Words        int            `json:"words"`
+	Characters   int            `json:"characters"`
+	Headings     int            `json:"headings"`
+	Macros       int            `json:"macros"`
+	MacroNames   map[string]int `json:"macro_names,omitempty"`
+	Attachments  int            `json:"attachments"`
+}
+
+func runStats(ctx context.Context, opts *StatsOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	confluence := api.NewConfluenceService(client)
+
+	page, err := confluence.GetPage(ctx, opts.PageID)
+	if err != nil {
+		return fmt.Errorf("failed to get page: %w", err)
+	}
+
+	attachments, err := confluence.GetPageAttachments(ctx, page.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	storage := ""
+	if page.Body != nil && page.Body.Storage != nil {
+		storage = page.Body.Storage.Value
+	}
+	if storage == "" {
+		fmt.Fprintf(opts.IO.ErrOut, "Warning: page %s has no storage body; content counts are zero\n", page.ID)
+	}
+
+	statsOutput := storageStats(storage)
+	statsOutput.ID = page.ID
+	statsOutput.Title = page.Title
+	statsOutput.Attachments = len(attachments)
+	if page.Version != nil {
+		statsOutput.Version = page.Version.Number
+		statsOutput.LastEdited = page.Version.CreatedAt
+		statsOutput.LastEditedBy = page.Version.AuthorID
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, statsOutput)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "# %s\n\n", statsOutput.Title)
+	rows := [][]string{
+		{"Words", strconv.Itoa(statsOutput.Words)},
+		{"Characters", strconv.Itoa(statsOutput.Characters)},
+		{"Headings", strconv.Itoa(statsOutput.Headings)},
+		{"Macros", formatMacroCount(statsOutput.Macros, statsOutput.MacroNames)},
+		{"Attachments", strconv.Itoa(statsOutput.Attachments)},
+		{"Version", strconv.Itoa(statsOutput.Version)},
+	}
+	if statsOutput.LastEdited != "" {
+		rows = append(rows, []string{"Last edited", statsOutput.LastEdited})
+	}
+	if statsOutput.LastEditedBy != "" {
+		rows = append(rows, []string{"Last edited by", statsOutput.LastEditedBy})
+	}
+	output.SimpleTable(opts.IO.Out, []string{"METRIC", "VALUE"}, rows)
+
+	return nil
+}
+
+var (
+	statsMacroRegex     = regexp.MustCompile(`<ac:structured-macro(?:\s[^>]*)?>`)
+	statsMacroNameRegex = regexp.MustCompile(`\sac:name="([^"]*)"`)
+	statsHeadingRegex   = regexp.MustCompile(`(?i)<h[1-6][\s>]`)
+	statsParamRegex     = regexp.MustCompile(`(?s)<ac:parameter[^>]*>.*?</ac:parameter>`)
+	statsPlainBodyRegex = regexp.MustCompile(`(?s)<ac:plain-text-body>.*?</ac:plain-text-body>`)
+	statsTagRegex       = regexp.MustCompile(`</?([a-zA-Z:-]+)[^>]*>`)
+
+	// statsInlineTags don't separate words, so "<strong>x</strong>y" is one word.
+	statsInlineTags = map[string]bool{
+		"a": true, "b": true, "strong": true, "i": true, "em": true, "u": true,
+		"s": true, "del": true, "code": true, "span": true, "sub": true, "sup": true,
+		"ac:link": true, "ac:link-body": true, "ac:plain-text-link-body": true,
+	}
+)
+
+// storageStats counts the words, characters, headings, and macros of a
+// storage format body. Macro parameters and plain-text macro bodies (code
+// blocks) are not page text; rich-text macro bodies such as panels are.
+func storageStats(storage string) *PageStatsOutput {
+	stats := &PageStatsOutput{}
+
+	for _, tag := range statsMacroRegex.FindAllString(storage, -1) {
+		stats.Macros++
+		if m := statsMacroNameRegex.FindStringSubmatch(tag); m != nil && m[1] != "" {
+			if stats.MacroNames == nil {
+				stats.MacroNames = make(map[string]int)
+			}
+			stats.MacroNames[m[1]]++
+		}
+	}
+	stats.Headings = len(statsHeadingRegex.FindAllStringIndex(storage, -1))
+
+	text := statsParamRegex.ReplaceAllString(storage, " ")
+	text = statsPlainBodyRegex.ReplaceAllString(text, " ")
+	text = statsTagRegex.ReplaceAllStringFunc(text, func(tag string) string {
+		if statsInlineTags[strings.ToLower(statsTagRegex.FindStringSubmatch(tag)[1])] {
+			return ""
+		}
+		return " "
+	})
+	words := strings.Fields(html.UnescapeString(text))
+	stats.Words = len(words)
+	// Characters of the text with each run of whitespace counted once.
+	if len(words) > 0 {
+		stats.Characters = utf8.RuneCountInString(strings.Join(words, " "))
+	}
+
+	return stats
+}
+
+// formatMacroCount formats a macro count with its names, e.g. "3 (code x2, toc)".
+func formatMacroCount(total int, names map[string]int) string {
+	if len(names) == 0 {
+		return strconv.Itoa(total)
+	}
+
+	keys := make([]string, 0, len(names))
+	for name := range names {
+		keys = append(keys, name)
+	}
+	slices.Sort(keys)
+
+	parts := make([]string, len(keys))
+	for i, name := range keys {
+		parts[i] = name
+		if n := names[name]; n > 1 {
+			parts[i] = fmt.Sprintf("%s x%d", name, n)
+		}
+	}
+	return fmt.Sprintf("%d (%s)", total, strings.Join(parts, ", "))
+}
@@ -0,0 +1,44 @@
+package page
+
+import "testing"
+
+func TestStorageStats(t *testing.T) {
+	storage := `<h1>Runbook</h1>` +
+		`<p>Restart the <strong>api</strong>-server first.</p>` +
+		`<ac:structured-macro ac:name="toc" ac:schema-version="1"/>` +
+		`<h2 id="steps">Steps</h2>` +
+		`<ac:structured-macro ac:name="code"><ac:parameter ac:name="language">bash</ac:parameter>` +
+		`<ac:plain-text-body><![CDATA[systemctl restart api]]></ac:plain-text-body></ac:structured-macro>` +
+		`<ac:structured-macro ac:name="info"><ac:rich-text-body><p>Ask&nbsp;ops &amp; wait.</p></ac:rich-text-body></ac:structured-macro>` +
+		`<ac:structured-macro ac:name="code"><ac:plain-text-body><![CDATA[exit 0]]></ac:plain-text-body></ac:structured-macro>` +
+		`<hr/><p>Done</p>`
+
+	got := storageStats(storage)
+
+	// Runbook Restart the api-server first. Steps Ask ops & wait. Done
+	if got.Words != 11 {
+		t.Errorf("Words = %d, want 11", got.Words)
+	}
+	if want := len("Runbook Restart the api-server first. Steps Ask ops & wait. Done"); got.Characters != want {
+		t.Errorf("Characters = %d, want %d", got.Characters, want)
+	}
+	if got.Headings != 2 {
+		t.Errorf("Headings = %d, want 2", got.Headings)
+	}
+	if got.Macros != 4 {
+		t.Errorf("Macros = %d, want 4", got.Macros)
+	}
+	if got.MacroNames["code"] != 2 || got.MacroNames["toc"] != 1 || got.MacroNames["info"] != 1 {
+		t.Errorf("MacroNames = %v, want code x2, info, toc", got.MacroNames)
+	}
+	if s := formatMacroCount(got.Macros, got.MacroNames); s != "4 (code x2, info, toc)" {
+		t.Errorf("formatMacroCount() = %q", s)
+	}
+}
+
+func TestStorageStatsEmpty(t *testing.T) {
+	got := storageStats("")
+	if got.Words != 0 || got.Characters != 0 || got.Macros != 0 || got.MacroNames != nil {
+		t.Errorf("storageStats(\"\") = %+v, want zero counts", got)
+	}
+}
@@ -0,0 +1,191 @@
+package page
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// UpsertOptions holds the options for the upsert command.
+type UpsertOptions struct {
+	IO       *iostreams.IOStreams
+	Space    string
+	Title    string
+	ParentID string
+	Body     string
+	File     string
+	Markdown bool
+	JSON     bool
+}
+
+// NewCmdUpsert creates the upsert command.
+func NewCmdUpsert(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &UpsertOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "upsert",
+		Short: "Create a page, or update it if it already exists",
+		Long: `Update the page with exactly this title in the space, or create it if there
+is none. Running the same command again updates the page to a new version
+instead of creating a duplicate, which makes it safe for doc automation. If
+several pages in the space have the title, nothing is written.
+
+The body comes from --file (use - for stdin) or --body. It is sent as
+Confluence storage format (XHTML) unless --markdown is given, in which case it
+is converted from Markdown.
+
+--parent only places a newly created page; an existing page is not moved.`,
+		Example: `  # Publish generated docs from a Markdown file
+  atl confluence page upsert --space DOCS --title "API Reference" --file api.md --markdown
+
+  # Create under a parent page the first time
+  atl confluence page upsert --space DOCS --title "Release Notes" --file notes.md --markdown --parent 123456
+
+  # Storage format from stdin
+  ./render-docs | atl confluence page upsert --space DOCS --title "Status" --file -
+
+  # Output as JSON
+  atl confluence page upsert --space DOCS --title "Status" --body "All green" --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var missing []string
+			if opts.Space == "" {
+				missing = append(missing, "--space")
+			}
+			if opts.Title == "" {
+				missing = append(missing, "--title")
+			}
+			if len(missing) > 0 {
+				return fmt.Errorf("required flags not set: %v\n\nExample: atl confluence page upsert --space DOCS --title \"Page Title\" --file page.md --markdown", missing)
+			}
+			if opts.File != "" && opts.Body != "" {
+				return fmt.Errorf("--file and --body cannot be used together")
+			}
+			if opts.File == "" && opts.Body == "" {
+				return fmt.Errorf("page content is required: use --file or --body")
+			}
+			return runUpsert(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Space, "space", "s", "", "Space key (required)")
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Exact page title (required)")
+	cmd.Flags().StringVarP(&opts.ParentID, "parent", "p", "", "Parent page ID when the page is created")
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Page body content")
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "Read the page body from a file (- for stdin)")
+	cmd.Flags().BoolVar(&opts.Markdown, "markdown", false, "Convert the body from Markdown")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// PageUpsertOutput represents the output after creating or updating a page.
+type PageUpsertOutput struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	SpaceID string `json:"space_id"`
+	Version int    `json:"version"`
+	Action  string `json:"action"` // "created" or "updated"
+	URL     string `json:"url"`
+}
+
+func runUpsert(ctx context.Context, opts *UpsertOptions) error {
+	body, representation, err := upsertBody(opts)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	confluence := api.NewConfluenceService(client)
+
+	result, err := confluence.UpsertPage(ctx, api.UpsertPageOptions{
+		SpaceKey:       opts.Space,
+		Title:          opts.Title,
+		ParentID:       opts.ParentID,
+		Body:           body,
+		Representation: representation,
+		Message:        "Updated via atl CLI",
+	})
+	if err != nil {
+		return err
+	}
+
+	page := result.Page
+	upsertOutput := &PageUpsertOutput{
+		ID:      page.ID,
+		Title:   page.Title,
+		SpaceID: page.SpaceID,
+		Action:  "updated",
+		URL:     fmt.Sprintf("https://%s/wiki/spaces/%s/pages/%s", client.Hostname(), opts.Space, page.ID),
+	}
+	if result.Created {
+		upsertOutput.Action = "created"
+	}
+	if page.Version != nil {
+		upsertOutput.Version = page.Version.Number
+	}
+	if page.Links != nil && page.Links.WebUI != "" {
+		upsertOutput.URL = fmt.Sprintf("https://%s/wiki%s", client.Hostname(), page.Links.WebUI)
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, upsertOutput)
+	}
+
+	if result.Created {
+		fmt.Fprintf(opts.IO.Out, "Created page: %s\n", upsertOutput.Title)
+	} else {
+		fmt.Fprintf(opts.IO.Out, "Updated page: %s (version %d)\n", upsertOutput.Title, upsertOutput.Version)
+	}
+	fmt.Fprintf(opts.IO.Out, "ID: %s\n", upsertOutput.ID)
+	fmt.Fprintf(opts.IO.Out, "URL: %s\n", upsertOutput.URL)
+
+	return nil
+}
+
+// upsertBody reads the page content and returns it in the representation it
+// is sent in: ADF JSON for --markdown, storage format otherwise. --body text
+// without --markdown is wrapped in a paragraph, like page create.
+func upsertBody(opts *UpsertOptions) (string, string, error) {
+	text := opts.Body
+	fromFile := opts.File != ""
+	if fromFile {
+		var data []byte
+		var err error
+		if opts.File == "-" {
+			data, err = io.ReadAll(opts.IO.In)
+		} else {
+			data, err = os.ReadFile(opts.File)
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read page body: %w", err)
+		}
+		text = string(data)
+	}
+
+	if opts.Markdown {
+		adf, err := json.Marshal(api.MarkdownToADF(text))
+		if err != nil {
+			return "", "", fmt.Errorf("failed to convert Markdown: %w", err)
+		}
+		return string(adf), api.RepresentationADF, nil
+	}
+	if fromFile {
+		return text, api.RepresentationStorage, nil
+	}
+	return api.TextToStorage(text), api.RepresentationStorage, nil
+}
@@ -32,12 +32,18 @@ func NewCmdView(ios *iostreams.IOStreams) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "view [page-id]",
+		Use:   "view [page-id|url]",
 		Short: "View a Confluence page",
-		Long:  `Display the content of a Confluence page.`,
+		Long: `Display the content of a Confluence page.
+
+The page can be given as an ID, a page URL, or a tiny link (/wiki/x/...).`,
 		Example: `  # View a page by ID
   atl confluence page view 123456
 
+  # View a page by URL or tiny link
+  atl confluence page view https://mycompany.atlassian.net/wiki/spaces/DOCS/pages/123456/Getting+Started
+  atl confluence page view https://mycompany.atlassian.net/wiki/x/QQAB
+
   # View a page by space and title
   atl confluence page view --space DOCS --title "Getting Started"
 
@@ -51,9 +57,13 @@ func NewCmdView(ios *iostreams.IOStreams) *cobra.Command {
   atl confluence page view 123456 --raw`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
-				opts.PageID = args[0]
+				pageID, err := api.ParsePageRef(args[0])
+				if err != nil {
+					return err
+				}
+				opts.PageID = pageID
 			}
-			return runView(opts)
+			return runView(cmd.Context(), opts)
 		},
 	}
 
@@ -78,7 +88,7 @@ type PageViewOutput struct {
 	URL        string `json:"url"`
 }
 
-func runView(opts *ViewOptions) error {
+func runView(ctx context.Context, opts *ViewOptions) error {
 	if opts.PageID == "" && (opts.Space == "" || opts.Title == "") {
 		return fmt.Errorf("please provide a page ID or both --space and --title")
 	}
@@ -88,7 +98,6 @@ func runView(opts *ViewOptions) error {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	var page *api.Page
@@ -145,7 +154,8 @@ func runView(opts *ViewOptions) error {
 		viewOutput.Version = page.Version.Number
 	}
 
-	// Extract body content - try storage first, then atlas_doc_format
+	// Extract body content - try storage first, then atlas_doc_format, then
+	// the rendered HTML
 	if page.Body != nil {
 		if page.Body.Storage != nil && page.Body.Storage.Value != "" {
 			viewOutput.BodyFormat = "storage"
@@ -161,11 +171,18 @@ func runView(opts *ViewOptions) error {
 			} else {
 				viewOutput.Body = adfToPlainText(page.Body.AtlasDocFormat.Value)
 			}
+		} else if page.Body.View != nil && page.Body.View.Value != "" {
+			viewOutput.BodyFormat = "view"
+			if opts.Raw {
+				viewOutput.Body = page.Body.View.Value
+			} else {
+				viewOutput.Body = htmlToMarkdown(page.Body.View.Value)
+			}
 		}
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, viewOutput)
+		return output.PrintJSON(opts.IO, viewOutput)
 	}
 
 	// Plain text output (LLM-friendly)
@@ -190,6 +207,17 @@ func runView(opts *ViewOptions) error {
 func storageToPlainText(storage string) string {
 	text := storage
 
+	// Render macros that reference other content by their target
+	// (e.g. [Jira: PROJ-123]) instead of just the macro name
+	linkedMacroRegex := regexp.MustCompile(`(?s)<ac:structured-macro[^>]*ac:name="(jira|include|excerpt-include)"[^>]*>(.*?)</ac:structured-macro>`)
+	text = linkedMacroRegex.ReplaceAllStringFunc(text, func(macro string) string {
+		m := linkedMacroRegex.FindStringSubmatch(macro)
+		if label := linkedMacroLabel(m[1], m[2]); label != "" {
+			return "\n" + label + "\n"
+		}
+		return macro
+	})
+
 	// Extract text from CDATA sections in macros (code blocks, etc.)
 	// <ac:plain-text-body><![CDATA[content]]></ac:plain-text-body>
 	cdataRegex := regexp.MustCompile(`<!\[CDATA\[(.*?)\]\]>`)
@@ -247,6 +275,40 @@ func storageToPlainText(storage string) string {
 	return text
 }
 
+var (
+	macroParamRegex  = regexp.MustCompile(`(?s)<ac:parameter[^>]*ac:name="([^"]*)"[^>]*>(.*?)</ac:parameter>`)
+	pageTitleRegex   = regexp.MustCompile(`<ri:page[^>]*ri:content-title="([^"]*)"`)
+	macroTitleLabels = map[string]string{
+		"include":         "Include",
+		"excerpt-include": "Excerpt include",
+	}
+)
+
+// linkedMacroLabel returns a label such as "[Jira: PROJ-123]" for a macro
+// body, or "" if the macro has no recognized parameters.
+func linkedMacroLabel(name, body string) string {
+	params := make(map[string]string)
+	for _, m := range macroParamRegex.FindAllStringSubmatch(body, -1) {
+		params[m[1]] = strings.TrimSpace(m[2])
+	}
+
+	if name == "jira" {
+		if key := params["key"]; key != "" {
+			return fmt.Sprintf("[Jira: %s]", key)
+		}
+		if jql := params["jqlQuery"]; jql != "" {
+			return fmt.Sprintf("[Jira: %s]", jql)
+		}
+		return ""
+	}
+
+	// include/excerpt-include reference a page via the default parameter
+	if m := pageTitleRegex.FindStringSubmatch(params[""]); m != nil {
+		return fmt.Sprintf("[%s: %s]", macroTitleLabels[name], m[1])
+	}
+	return ""
+}
+
 // adfToPlainText converts Atlassian Document Format (ADF) JSON to plain text.
 // ADF is used by the new Confluence editor.
 func adfToPlainText(adf string) string {
@@ -0,0 +1,61 @@
+package page
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageToPlainTextMacros(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage string
+		want    string
+		notWant string
+	}{
+		{
+			name: "jira issue macro",
+			storage: `<p>See</p><ac:structured-macro ac:name="jira" ac:schema-version="1" ac:macro-id="abc">` +
+				`<ac:parameter ac:name="server">System JIRA</ac:parameter>` +
+				`<ac:parameter ac:name="key">PROJ-123</ac:parameter>` +
+				`</ac:structured-macro>`,
+			want:    "[Jira: PROJ-123]",
+			notWant: "System JIRA",
+		},
+		{
+			name: "jira query macro",
+			storage: `<ac:structured-macro ac:name="jira">` +
+				`<ac:parameter ac:name="jqlQuery">project = PROJ</ac:parameter>` +
+				`</ac:structured-macro>`,
+			want: "[Jira: project = PROJ]",
+		},
+		{
+			name: "include macro",
+			storage: `<ac:structured-macro ac:name="include"><ac:parameter ac:name="">` +
+				`<ac:link><ri:page ri:space-key="DOCS" ri:content-title="Release Notes" /></ac:link>` +
+				`</ac:parameter></ac:structured-macro>`,
+			want: "[Include: Release Notes]",
+		},
+		{
+			name:    "generic macro without recognized parameters",
+			storage: `<ac:structured-macro ac:name="toc"><ac:parameter ac:name="maxLevel">2</ac:parameter></ac:structured-macro>`,
+			want:    "[Macro: toc]",
+		},
+		{
+			name:    "jira macro without key falls back to macro name",
+			storage: `<ac:structured-macro ac:name="jira"><ac:parameter ac:name="server">System JIRA</ac:parameter></ac:structured-macro>`,
+			want:    "[Macro: jira]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := storageToPlainText(tt.storage)
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("storageToPlainText() = %q, want it to contain %q", got, tt.want)
+			}
+			if tt.notWant != "" && strings.Contains(got, tt.notWant) {
+				t.Errorf("storageToPlainText() = %q, should not contain %q", got, tt.notWant)
+			}
+		})
+	}
+}
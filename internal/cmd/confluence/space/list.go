@@ -47,7 +47,7 @@ func NewCmdList(ios *iostreams.IOStreams) *cobra.Command {
   # Output as JSON
   atl confluence space list --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runList(opts)
+			return runList(cmd.Context(), opts)
 		},
 	}
 
@@ -76,13 +76,12 @@ type SpaceOutput struct {
 	Status string `json:"status"`
 }
 
-func runList(opts *ListOptions) error {
+func runList(ctx context.Context, opts *ListOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	var spaces []*api.Space
@@ -101,6 +100,9 @@ func runList(opts *ListOptions) error {
 		if !opts.JSON {
 			fmt.Fprintln(opts.IO.Out, " done")
 		}
+		if msg := confluence.LastRateLimit().Warning(); msg != "" {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: %s\n", msg)
+		}
 	} else {
 		// Single page fetch
 		result, err := confluence.GetSpaces(ctx, opts.Limit, opts.Cursor)
@@ -108,12 +110,8 @@ func runList(opts *ListOptions) error {
 			return fmt.Errorf("failed to get spaces: %w", err)
 		}
 		spaces = result.Results
-
-		if result.Links != nil && result.Links.Next != "" {
-			hasMore = true
-			// Extract cursor for user
-			nextCursor = extractCursorFromURL(result.Links.Next)
-		}
+		nextCursor = result.NextCursor()
+		hasMore = nextCursor != ""
 	}
 
 	listOutput := &SpaceListOutput{
@@ -134,7 +132,7 @@ func runList(opts *ListOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, listOutput)
+		return output.PrintJSON(opts.IO, listOutput)
 	}
 
 	if len(listOutput.Spaces) == 0 {
@@ -165,25 +163,3 @@ func runList(opts *ListOptions) error {
 
 	return nil
 }
-
-// extractCursorFromURL extracts the cursor parameter from a pagination URL.
-func extractCursorFromURL(nextURL string) string {
-	// Simple extraction - find cursor= in the URL
-	const prefix = "cursor="
-	start := 0
-	for i := 0; i < len(nextURL)-len(prefix); i++ {
-		if nextURL[i:i+len(prefix)] == prefix {
-			start = i + len(prefix)
-			break
-		}
-	}
-	if start == 0 {
-		return ""
-	}
-	// Find end of cursor value
-	end := start
-	for end < len(nextURL) && nextURL[end] != '&' {
-		end++
-	}
-	return nextURL[start:end]
-}
@@ -54,7 +54,7 @@ The body must be in Confluence storage format (HTML with Confluence macros).`,
 			if opts.Body == "" {
 				return fmt.Errorf("--body flag is required")
 			}
-			return runCreate(opts)
+			return runCreate(cmd.Context(), opts)
 		},
 	}
 
@@ -75,13 +75,12 @@ type TemplateCreateOutput struct {
 	SpaceKey    string `json:"space_key,omitempty"`
 }
 
-func runCreate(opts *CreateOptions) error {
+func runCreate(ctx context.Context, opts *CreateOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	template, err := confluence.CreateTemplate(ctx, opts.Name, opts.Body, opts.Description, opts.Space)
@@ -102,7 +101,7 @@ func runCreate(opts *CreateOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, createOutput)
+		return output.PrintJSON(opts.IO, createOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Created template: %s\n", createOutput.Name)
@@ -52,7 +52,7 @@ The body must be in Confluence storage format (HTML with Confluence macros).`,
 			if opts.Body == "" {
 				return fmt.Errorf("--body flag is required")
 			}
-			return runUpdate(opts)
+			return runUpdate(cmd.Context(), opts)
 		},
 	}
 
@@ -72,13 +72,12 @@ type TemplateUpdateOutput struct {
 	SpaceKey    string `json:"space_key,omitempty"`
 }
 
-func runUpdate(opts *UpdateOptions) error {
+func runUpdate(ctx context.Context, opts *UpdateOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	// If name not provided, get existing template to preserve name
@@ -113,7 +112,7 @@ func runUpdate(opts *UpdateOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, updateOutput)
+		return output.PrintJSON(opts.IO, updateOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Updated template: %s\n", updateOutput.Name)
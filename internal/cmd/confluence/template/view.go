@@ -40,7 +40,7 @@ func NewCmdView(ios *iostreams.IOStreams) *cobra.Command {
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.TemplateID = args[0]
-			return runView(opts)
+			return runView(cmd.Context(), opts)
 		},
 	}
 
@@ -60,13 +60,12 @@ type TemplateViewOutput struct {
 	Body        string `json:"body,omitempty"`
 }
 
-func runView(opts *ViewOptions) error {
+func runView(ctx context.Context, opts *ViewOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	confluence := api.NewConfluenceService(client)
 
 	template, err := confluence.GetTemplate(ctx, opts.TemplateID)
@@ -94,7 +93,7 @@ func runView(opts *ViewOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, viewOutput)
+		return output.PrintJSON(opts.IO, viewOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "# %s\n\n", template.Name)
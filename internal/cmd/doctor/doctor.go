@@ -0,0 +1,375 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/auth"
+	"github.com/enthus-appdev/atl-cli/internal/config"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// Check statuses. Only statusFail makes the command exit non-zero.
+const (
+	statusPass = "pass"
+	statusWarn = "warn"
+	statusFail = "fail"
+	statusSkip = "skip"
+)
+
+// DoctorOptions holds the options for the doctor command.
+type DoctorOptions struct {
+	IO       *iostreams.IOStreams
+	Hostname string
+	JSON     bool
+}
+
+// NewCmdDoctor creates the doctor command.
+func NewCmdDoctor(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &DoctorOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that atl is set up correctly",
+		Long: `Diagnose configuration and authentication problems.
+
+Runs each check in turn and prints pass/fail with a hint for fixing failures:
+
+  - the config file exists and can be parsed
+  - OAuth app credentials are configured (config or environment)
+  - a current host is configured
+  - a token is stored for the host and is not expired (an expired token is
+    refreshed, as any other command would)
+  - the host's cloud ID is one the token can access
+  - a live API call (GET /myself) succeeds
+
+Checks that depend on an earlier failed check are skipped. Exits non-zero if
+any check fails.`,
+		Example: `  # Check the current host
+  atl doctor
+
+  # Check a specific host
+  atl doctor --hostname mycompany.atlassian.net
+
+  # Output as JSON
+  atl doctor --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDoctor(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Hostname, "hostname", "", "The hostname to check (defaults to current host)")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// DoctorOutput represents the output of the doctor command.
+type DoctorOutput struct {
+	Hostname string         `json:"hostname,omitempty"`
+	OK       bool           `json:"ok"`
+	Checks   []*CheckResult `json:"checks"`
+}
+
+// CheckResult represents the outcome of a single check.
+type CheckResult struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"` // pass, warn, fail, or skip
+	Message string `json:"message"`
+	Hint    string `json:"hint,omitempty"`
+}
+
+// failed returns the number of failed checks.
+func (o *DoctorOutput) failed() int {
+	n := 0
+	for _, c := range o.Checks {
+		if c.Status == statusFail {
+			n++
+		}
+	}
+	return n
+}
+
+func runDoctor(ctx context.Context, opts *DoctorOptions) error {
+	doctorOutput := &DoctorOutput{}
+	add := func(name, status, message, hint string) {
+		doctorOutput.Checks = append(doctorOutput.Checks, &CheckResult{
+			Name:    name,
+			Status:  status,
+			Message: message,
+			Hint:    hint,
+		})
+	}
+	skip := func(names ...string) {
+		for _, name := range names {
+			add(name, statusSkip, "skipped because an earlier check failed", "")
+		}
+	}
+
+	cfg, result := checkConfigFile(config.ConfigFile())
+	doctorOutput.Checks = append(doctorOutput.Checks, result)
+	if cfg == nil {
+		skip("OAuth credentials", "Host", "Token", "Cloud ID", "API access")
+		return finishDoctor(opts, doctorOutput)
+	}
+
+	clientID, clientSecret := oauthCredentials(cfg)
+	haveCredentials := clientID != "" && clientSecret != ""
+	doctorOutput.Checks = append(doctorOutput.Checks, checkOAuthCredentials(cfg, clientID, clientSecret))
+
+	hostname := cfg.CurrentHost
+	if opts.Hostname != "" {
+		hostname = cfg.ResolveHost(opts.Hostname)
+	}
+	doctorOutput.Hostname = hostname
+
+	result = checkHost(cfg, hostname)
+	doctorOutput.Checks = append(doctorOutput.Checks, result)
+	if result.Status == statusFail {
+		skip("Token", "Cloud ID", "API access")
+		return finishDoctor(opts, doctorOutput)
+	}
+	hostConfig := cfg.GetHost(hostname)
+
+	tokens, err := auth.GetToken(hostname)
+	switch {
+	case err != nil:
+		add("Token", statusFail, err.Error(), "Run 'atl auth login' to store a new token")
+	case tokens == nil:
+		add("Token", statusFail, "no token stored for "+hostname, "Run 'atl auth login' to authenticate")
+	case !tokens.IsExpired():
+		add("Token", statusPass, "valid until "+tokens.ExpiresAt.Local().Format("2006-01-02 15:04"), "")
+	case !haveCredentials:
+		tokens = nil
+		add("Token", statusFail, "token expired and cannot be refreshed without OAuth credentials",
+			"Run 'atl auth setup' to configure credentials, then 'atl auth refresh'")
+	default:
+		refreshed, err := auth.RefreshAccessToken(ctx, hostname, &auth.RefreshConfig{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+		})
+		if err != nil {
+			tokens = nil
+			add("Token", statusFail, fmt.Sprintf("token expired and refresh failed: %v", err),
+				"Run 'atl auth login' to authenticate again")
+		} else {
+			tokens = refreshed
+			add("Token", statusPass, "expired token refreshed; valid until "+tokens.ExpiresAt.Local().Format("2006-01-02 15:04"), "")
+		}
+	}
+	if tokens == nil {
+		skip("Cloud ID", "API access")
+		return finishDoctor(opts, doctorOutput)
+	}
+
+	if hostConfig.CloudID == "" {
+		add("Cloud ID", statusFail, "no cloud ID configured for "+hostname, "Run 'atl auth sync' to look it up again")
+		skip("API access")
+		return finishDoctor(opts, doctorOutput)
+	}
+	resources, err := api.GetAccessibleResources(ctx, tokens.AccessToken)
+	if err != nil {
+		add("Cloud ID", statusFail, fmt.Sprintf("failed to list accessible sites: %v", err),
+			"Run 'atl auth login' to authenticate again")
+		skip("API access")
+		return finishDoctor(opts, doctorOutput)
+	}
+	result = checkCloudID(resources, hostname, hostConfig.CloudID)
+	doctorOutput.Checks = append(doctorOutput.Checks, result)
+	if result.Status == statusFail {
+		skip("API access")
+		return finishDoctor(opts, doctorOutput)
+	}
+
+	client, err := api.NewClient(hostname, api.WithTimeout(30*time.Second))
+	if err != nil {
+		add("API access", statusFail, err.Error(), "")
+		return finishDoctor(opts, doctorOutput)
+	}
+	user, err := api.NewJiraService(client).GetMyself(ctx)
+	if err != nil {
+		add("API access", statusFail, fmt.Sprintf("GET /myself failed: %v", err),
+			"Check that your account has Jira access on this site, or run 'atl auth login' again")
+	} else {
+		add("API access", statusPass, fmt.Sprintf("signed in as %s", user.DisplayName), "")
+	}
+
+	return finishDoctor(opts, doctorOutput)
+}
+
+// finishDoctor prints the results and returns an error if any check failed.
+func finishDoctor(opts *DoctorOptions, doctorOutput *DoctorOutput) error {
+	failed := doctorOutput.failed()
+	doctorOutput.OK = failed == 0
+
+	if opts.JSON {
+		if err := output.PrintJSON(opts.IO, doctorOutput); err != nil {
+			return err
+		}
+	} else {
+		printDoctor(opts, doctorOutput)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(doctorOutput.Checks))
+	}
+	return nil
+}
+
+func printDoctor(opts *DoctorOptions, doctorOutput *DoctorOutput) {
+	for _, c := range doctorOutput.Checks {
+		var mark string
+		switch c.Status {
+		case statusPass:
+			mark = output.Success.Render("✓")
+		case statusWarn:
+			mark = output.Warning.Render("!")
+		case statusFail:
+			mark = output.Error.Render("✗")
+		default:
+			mark = output.Faint.Render("-")
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", mark, c.Name, c.Message)
+		if c.Hint != "" {
+			fmt.Fprintf(opts.IO.Out, "    %s\n", c.Hint)
+		}
+	}
+
+	if doctorOutput.OK {
+		fmt.Fprintln(opts.IO.Out, "\nEverything looks good.")
+	}
+}
+
+// checkConfigFile loads the config file at path. The returned config is nil
+// when later checks can't run.
+func checkConfigFile(path string) (*config.Config, *CheckResult) {
+	result := &CheckResult{Name: "Config file"}
+
+	if _, err := os.Stat(path); err != nil {
+		result.Status = statusFail
+		if os.IsNotExist(err) {
+			result.Message = path + " does not exist"
+			result.Hint = "Run 'atl auth setup' and 'atl auth login' to create it"
+		} else {
+			result.Message = err.Error()
+			result.Hint = "Check the permissions of " + path
+		}
+		return nil, result
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		result.Status = statusFail
+		result.Message = err.Error()
+		result.Hint = "Fix or remove " + path + ", then run 'atl auth login'"
+		return nil, result
+	}
+
+	result.Status = statusPass
+	result.Message = path
+	return cfg, result
+}
+
+// oauthCredentials returns the OAuth app credentials, preferring the
+// environment over the config file, as token refresh does.
+func oauthCredentials(cfg *config.Config) (clientID, clientSecret string) {
+	clientID = os.Getenv("ATLASSIAN_CLIENT_ID")
+	clientSecret = os.Getenv("ATLASSIAN_CLIENT_SECRET")
+	if cfg.OAuth != nil {
+		if clientID == "" {
+			clientID = cfg.OAuth.ClientID
+		}
+		if clientSecret == "" {
+			clientSecret = cfg.OAuth.ClientSecret
+		}
+	}
+	return clientID, clientSecret
+}
+
+// checkOAuthCredentials reports where the OAuth credentials come from. Missing
+// credentials are a warning: an existing token keeps working until it expires.
+func checkOAuthCredentials(cfg *config.Config, clientID, clientSecret string) *CheckResult {
+	result := &CheckResult{Name: "OAuth credentials"}
+
+	if clientID == "" || clientSecret == "" {
+		result.Status = statusWarn
+		result.Message = "client ID or secret not configured; expired tokens cannot be refreshed"
+		result.Hint = "Run 'atl auth setup' or set ATLASSIAN_CLIENT_ID and ATLASSIAN_CLIENT_SECRET"
+		return result
+	}
+
+	result.Status = statusPass
+	if os.Getenv("ATLASSIAN_CLIENT_ID") != "" || os.Getenv("ATLASSIAN_CLIENT_SECRET") != "" {
+		result.Message = "configured (environment)"
+	} else {
+		result.Message = "configured (config file)"
+	}
+	return result
+}
+
+// checkHost verifies that hostname is set and has a configuration entry.
+func checkHost(cfg *config.Config, hostname string) *CheckResult {
+	result := &CheckResult{Name: "Host"}
+
+	switch {
+	case hostname == "":
+		result.Status = statusFail
+		result.Message = "no current host configured"
+		result.Hint = "Run 'atl auth login' or 'atl config use-context <host>'"
+	case cfg.GetHost(hostname) == nil:
+		result.Status = statusFail
+		result.Message = hostname + " is not in the configuration"
+		result.Hint = fmt.Sprintf("Run 'atl auth login --hostname %s'", hostname)
+	default:
+		result.Status = statusPass
+		result.Message = hostname
+	}
+	return result
+}
+
+// checkCloudID verifies that cloudID is among the sites the token can access,
+// and that it belongs to hostname.
+func checkCloudID(resources []*api.AccessibleResource, hostname, cloudID string) *CheckResult {
+	result := &CheckResult{Name: "Cloud ID"}
+
+	for _, r := range resources {
+		if r.ID == cloudID {
+			result.Status = statusPass
+			result.Message = fmt.Sprintf("%s (%s)", cloudID, r.Name)
+			if h := resourceHost(r.URL); h != "" && h != hostname {
+				result.Status = statusWarn
+				result.Message = fmt.Sprintf("%s belongs to %s, not %s", cloudID, h, hostname)
+				result.Hint = "Run 'atl auth sync' to look up the cloud ID again"
+			}
+			return result
+		}
+	}
+
+	result.Status = statusFail
+	result.Message = fmt.Sprintf("cloud ID %s is not accessible with the stored token", cloudID)
+	if hostResource := api.FindAccessibleResource(resources, hostname); hostResource != nil {
+		result.Hint = fmt.Sprintf("The token can access %s as %s; run 'atl auth sync' to update the configuration", hostname, hostResource.ID)
+	} else {
+		result.Hint = "Run 'atl auth login' and grant access to " + hostname
+	}
+	return result
+}
+
+// resourceHost returns the hostname of an accessible resource URL.
+func resourceHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
@@ -0,0 +1,98 @@
+package doctor
+
+import (
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/config"
+)
+
+func TestCheckCloudID(t *testing.T) {
+	resources := []*api.AccessibleResource{
+		{ID: "cloud-1", Name: "Acme", URL: "https://acme.atlassian.net"},
+		{ID: "cloud-2", Name: "Other", URL: "https://other.atlassian.net"},
+	}
+
+	tests := []struct {
+		name       string
+		hostname   string
+		cloudID    string
+		wantStatus string
+		wantHint   bool
+	}{
+		{name: "matching site", hostname: "acme.atlassian.net", cloudID: "cloud-1", wantStatus: statusPass},
+		{name: "cloud ID of another site", hostname: "acme.atlassian.net", cloudID: "cloud-2", wantStatus: statusWarn, wantHint: true},
+		{name: "stale cloud ID", hostname: "acme.atlassian.net", cloudID: "cloud-old", wantStatus: statusFail, wantHint: true},
+		{name: "site not granted", hostname: "missing.atlassian.net", cloudID: "cloud-old", wantStatus: statusFail, wantHint: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkCloudID(resources, tt.hostname, tt.cloudID)
+			if got.Status != tt.wantStatus {
+				t.Errorf("checkCloudID() status = %q, want %q (%s)", got.Status, tt.wantStatus, got.Message)
+			}
+			if (got.Hint != "") != tt.wantHint {
+				t.Errorf("checkCloudID() hint = %q, want hint: %v", got.Hint, tt.wantHint)
+			}
+		})
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	cfg := &config.Config{
+		Hosts: map[string]*config.HostConfig{
+			"acme.atlassian.net": {Hostname: "acme.atlassian.net"},
+		},
+	}
+
+	tests := []struct {
+		hostname   string
+		wantStatus string
+	}{
+		{"acme.atlassian.net", statusPass},
+		{"", statusFail},
+		{"other.atlassian.net", statusFail},
+	}
+
+	for _, tt := range tests {
+		if got := checkHost(cfg, tt.hostname); got.Status != tt.wantStatus {
+			t.Errorf("checkHost(%q) status = %q, want %q", tt.hostname, got.Status, tt.wantStatus)
+		}
+	}
+}
+
+func TestOAuthCredentials(t *testing.T) {
+	cfg := &config.Config{
+		OAuth: &config.OAuthConfig{ClientID: "cfg-id", ClientSecret: "cfg-secret"},
+	}
+
+	t.Setenv("ATLASSIAN_CLIENT_ID", "")
+	t.Setenv("ATLASSIAN_CLIENT_SECRET", "")
+	id, secret := oauthCredentials(cfg)
+	if id != "cfg-id" || secret != "cfg-secret" {
+		t.Errorf("oauthCredentials() = %q, %q, want config values", id, secret)
+	}
+
+	t.Setenv("ATLASSIAN_CLIENT_ID", "env-id")
+	id, secret = oauthCredentials(cfg)
+	if id != "env-id" || secret != "cfg-secret" {
+		t.Errorf("oauthCredentials() = %q, %q, want env ID and config secret", id, secret)
+	}
+
+	if got := checkOAuthCredentials(&config.Config{}, "", ""); got.Status != statusWarn {
+		t.Errorf("checkOAuthCredentials() without credentials status = %q, want %q", got.Status, statusWarn)
+	}
+}
+
+func TestDoctorOutputFailed(t *testing.T) {
+	o := &DoctorOutput{Checks: []*CheckResult{
+		{Status: statusPass},
+		{Status: statusWarn},
+		{Status: statusFail},
+		{Status: statusSkip},
+	}}
+	if got := o.failed(); got != 1 {
+		t.Errorf("failed() = %d, want 1", got)
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+// Exit codes returned by Execute, so scripts can branch on why a command
+// failed.
+const (
+	ExitOK          = 0
+	ExitError       = 1 // Any other failure, including usage errors
+	ExitAuth        = 2 // Not logged in, token expired or rejected (401)
+	ExitNotFound    = 3 // Issue, page, or other resource not found (404)
+	ExitPermission  = 4 // Not allowed (403, missing OAuth scope or space permission)
+	ExitRateLimited = 5 // Rate limited (429) after retries
+)
+
+// ExitCode maps an error returned by a command to an exit code.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+
+	var authErr *api.AuthError
+	if errors.As(err, &authErr) {
+		return ExitAuth
+	}
+
+	var scopeErr *api.MissingScopeError
+	var permErr *api.PermissionError
+	if errors.As(err, &scopeErr) || errors.As(err, &permErr) {
+		return ExitPermission
+	}
+
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized:
+			return ExitAuth
+		case http.StatusForbidden:
+			return ExitPermission
+		case http.StatusNotFound:
+			return ExitNotFound
+		case http.StatusTooManyRequests:
+			return ExitRateLimited
+		}
+	}
+
+	return ExitError
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"success", nil, ExitOK},
+		{"generic", errors.New("boom"), ExitError},
+		{"not found", fmt.Errorf("failed to get issue: %w", &api.APIError{StatusCode: 404, Status: "404 Not Found"}), ExitNotFound},
+		{"unauthorized", &api.APIError{StatusCode: 401}, ExitAuth},
+		{"forbidden", &api.APIError{StatusCode: 403}, ExitPermission},
+		{"rate limited after retries", fmt.Errorf("max retries exceeded: %w", &api.APIError{StatusCode: 429}), ExitRateLimited},
+		{"server error", &api.APIError{StatusCode: 500}, ExitError},
+		{"not logged in", &api.AuthError{Err: errors.New("not authenticated")}, ExitAuth},
+		{"missing scope", &api.MissingScopeError{Operation: api.OpJiraWrite, Scope: "write:jira-work"}, ExitPermission},
+		{"space permission", fmt.Errorf("wrapped: %w", &api.PermissionError{Action: "create pages in space DOCS"}), ExitPermission},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -13,10 +13,10 @@ import (
 
 // AssignOptions holds the options for the assign command.
 type AssignOptions struct {
-	IO       *iostreams.IOStreams
-	IssueKey string
-	Assignee string
-	JSON     bool
+	IO        *iostreams.IOStreams
+	IssueKeys []string
+	Assignee  string
+	JSON      bool
 }
 
 // NewCmdAssign creates the assign command.
@@ -26,31 +26,46 @@ func NewCmdAssign(ios *iostreams.IOStreams) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "assign <issue-key>",
-		Short: "Assign an issue to a user",
-		Long:  `Assign a Jira issue to a user or unassign it.`,
+		Use:   "assign <issue-key>... [user]",
+		Short: "Assign issues to a user",
+		Long: `Assign one or more Jira issues to a user or unassign them.
+
+The user can be given as the last argument or with --to. Use @me for
+yourself, an email address or name to search for a user, and - or none to
+unassign. If the last argument is an issue key, all arguments are treated
+as issues and --to is required. When assigning several issues, each result
+is printed and failures do not stop the remaining issues.`,
 		Example: `  # Assign to yourself
-  atl issue assign PROJ-1234 --assignee @me
+  atl issue assign PROJ-1234 @me
 
   # Assign to another user
-  atl issue assign PROJ-1234 --assignee john.doe
+  atl issue assign PROJ-1234 john.doe@example.com
 
   # Unassign
-  atl issue assign PROJ-1234 --assignee -
+  atl issue assign PROJ-1234 none
+
+  # Assign several issues at once
+  atl issue assign PROJ-1 PROJ-2 PROJ-3 --to @me
 
   # Output as JSON
-  atl issue assign PROJ-1234 --assignee @me --json`,
-		Args: cobra.ExactArgs(1),
+  atl issue assign PROJ-1234 --to @me --json`,
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
-			if opts.Assignee == "" {
-				return fmt.Errorf("--assignee flag is required\n\nUse @me to assign to yourself, or - to unassign")
+			keys, assignee, err := splitAssignArgs(args, opts.Assignee)
+			if err != nil {
+				return err
+			}
+			opts.IssueKeys, err = normalizeIssueKeys(keys)
+			if err != nil {
+				return err
 			}
-			return runAssign(opts)
+			opts.Assignee = assignee
+			return runAssign(cmd.Context(), opts)
 		},
 	}
 
-	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "User to assign (use @me for yourself, - to unassign)")
+	cmd.Flags().StringVarP(&opts.Assignee, "to", "t", "", "User to assign (use @me for yourself, - to unassign)")
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Alias for --to")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
 
 	return cmd
@@ -60,64 +75,114 @@ func NewCmdAssign(ios *iostreams.IOStreams) *cobra.Command {
 type AssignOutput struct {
 	IssueKey string `json:"issue_key"`
 	Assignee string `json:"assignee"`
-	URL      string `json:"url"`
+	URL      string `json:"url,omitempty"`
+	Error    string `json:"error,omitempty"`
 }
 
-func runAssign(opts *AssignOptions) error {
+func runAssign(ctx context.Context, opts *AssignOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
-	var accountID string
-	var assigneeName string
+	accountID, assigneeName, err := resolveAssignee(ctx, jira, opts.Assignee)
+	if err != nil {
+		return err
+	}
 
-	switch opts.Assignee {
-	case "-", "none", "":
-		accountID = "" // Unassign
-		assigneeName = "Unassigned"
-	case "@me":
-		user, err := jira.GetMyself(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get current user: %w", err)
+	results := make([]*AssignOutput, 0, len(opts.IssueKeys))
+	var failed int
+
+	for _, key := range opts.IssueKeys {
+		result := &AssignOutput{
+			IssueKey: key,
+			Assignee: assigneeName,
 		}
-		accountID = user.AccountID
-		assigneeName = user.DisplayName
-	default:
-		users, err := jira.SearchUsers(ctx, opts.Assignee)
-		if err != nil {
-			return fmt.Errorf("failed to search for user: %w", err)
+
+		if err := jira.AssignIssue(ctx, key, accountID); err != nil {
+			failed++
+			result.Error = err.Error()
+			results = append(results, result)
+			if !opts.JSON {
+				fmt.Fprintf(opts.IO.ErrOut, "Failed to assign %s: %v\n", key, err)
+			}
+			continue
 		}
-		if len(users) == 0 {
-			return fmt.Errorf("user not found: %s", opts.Assignee)
+
+		result.URL = client.BrowseURL(key)
+		results = append(results, result)
+
+		if !opts.JSON {
+			if accountID == "" {
+				fmt.Fprintf(opts.IO.Out, "Unassigned %s\n", key)
+			} else {
+				fmt.Fprintf(opts.IO.Out, "Assigned %s to %s\n", key, assigneeName)
+			}
+			if len(opts.IssueKeys) == 1 {
+				fmt.Fprintf(opts.IO.Out, "URL: %s\n", result.URL)
+			}
 		}
-		accountID = users[0].AccountID
-		assigneeName = users[0].DisplayName
 	}
 
-	if err := jira.AssignIssue(ctx, opts.IssueKey, accountID); err != nil {
-		return fmt.Errorf("failed to assign issue: %w", err)
+	if opts.JSON {
+		// Keep the single-issue output shape unchanged for scripts
+		var err error
+		if len(results) == 1 {
+			err = output.PrintJSON(opts.IO, results[0])
+		} else {
+			err = output.PrintJSON(opts.IO, results)
+		}
+		if err != nil {
+			return err
+		}
 	}
 
-	assignOutput := &AssignOutput{
-		IssueKey: opts.IssueKey,
-		Assignee: assigneeName,
-		URL:      fmt.Sprintf("https://%s/browse/%s", client.Hostname(), opts.IssueKey),
+	if failed > 0 {
+		return fmt.Errorf("failed to assign %d of %d issues", failed, len(opts.IssueKeys))
 	}
+	return nil
+}
 
-	if opts.JSON {
-		return output.JSON(opts.IO.Out, assignOutput)
+// splitAssignArgs separates issue keys from the user. Without --to, the
+// last argument is the user, unless it is an issue key: then all arguments
+// are issues and the user is missing.
+func splitAssignArgs(args []string, to string) ([]string, string, error) {
+	if to != "" {
+		return args, to, nil
 	}
-
-	if assigneeName == "Unassigned" {
-		fmt.Fprintf(opts.IO.Out, "Unassigned %s\n", opts.IssueKey)
-	} else {
-		fmt.Fprintf(opts.IO.Out, "Assigned %s to %s\n", opts.IssueKey, assigneeName)
+	errNoUser := fmt.Errorf("a user is required\n\nPass it as the last argument or with --to (use @me for yourself, - to unassign)")
+	if len(args) < 2 {
+		return nil, "", errNoUser
+	}
+	last := args[len(args)-1]
+	if _, err := api.NormalizeIssueKey(last); err == nil {
+		return nil, "", errNoUser
 	}
-	fmt.Fprintf(opts.IO.Out, "URL: %s\n", assignOutput.URL)
+	return args[:len(args)-1], last, nil
+}
 
-	return nil
+// resolveAssignee turns @me, -/none, or a user search string into an
+// account ID and display name. An empty account ID means unassign.
+func resolveAssignee(ctx context.Context, jira *api.JiraService, assignee string) (string, string, error) {
+	switch assignee {
+	case "-", "none", "":
+		return "", "Unassigned", nil
+	case "@me":
+		user, err := jira.GetMyself(ctx)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get current user: %w", err)
+		}
+		return user.AccountID, user.DisplayName, nil
+	default:
+		users, err := jira.SearchUsers(ctx, assignee)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to search for user: %w", err)
+		}
+		if len(users) == 0 {
+			return "", "", fmt.Errorf("user not found: %s", assignee)
+		}
+		return users[0].AccountID, users[0].DisplayName, nil
+	}
 }
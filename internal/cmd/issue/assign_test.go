@@ -0,0 +1,38 @@
+package issue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitAssignArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		to       string
+		wantKeys []string
+		wantUser string
+		wantErr  bool
+	}{
+		{"positional user", []string{"PROJ-1", "@me"}, "", []string{"PROJ-1"}, "@me", false},
+		{"positional unassign", []string{"PROJ-1", "none"}, "", []string{"PROJ-1"}, "none", false},
+		{"multiple keys with --to", []string{"PROJ-1", "PROJ-2"}, "@me", []string{"PROJ-1", "PROJ-2"}, "@me", false},
+		{"single key with --to", []string{"PROJ-1"}, "-", []string{"PROJ-1"}, "-", false},
+		{"missing user", []string{"PROJ-1"}, "", nil, "", true},
+		{"issue keys only", []string{"PROJ-1", "PROJ-2"}, "", nil, "", true},
+		{"issue URL last", []string{"PROJ-1", "https://example.atlassian.net/browse/PROJ-2"}, "", nil, "", true},
+		{"email user", []string{"PROJ-1", "PROJ-2", "jane@example.com"}, "", []string{"PROJ-1", "PROJ-2"}, "jane@example.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, user, err := splitAssignArgs(tt.args, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitAssignArgs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(keys, tt.wantKeys) || user != tt.wantUser {
+				t.Errorf("splitAssignArgs() = %v, %q, want %v, %q", keys, user, tt.wantKeys, tt.wantUser)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// jqlNameTemplate is the default --name-template with --jql: one
+// subdirectory per issue, so same-named files from different issues don't
+// overwrite each other.
+const jqlNameTemplate = "{issue}/{filename}"
+
+// JQLDownloadOutput represents the result of downloading the attachments of
+// every issue matching a JQL query.
+type JQLDownloadOutput struct {
+	JQL         string            `json:"jql"`
+	DryRun      bool              `json:"dry_run,omitempty"`
+	Issues      int               `json:"issues"`
+	Attachments int               `json:"attachments"`
+	TotalSize   int64             `json:"total_size"`
+	Downloads   []*DownloadOutput `json:"downloads"`
+	Errors      []string          `json:"errors,omitempty"`
+}
+
+// plannedDownload is an attachment to download and the path it is written to.
+type plannedDownload struct {
+	issueKey   string
+	attachment *api.Attachment
+	path       string
+}
+
+func runAttachmentJQL(ctx context.Context, opts *AttachmentOptions, jira *api.JiraService) error {
+	issues, err := searchAllIssues(ctx, jira, opts.JQL, []string{"attachment"})
+	if err != nil {
+		return err
+	}
+
+	planned, err := planJQLDownloads(opts.OutputDir, opts.NameTemplate, issues)
+	if err != nil {
+		return err
+	}
+
+	jqlOutput := &JQLDownloadOutput{
+		JQL:         opts.JQL,
+		DryRun:      opts.DryRun,
+		Issues:      len(issues),
+		Attachments: len(planned),
+		Downloads:   []*DownloadOutput{},
+	}
+
+	if opts.DryRun {
+		for _, p := range planned {
+			jqlOutput.TotalSize += p.attachment.Size
+			jqlOutput.Downloads = append(jqlOutput.Downloads, &DownloadOutput{
+				IssueKey: p.issueKey,
+				ID:       p.attachment.ID,
+				Filename: p.attachment.Filename,
+				Size:     p.attachment.Size,
+				Path:     p.path,
+			})
+		}
+	} else {
+		downloads, errs := downloadPlanned(ctx, jira, planned, opts.Concurrency, opts.Thumbnail, opts.IO.ErrOut)
+		for _, d := range downloads {
+			jqlOutput.TotalSize += d.Size
+		}
+		jqlOutput.Downloads = append(jqlOutput.Downloads, downloads...)
+		jqlOutput.Errors = errs
+	}
+
+	if opts.JSON {
+		if err := output.PrintJSON(opts.IO, jqlOutput); err != nil {
+			return err
+		}
+	} else {
+		printJQLDownloads(opts, jqlOutput)
+	}
+
+	if len(jqlOutput.Errors) > 0 {
+		return fmt.Errorf("failed to download %d of %d attachments", len(jqlOutput.Errors), jqlOutput.Attachments)
+	}
+	return nil
+}
+
+// planJQLDownloads lists the attachments of every issue, in search order,
+// with the path each one is written to.
+func planJQLDownloads(outputDir, template string, issues []*api.Issue) ([]*plannedDownload, error) {
+	var planned []*plannedDownload
+	for _, issue := range issues {
+		for _, a := range issue.Fields.Attachment {
+			path, err := attachmentPath(outputDir, template, issue.Key, a)
+			if err != nil {
+				return nil, err
+			}
+			planned = append(planned, &plannedDownload{issueKey: issue.Key, attachment: a, path: path})
+		}
+	}
+	return planned, nil
+}
+
+// downloadPlanned downloads and writes the planned attachments, up to
+// concurrency at a time. Results keep the planned order; failures are
+// returned as "ISSUE/filename: error" messages.
+func downloadPlanned(ctx context.Context, d attachmentDownloader, planned []*plannedDownload, concurrency int, thumbnail bool, warn io.Writer) ([]*DownloadOutput, []string) {
+	results := make([]*DownloadOutput, len(planned))
+	errs := make([]error, len(planned))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for i, p := range planned {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			content, isThumbnail, err := fetchAttachment(ctx, d, p.attachment, thumbnail, warn)
+			if err == nil {
+				err = writeAttachment(p.path, content)
+			}
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i] = &DownloadOutput{
+				IssueKey:  p.issueKey,
+				ID:        p.attachment.ID,
+				Filename:  p.attachment.Filename,
+				Size:      int64(len(content)),
+				Path:      p.path,
+				Thumbnail: isThumbnail,
+			}
+		}()
+	}
+	wg.Wait()
+
+	var downloads []*DownloadOutput
+	var messages []string
+	for i, p := range planned {
+		if errs[i] != nil {
+			messages = append(messages, fmt.Sprintf("%s/%s: %v", p.issueKey, p.attachment.Filename, errs[i]))
+			continue
+		}
+		downloads = append(downloads, results[i])
+	}
+	return downloads, messages
+}
+
+func printJQLDownloads(opts *AttachmentOptions, jqlOutput *JQLDownloadOutput) {
+	if jqlOutput.Issues == 0 {
+		fmt.Fprintf(opts.IO.Out, "No issues found matching: %s\n", opts.JQL)
+		return
+	}
+	if jqlOutput.Attachments == 0 {
+		fmt.Fprintf(opts.IO.Out, "No attachments on the %d matching issue(s)\n", jqlOutput.Issues)
+		return
+	}
+
+	verb := "Downloaded"
+	if jqlOutput.DryRun {
+		verb = "Would download"
+	}
+	for _, d := range jqlOutput.Downloads {
+		fmt.Fprintf(opts.IO.Out, "%s: %s (%s)\n", verb, d.Path, formatSize(d.Size))
+	}
+
+	if len(jqlOutput.Errors) > 0 {
+		fmt.Fprintf(opts.IO.Out, "\nFailed to download %d file(s):\n", len(jqlOutput.Errors))
+		for _, e := range jqlOutput.Errors {
+			fmt.Fprintf(opts.IO.Out, "  - %s\n", e)
+		}
+	}
+
+	if jqlOutput.DryRun {
+		fmt.Fprintf(opts.IO.Out, "\nWould download %d attachments (%s) from %d issues to %s\n",
+			jqlOutput.Attachments, formatSize(jqlOutput.TotalSize), jqlOutput.Issues, opts.OutputDir)
+		return
+	}
+	fmt.Fprintf(opts.IO.Out, "\nDownloaded %d of %d attachments (%s) from %d issues to %s\n",
+		len(jqlOutput.Downloads), jqlOutput.Attachments, formatSize(jqlOutput.TotalSize), jqlOutput.Issues, opts.OutputDir)
+}
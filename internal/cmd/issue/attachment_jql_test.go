@@ -0,0 +1,135 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestPlanJQLDownloads(t *testing.T) {
+	issues := []*api.Issue{
+		{Key: "PROJ-1", Fields: api.IssueFields{Attachment: []*api.Attachment{
+			{ID: "10", Filename: "log.txt", Size: 100},
+			{ID: "11", Filename: "shot.png", Size: 2048},
+		}}},
+		{Key: "PROJ-2"},
+		{Key: "PROJ-3", Fields: api.IssueFields{Attachment: []*api.Attachment{
+			{ID: "12", Filename: "log.txt", Size: 50},
+		}}},
+	}
+
+	planned, err := planJQLDownloads("out", jqlNameTemplate, issues)
+	if err != nil {
+		t.Fatalf("planJQLDownloads() error = %v", err)
+	}
+
+	want := []string{
+		filepath.Join("out", "PROJ-1", "log.txt"),
+		filepath.Join("out", "PROJ-1", "shot.png"),
+		filepath.Join("out", "PROJ-3", "log.txt"),
+	}
+	if len(planned) != len(want) {
+		t.Fatalf("planned %d downloads, want %d", len(planned), len(want))
+	}
+	for i, p := range planned {
+		if p.path != want[i] {
+			t.Errorf("planned[%d].path = %q, want %q", i, p.path, want[i])
+		}
+	}
+	if planned[2].issueKey != "PROJ-3" {
+		t.Errorf("planned[2].issueKey = %q, want PROJ-3", planned[2].issueKey)
+	}
+}
+
+// syncDownloader is a concurrency-safe attachmentDownloader that fails for
+// the IDs in fail.
+type syncDownloader struct {
+	mu    sync.Mutex
+	calls int
+	fail  map[string]bool
+}
+
+func (d *syncDownloader) DownloadAttachment(ctx context.Context, id string) ([]byte, string, error) {
+	d.mu.Lock()
+	d.calls++
+	d.mu.Unlock()
+	if d.fail[id] {
+		return nil, "", errors.New("boom")
+	}
+	return []byte("content-" + id), "", nil
+}
+
+func (d *syncDownloader) DownloadAttachmentThumbnail(ctx context.Context, id string) ([]byte, string, error) {
+	return d.DownloadAttachment(ctx, id)
+}
+
+func TestDownloadPlanned(t *testing.T) {
+	dir := t.TempDir()
+	issues := []*api.Issue{
+		{Key: "PROJ-1", Fields: api.IssueFields{Attachment: []*api.Attachment{
+			{ID: "1", Filename: "a.txt"},
+			{ID: "2", Filename: "b.txt"},
+		}}},
+		{Key: "PROJ-2", Fields: api.IssueFields{Attachment: []*api.Attachment{
+			{ID: "3", Filename: "a.txt"},
+		}}},
+	}
+	planned, err := planJQLDownloads(dir, jqlNameTemplate, issues)
+	if err != nil {
+		t.Fatalf("planJQLDownloads() error = %v", err)
+	}
+
+	d := &syncDownloader{fail: map[string]bool{"2": true}}
+	downloads, errs := downloadPlanned(context.Background(), d, planned, 2, false, io.Discard)
+
+	if d.calls != 3 {
+		t.Errorf("calls = %d, want 3", d.calls)
+	}
+	if len(errs) != 1 || errs[0] != "PROJ-1/b.txt: boom" {
+		t.Errorf("errs = %q, want [PROJ-1/b.txt: boom]", errs)
+	}
+	if len(downloads) != 2 || downloads[0].ID != "1" || downloads[1].ID != "3" {
+		t.Fatalf("downloads = %+v, want IDs 1 and 3 in order", downloads)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "PROJ-2", "a.txt"))
+	if err != nil {
+		t.Fatalf("read downloaded file: %v", err)
+	}
+	if string(content) != "content-3" {
+		t.Errorf("content = %q, want content-3", content)
+	}
+	if downloads[1].Size != int64(len("content-3")) {
+		t.Errorf("size = %d, want %d", downloads[1].Size, len("content-3"))
+	}
+}
+
+func TestValidateJQLDownload(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    AttachmentOptions
+		args    []string
+		wantErr bool
+	}{
+		{name: "download all", opts: AttachmentOptions{DownloadAll: true, Concurrency: 5}},
+		{name: "with issue key", opts: AttachmentOptions{DownloadAll: true, Concurrency: 5}, args: []string{"PROJ-1"}, wantErr: true},
+		{name: "without download all", opts: AttachmentOptions{Concurrency: 5}, wantErr: true},
+		{name: "with list", opts: AttachmentOptions{DownloadAll: true, List: true, Concurrency: 5}, wantErr: true},
+		{name: "zero concurrency", opts: AttachmentOptions{DownloadAll: true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateJQLDownload(&tt.opts, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateJQLDownload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
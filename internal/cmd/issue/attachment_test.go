@@ -0,0 +1,178 @@
+package issue
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestAttachmentPath(t *testing.T) {
+	attachment := &api.Attachment{ID: "10042", Filename: "error.log"}
+
+	tests := []struct {
+		name     string
+		template string
+		a        *api.Attachment
+		want     string
+		wantErr  string
+	}{
+		{name: "default keeps flat layout", template: defaultNameTemplate, a: attachment, want: "out/error.log"},
+		{name: "per-issue subdirectory", template: "{issue}/{id}-{filename}", a: attachment, want: "out/PROJ-1/10042-error.log"},
+		{name: "separators in values are replaced", template: "{filename}", a: &api.Attachment{ID: "1", Filename: "../../etc/passwd"}, want: "out/.._.._etc_passwd"},
+		{name: "dot-dot filename", template: "{issue}/{filename}", a: &api.Attachment{ID: "1", Filename: ".."}, want: "out/PROJ-1/_"},
+		{name: "unknown placeholder", template: "{project}/{filename}", a: attachment, wantErr: "unknown placeholder {project}"},
+		{name: "escapes output dir", template: "../{filename}", a: attachment, wantErr: "does not name a file inside --output"},
+		{name: "absolute", template: "/tmp/{filename}", a: attachment, wantErr: "must be relative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := attachmentPath("out", tt.template, "PROJ-1", tt.a)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != filepath.FromSlash(tt.want) {
+				t.Errorf("attachmentPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteAttachmentCreatesDirectories(t *testing.T) {
+	dir := t.TempDir()
+
+	path, err := attachmentPath(dir, "{issue}/{id}-{filename}", "PROJ-1", &api.Attachment{ID: "7", Filename: "shot.png"})
+	if err != nil {
+		t.Fatalf("attachmentPath() error = %v", err)
+	}
+	if err := writeAttachment(path, []byte("png")); err != nil {
+		t.Fatalf("writeAttachment() error = %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "PROJ-1", "7-shot.png"))
+	if err != nil {
+		t.Fatalf("reading written file: %v", err)
+	}
+	if string(got) != "png" {
+		t.Errorf("file content = %q, want %q", got, "png")
+	}
+}
+
+func TestValidateUploadSources(t *testing.T) {
+	tests := []struct {
+		name     string
+		sources  []string
+		filename string
+		wantErr  string
+	}{
+		{name: "plain files", sources: []string{"a.png", "b.pdf"}},
+		{name: "stdin with filename", sources: []string{"-"}, filename: "out.log"},
+		{name: "stdin without filename", sources: []string{"-"}, wantErr: "--filename is required"},
+		{name: "stdin twice", sources: []string{"-", "-"}, filename: "out.log", wantErr: "only be given once"},
+		{name: "URL without filename", sources: []string{"@https://example.com/report.pdf", "a.png"}},
+		{name: "filename with several streams", sources: []string{"-", "@https://example.com/a.pdf"}, filename: "x", wantErr: "single stdin or URL upload"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateUploadSources(tt.sources, tt.filename)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type fakeDownloader struct {
+	calls        []string
+	noThumbnails bool
+}
+
+func (f *fakeDownloader) DownloadAttachment(ctx context.Context, id string) ([]byte, string, error) {
+	f.calls = append(f.calls, "content/"+id)
+	return []byte("full"), "", nil
+}
+
+func (f *fakeDownloader) DownloadAttachmentThumbnail(ctx context.Context, id string) ([]byte, string, error) {
+	f.calls = append(f.calls, "thumbnail/"+id)
+	if f.noThumbnails {
+		return nil, "", fmt.Errorf("%w: attachment %s", api.ErrNoThumbnail, id)
+	}
+	return []byte("thumb"), "", nil
+}
+
+func TestFetchAttachment(t *testing.T) {
+	image := &api.Attachment{ID: "1", Filename: "shot.png", MimeType: "image/png"}
+	log := &api.Attachment{ID: "2", Filename: "app.log", MimeType: "text/plain"}
+
+	tests := []struct {
+		name          string
+		a             *api.Attachment
+		thumbnail     bool
+		noThumbnails  bool
+		wantContent   string
+		wantThumbnail bool
+		wantCalls     string
+		wantWarning   string
+	}{
+		{name: "full file without flag", a: image, wantContent: "full", wantCalls: "content/1"},
+		{name: "thumbnail endpoint with flag", a: image, thumbnail: true, wantContent: "thumb", wantThumbnail: true, wantCalls: "thumbnail/1"},
+		{name: "non-image falls back", a: log, thumbnail: true, wantContent: "full", wantCalls: "content/2", wantWarning: "app.log is not an image"},
+		{name: "missing thumbnail falls back", a: image, thumbnail: true, noThumbnails: true, wantContent: "full", wantCalls: "thumbnail/1 content/1", wantWarning: "no thumbnail for shot.png"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &fakeDownloader{noThumbnails: tt.noThumbnails}
+			var warn bytes.Buffer
+
+			content, thumbnail, err := fetchAttachment(context.Background(), d, tt.a, tt.thumbnail, &warn)
+			if err != nil {
+				t.Fatalf("fetchAttachment() error = %v", err)
+			}
+			if string(content) != tt.wantContent || thumbnail != tt.wantThumbnail {
+				t.Errorf("got (%q, %v), want (%q, %v)", content, thumbnail, tt.wantContent, tt.wantThumbnail)
+			}
+			if got := strings.Join(d.calls, " "); got != tt.wantCalls {
+				t.Errorf("calls = %q, want %q", got, tt.wantCalls)
+			}
+			if tt.wantWarning == "" && warn.Len() > 0 {
+				t.Errorf("unexpected warning %q", warn.String())
+			}
+			if tt.wantWarning != "" && !strings.Contains(warn.String(), tt.wantWarning) {
+				t.Errorf("warning = %q, want it to contain %q", warn.String(), tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestCappedReader(t *testing.T) {
+	small := &cappedReader{r: io.LimitReader(strings.NewReader("hello"), 6), max: 5}
+	if data, err := io.ReadAll(small); err != nil || string(data) != "hello" {
+		t.Errorf("ReadAll() = %q, %v, want the whole body", data, err)
+	}
+
+	large := &cappedReader{r: io.LimitReader(strings.NewReader("hello world"), 6), max: 5}
+	if _, err := io.ReadAll(large); err == nil {
+		t.Error("ReadAll() should fail once the body exceeds the cap")
+	}
+}
@@ -0,0 +1,154 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// BlockOptions holds the options for the block command.
+type BlockOptions struct {
+	IO       *iostreams.IOStreams
+	IssueKey string
+	By       string
+	Blocks   string
+	JSON     bool
+}
+
+// NewCmdBlock creates the block command.
+func NewCmdBlock(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &BlockOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "block <issue-key>",
+		Short: "Mark an issue as blocked by or blocking another issue",
+		Long: `Create a "blocks" link between two issues without knowing the link type name.
+
+The Blocks-style link type is discovered from your Jira instance by matching
+its inward/outward descriptions (e.g. "is blocked by" / "blocks"), so this
+works even when the type has been renamed.`,
+		Example: `  # PROJ-1 is blocked by PROJ-2
+  atl issue block PROJ-1 --by PROJ-2
+
+  # PROJ-1 blocks PROJ-3
+  atl issue block PROJ-1 --blocks PROJ-3`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			if opts.By == "" && opts.Blocks == "" {
+				return fmt.Errorf("either --by or --blocks is required")
+			}
+			if opts.By != "" && opts.Blocks != "" {
+				return fmt.Errorf("--by and --blocks cannot be used together")
+			}
+			return runBlock(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.By, "by", "", "Issue that blocks this issue")
+	cmd.Flags().StringVar(&opts.Blocks, "blocks", "", "Issue that this issue blocks")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+func runBlock(ctx context.Context, opts *BlockOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	linkTypes, err := jira.GetIssueLinkTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get link types: %w", err)
+	}
+
+	blocksType := findBlocksLinkType(linkTypes)
+	if blocksType == nil {
+		return linkTypeNotFoundError(blocksLinkType, linkTypes)
+	}
+
+	// Same argument order as 'atl issue link': <blocker> <blocks> <blocked>
+	blocker, blocked := opts.IssueKey, opts.Blocks
+	if opts.By != "" {
+		blocker, blocked = opts.By, opts.IssueKey
+	}
+
+	if err := jira.CreateIssueLink(ctx, blocker, blocked, blocksType.Name); err != nil {
+		return fmt.Errorf("failed to create link: %w", err)
+	}
+
+	linkOutput := &LinkOutput{
+		InwardIssue:  blocker,
+		OutwardIssue: blocked,
+		LinkType:     blocksType.Name,
+		Message:      fmt.Sprintf("%s %s %s", blocker, blocksType.Outward, blocked),
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, linkOutput)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Linked: %s\n", linkOutput.Message)
+	return nil
+}
+
+// blocksLinkType is the standard Jira link type for blocking relationships.
+var blocksLinkType = wellKnownLinkType{Name: "Blocks", Outward: "blocks", Inward: "blocked by"}
+
+// findBlocksLinkType returns the link type used for blocking relationships.
+func findBlocksLinkType(linkTypes []*api.IssueLinkType) *api.IssueLinkType {
+	return findLinkType(linkTypes, blocksLinkType)
+}
+
+// wellKnownLinkType describes a standard Jira link type by its default name
+// and descriptions.
+type wellKnownLinkType struct {
+	Name    string // default name, e.g. "Blocks"
+	Outward string // outward description, e.g. "blocks"
+	Inward  string // part of the inward description, e.g. "blocked by"
+}
+
+// findLinkType returns the instance's link type for a well-known type.
+// Descriptions are preferred over the name since admins often rename types
+// but keep the "blocks"/"is blocked by" wording.
+func findLinkType(linkTypes []*api.IssueLinkType, want wellKnownLinkType) *api.IssueLinkType {
+	for _, lt := range linkTypes {
+		if strings.EqualFold(strings.TrimSpace(lt.Outward), want.Outward) &&
+			strings.Contains(strings.ToLower(lt.Inward), want.Inward) {
+			return lt
+		}
+	}
+	for _, lt := range linkTypes {
+		if strings.EqualFold(lt.Name, want.Name) ||
+			strings.Contains(strings.ToLower(lt.Inward), want.Inward) {
+			return lt
+		}
+	}
+	return nil
+}
+
+// linkTypeNotFoundError lists the instance's link types when a well-known
+// type is missing.
+func linkTypeNotFoundError(want wellKnownLinkType, linkTypes []*api.IssueLinkType) error {
+	names := make([]string, 0, len(linkTypes))
+	for _, lt := range linkTypes {
+		names = append(names, fmt.Sprintf("%s (%s / %s)", lt.Name, lt.Outward, lt.Inward))
+	}
+	return fmt.Errorf("no %s-style link type found on this instance\n\nAvailable link types:\n  %s\n\nUse 'atl issue link <a> <b> --type <name>' instead",
+		want.Name, strings.Join(names, "\n  "))
+}
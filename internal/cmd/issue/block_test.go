@@ -0,0 +1,34 @@
+package issue
+
+import (
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestFindBlocksLinkType(t *testing.T) {
+	relates := &api.IssueLinkType{ID: "1", Name: "Relates", Inward: "relates to", Outward: "relates to"}
+	blocks := &api.IssueLinkType{ID: "2", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"}
+	renamed := &api.IssueLinkType{ID: "3", Name: "Dependency", Inward: "is blocked by", Outward: "blocks"}
+	nameOnly := &api.IssueLinkType{ID: "4", Name: "Blocks", Inward: "depends on", Outward: "is needed by"}
+
+	tests := []struct {
+		name      string
+		linkTypes []*api.IssueLinkType
+		want      *api.IssueLinkType
+	}{
+		{"standard", []*api.IssueLinkType{relates, blocks}, blocks},
+		{"renamed type", []*api.IssueLinkType{relates, renamed}, renamed},
+		{"descriptions win over name", []*api.IssueLinkType{nameOnly, renamed}, renamed},
+		{"name fallback", []*api.IssueLinkType{relates, nameOnly}, nameOnly},
+		{"none", []*api.IssueLinkType{relates}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := findBlocksLinkType(tt.linkTypes); got != tt.want {
+				t.Errorf("findBlocksLinkType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// BulkLabelOptions holds the options for the bulk-label command.
+type BulkLabelOptions struct {
+	IO          *iostreams.IOStreams
+	JQL         string
+	Add         []string
+	Remove      []string
+	Concurrency int
+	DryRun      bool
+	NoNotify    bool
+	JSON        bool
+}
+
+// NewCmdBulkLabel creates the bulk-label command.
+func NewCmdBulkLabel(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &BulkLabelOptions{
+		IO:          ios,
+		Concurrency: 5,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "bulk-label",
+		Short: "Add or remove labels on every issue matching a JQL query",
+		Long: `Add and remove labels on all issues returned by a JQL query.
+
+Labels are changed with add/remove operations, so other labels on each issue
+are kept. Issues that already have the requested labels (and none of the
+removed ones) are skipped. A failure on one issue does not stop the rest;
+failed issues are reported at the end.
+
+Use --dry-run to see which issues would change without updating them.`,
+		Example: `  # Retag a batch of issues
+  atl issue bulk-label --jql "project = PROJ AND labels = legacy" --add tech-debt --remove legacy
+
+  # Preview the change first
+  atl issue bulk-label --jql "project = PROJ AND sprint in openSprints()" --add q3 --dry-run
+
+  # Without emailing watchers (project admins only)
+  atl issue bulk-label --jql "project = PROJ" --remove stale --no-notify`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.JQL == "" {
+				return fmt.Errorf("--jql is required\n\nExample: atl issue bulk-label --jql \"project = PROJ\" --add tech-debt")
+			}
+			if len(opts.Add) == 0 && len(opts.Remove) == 0 {
+				return fmt.Errorf("at least one of --add or --remove is required")
+			}
+			if opts.Concurrency < 1 {
+				return fmt.Errorf("--concurrency must be at least 1")
+			}
+			return runBulkLabel(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.JQL, "jql", "q", "", "JQL query selecting the issues to update (required)")
+	cmd.Flags().StringSliceVar(&opts.Add, "add", nil, "Labels to add")
+	cmd.Flags().StringSliceVar(&opts.Remove, "remove", nil, "Labels to remove")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of issues updated in parallel")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show which issues would change without updating them")
+	cmd.Flags().BoolVar(&opts.NoNotify, "no-notify", false, noNotifyFlagUsage)
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// BulkLabelOutput represents the result of a bulk label change.
+type BulkLabelOutput struct {
+	JQL       string                  `json:"jql"`
+	Added     []string                `json:"added,omitempty"`
+	Removed   []string                `json:"removed,omitempty"`
+	DryRun    bool                    `json:"dry_run"`
+	Matched   int                     `json:"matched"`
+	Updated   int                     `json:"updated"`
+	Unchanged int                     `json:"unchanged"`
+	Failed    int                     `json:"failed"`
+	Issues    []*BulkLabelIssueOutput `json:"issues"`
+}
+
+// BulkLabelIssueOutput represents the result for a single issue.
+type BulkLabelIssueOutput struct {
+	IssueKey string   `json:"issue_key"`
+	Labels   []string `json:"labels"` // Labels after the change
+	Changed  bool     `json:"changed"`
+	Error    string   `json:"error,omitempty"`
+}
+
+func runBulkLabel(ctx context.Context, opts *BulkLabelOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	issues, err := searchAllIssues(ctx, jira, opts.JQL, []string{"labels"})
+	if err != nil {
+		return err
+	}
+
+	bulkOutput := &BulkLabelOutput{
+		JQL:     opts.JQL,
+		Added:   opts.Add,
+		Removed: opts.Remove,
+		DryRun:  opts.DryRun,
+		Matched: len(issues),
+		Issues:  make([]*BulkLabelIssueOutput, len(issues)),
+	}
+
+	ops := labelUpdateOps(opts.Add, opts.Remove)
+	notify := api.NotifyOptions{Notify: !opts.NoNotify}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, opts.Concurrency)
+
+	for i, issue := range issues {
+		labels, changed := applyLabelChanges(issue.Fields.Labels, opts.Add, opts.Remove)
+		result := &BulkLabelIssueOutput{
+			IssueKey: issue.Key,
+			Labels:   labels,
+			Changed:  changed,
+		}
+		bulkOutput.Issues[i] = result
+
+		if !changed || opts.DryRun {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(key string, result *BulkLabelIssueOutput) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			req := &api.UpdateIssueRequest{
+				Update: map[string][]api.UpdateOp{"labels": ops},
+			}
+			if err := jira.UpdateIssueWithOptions(ctx, key, req, notify); err != nil {
+				result.Error = explainNoNotifyError(err, opts.NoNotify).Error()
+			}
+		}(issue.Key, result)
+	}
+	wg.Wait()
+
+	if msg := jira.LastRateLimit().Warning(); msg != "" {
+		fmt.Fprintf(opts.IO.ErrOut, "Warning: %s\n", msg)
+	}
+
+	for _, r := range bulkOutput.Issues {
+		switch {
+		case r.Error != "":
+			bulkOutput.Failed++
+		case r.Changed:
+			bulkOutput.Updated++
+		default:
+			bulkOutput.Unchanged++
+		}
+	}
+
+	if opts.JSON {
+		if err := output.PrintJSON(opts.IO, bulkOutput); err != nil {
+			return err
+		}
+	} else {
+		printBulkLabel(opts, bulkOutput)
+	}
+
+	if bulkOutput.Failed > 0 {
+		return fmt.Errorf("failed to update %d of %d issues", bulkOutput.Failed, bulkOutput.Matched)
+	}
+	return nil
+}
+
+func printBulkLabel(opts *BulkLabelOptions, bulkOutput *BulkLabelOutput) {
+	if bulkOutput.Matched == 0 {
+		fmt.Fprintf(opts.IO.Out, "No issues found matching: %s\n", opts.JQL)
+		return
+	}
+
+	for _, r := range bulkOutput.Issues {
+		switch {
+		case r.Error != "":
+			fmt.Fprintf(opts.IO.ErrOut, "Failed to update %s: %s\n", r.IssueKey, r.Error)
+		case r.Changed && opts.DryRun:
+			fmt.Fprintf(opts.IO.Out, "Would update %s: %s\n", r.IssueKey, strings.Join(r.Labels, ", "))
+		case r.Changed:
+			fmt.Fprintf(opts.IO.Out, "Updated %s: %s\n", r.IssueKey, strings.Join(r.Labels, ", "))
+		}
+	}
+
+	verb := "Updated"
+	if opts.DryRun {
+		verb = "Would update"
+	}
+	fmt.Fprintf(opts.IO.Out, "\n%s %d of %d issues (%d already up to date", verb, bulkOutput.Updated, bulkOutput.Matched, bulkOutput.Unchanged)
+	if bulkOutput.Failed > 0 {
+		fmt.Fprintf(opts.IO.Out, ", %d failed", bulkOutput.Failed)
+	}
+	fmt.Fprintln(opts.IO.Out, ")")
+}
+
+// applyLabelChanges returns the labels an issue ends up with after adding and
+// removing labels, and whether that differs from its current labels. Removal
+// wins when a label is both added and removed, matching Jira's handling of
+// the operations in order.
+func applyLabelChanges(current, add, remove []string) ([]string, bool) {
+	removed := make(map[string]bool, len(remove))
+	for _, label := range remove {
+		removed[label] = true
+	}
+
+	seen := make(map[string]bool, len(current)+len(add))
+	labels := make([]string, 0, len(current)+len(add))
+	changed := false
+
+	for _, label := range current {
+		seen[label] = true
+		if removed[label] {
+			changed = true
+			continue
+		}
+		labels = append(labels, label)
+	}
+	for _, label := range add {
+		if seen[label] || removed[label] {
+			continue
+		}
+		seen[label] = true
+		labels = append(labels, label)
+		changed = true
+	}
+
+	return labels, changed
+}
+
+// searchAllIssues runs a JQL search and follows pagination until every
+// matching issue has been fetched.
+func searchAllIssues(ctx context.Context, jira *api.JiraService, jql string, fields []string) ([]*api.Issue, error) {
+	searchOpts := api.SearchOptions{
+		JQL:        jql,
+		MaxResults: 100,
+		Fields:     fields,
+	}
+
+	var issues []*api.Issue
+	for {
+		result, err := jira.Search(ctx, searchOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search issues: %w", err)
+		}
+		issues = append(issues, result.Issues...)
+
+		next, more := result.NextPage(searchOpts)
+		if !more {
+			return issues, nil
+		}
+		searchOpts = next
+	}
+}
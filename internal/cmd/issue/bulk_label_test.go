@@ -0,0 +1,60 @@
+package issue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApplyLabelChanges(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     []string
+		add         []string
+		remove      []string
+		want        []string
+		wantChanged bool
+	}{
+		{
+			name:        "add and remove",
+			current:     []string{"legacy", "backend"},
+			add:         []string{"tech-debt"},
+			remove:      []string{"legacy"},
+			want:        []string{"backend", "tech-debt"},
+			wantChanged: true,
+		},
+		{
+			name:        "already up to date",
+			current:     []string{"tech-debt"},
+			add:         []string{"tech-debt"},
+			remove:      []string{"legacy"},
+			want:        []string{"tech-debt"},
+			wantChanged: false,
+		},
+		{
+			name:        "no labels yet",
+			add:         []string{"q3", "q3"},
+			want:        []string{"q3"},
+			wantChanged: true,
+		},
+		{
+			name:        "remove wins over add",
+			current:     []string{"stale"},
+			add:         []string{"stale"},
+			remove:      []string{"stale"},
+			want:        []string{},
+			wantChanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := applyLabelChanges(tt.current, tt.add, tt.remove)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("labels = %v, want %v", got, tt.want)
+			}
+			if changed != tt.wantChanged {
+				t.Errorf("changed = %v, want %v", changed, tt.wantChanged)
+			}
+		})
+	}
+}
@@ -59,8 +59,12 @@ func NewCmdChangelog(ios *iostreams.IOStreams) *cobra.Command {
   atl issue changelog NX-1234 --json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
-			return runChangelog(opts)
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			return runChangelog(cmd.Context(), opts)
 		},
 	}
 
@@ -71,13 +75,12 @@ func NewCmdChangelog(ios *iostreams.IOStreams) *cobra.Command {
 	return cmd
 }
 
-func runChangelog(opts *ChangelogOptions) error {
+func runChangelog(ctx context.Context, opts *ChangelogOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	// Fetch all changelog pages
@@ -114,7 +117,7 @@ func runChangelog(opts *ChangelogOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, entries)
+		return output.PrintJSON(opts.IO, entries)
 	}
 
 	printChangelog(opts.IO, opts.IssueKey, entries)
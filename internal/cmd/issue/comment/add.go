@@ -20,6 +20,7 @@ type AddOptions struct {
 	ReplyTo        string
 	VisibilityType string
 	VisibilityName string
+	Format         string
 	JSON           bool
 }
 
@@ -45,6 +46,9 @@ and replying to existing comments with automatic quoting.`,
   # Add a comment visible only to a group
   atl issue comment add PROJ-1234 --body "Team note" --visibility-type group --visibility-name "jira-developers"
 
+  # Paste Jira wiki markup as-is
+  atl issue comment add PROJ-1234 --format wiki --body "*Root cause:* {{NullPointerException}} in [the sync job|https://ci.example.com/job/sync]"
+
   # Reply to a specific comment (quotes the original)
   atl issue comment add PROJ-1234 --body "I agree!" --reply-to 12345
 
@@ -52,18 +56,26 @@ and replying to existing comments with automatic quoting.`,
   atl issue comment add PROJ-1234 --body "Comment" --json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
 
 			if opts.Body == "" {
 				return fmt.Errorf("--body is required")
 			}
+			if err := api.CheckBodyFormat(opts.Format); err != nil {
+				return err
+			}
 
-			return runAdd(opts)
+			return runAdd(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Comment text (required)")
 	cmd.Flags().StringVar(&opts.ReplyTo, "reply-to", "", "Comment ID to reply to (quotes original)")
+	cmd.Flags().StringVar(&opts.Format, "format", api.FormatMarkdown, "Text format of --body: markdown or wiki (Jira wiki markup)")
 	cmd.Flags().StringVar(&opts.VisibilityType, "visibility-type", "", "Visibility type: 'role' or 'group'")
 	cmd.Flags().StringVar(&opts.VisibilityName, "visibility-name", "", "Role or group name for visibility restriction")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
@@ -79,25 +91,25 @@ type AddCommentOutput struct {
 	URL       string `json:"url"`
 }
 
-func runAdd(opts *AddOptions) error {
+func runAdd(ctx context.Context, opts *AddOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
-	hostname := client.Hostname()
+	browseBase := client.BrowseBaseURL()
 
 	// Handle reply
 	if opts.ReplyTo != "" {
-		return replyToComment(ctx, jira, hostname, opts)
+		return replyToComment(ctx, jira, browseBase, opts)
 	}
 
 	commentOpts := &api.CommentOptions{
 		Body:           opts.Body,
 		VisibilityType: opts.VisibilityType,
 		VisibilityName: opts.VisibilityName,
+		Format:         opts.Format,
 	}
 
 	comment, err := jira.AddCommentWithOptions(ctx, opts.IssueKey, commentOpts)
@@ -109,11 +121,11 @@ func runAdd(opts *AddOptions) error {
 		IssueKey:  opts.IssueKey,
 		CommentID: comment.ID,
 		Action:    "added",
-		URL:       fmt.Sprintf("https://%s/browse/%s?focusedCommentId=%s", hostname, opts.IssueKey, comment.ID),
+		URL:       api.IssueBrowseURL(browseBase, opts.IssueKey) + "?focusedCommentId=" + comment.ID,
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, addOutput)
+		return output.PrintJSON(opts.IO, addOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Added comment to %s\n", opts.IssueKey)
@@ -126,39 +138,21 @@ func runAdd(opts *AddOptions) error {
 	return nil
 }
 
-func replyToComment(ctx context.Context, jira *api.JiraService, hostname string, opts *AddOptions) error {
+func replyToComment(ctx context.Context, jira *api.JiraService, browseBase string, opts *AddOptions) error {
 	// Get the original comment to quote it
 	originalComment, err := jira.GetComment(ctx, opts.IssueKey, opts.ReplyTo)
 	if err != nil {
 		return fmt.Errorf("failed to get original comment: %w", err)
 	}
 
-	// Build the reply with a quote of the original
-	originalText := ""
-	if originalComment.Body != nil {
-		originalText = api.ADFToText(originalComment.Body)
-	}
-	originalAuthor := "Unknown"
-	if originalComment.Author != nil {
-		originalAuthor = originalComment.Author.DisplayName
-	}
-
-	// Create quoted reply
-	quotedLines := strings.Split(originalText, "\n")
-	var quoted strings.Builder
-	quoted.WriteString(fmt.Sprintf("*Replying to %s:*\n", originalAuthor))
-	quoted.WriteString("{quote}\n")
-	for _, line := range quotedLines {
-		quoted.WriteString(line)
-		quoted.WriteString("\n")
-	}
-	quoted.WriteString("{quote}\n\n")
-	quoted.WriteString(opts.Body)
-
+	// Quote the original as ADF so it survives whichever --format the
+	// reply itself is written in.
 	commentOpts := &api.CommentOptions{
-		Body:           quoted.String(),
+		Body:           opts.Body,
 		VisibilityType: opts.VisibilityType,
 		VisibilityName: opts.VisibilityName,
+		Format:         opts.Format,
+		Prefix:         replyQuote(originalComment),
 	}
 
 	comment, err := jira.AddCommentWithOptions(ctx, opts.IssueKey, commentOpts)
@@ -170,11 +164,11 @@ func replyToComment(ctx context.Context, jira *api.JiraService, hostname string,
 		IssueKey:  opts.IssueKey,
 		CommentID: comment.ID,
 		Action:    "replied",
-		URL:       fmt.Sprintf("https://%s/browse/%s?focusedCommentId=%s", hostname, opts.IssueKey, comment.ID),
+		URL:       api.IssueBrowseURL(browseBase, opts.IssueKey) + "?focusedCommentId=" + comment.ID,
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, replyOutput)
+		return output.PrintJSON(opts.IO, replyOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Replied to comment %s on %s\n", opts.ReplyTo, opts.IssueKey)
@@ -183,3 +177,43 @@ func replyToComment(ctx context.Context, jira *api.JiraService, hostname string,
 
 	return nil
 }
+
+// replyQuote builds the ADF that opens a reply: who is being replied to,
+// then the original comment's text as a blockquote, one paragraph per line.
+func replyQuote(original *api.Comment) []api.ADFContent {
+	author := "Unknown"
+	if original.Author != nil {
+		author = original.Author.DisplayName
+	}
+	text := ""
+	if original.Body != nil {
+		text = api.ADFToText(original.Body)
+	}
+
+	var quoted []api.ADFContent
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		quoted = append(quoted, api.ADFContent{
+			Type:    "paragraph",
+			Content: []api.ADFContent{{Type: "text", Text: line}},
+		})
+	}
+	if len(quoted) == 0 {
+		// A blockquote needs at least one block.
+		quoted = []api.ADFContent{{Type: "paragraph"}}
+	}
+
+	return []api.ADFContent{
+		{
+			Type: "paragraph",
+			Content: []api.ADFContent{{
+				Type:  "text",
+				Text:  fmt.Sprintf("Replying to %s:", author),
+				Marks: []api.ADFMark{{Type: "strong"}},
+			}},
+		},
+		{Type: "blockquote", Content: quoted},
+	}
+}
@@ -0,0 +1,44 @@
+package comment
+
+import (
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestReplyQuote(t *testing.T) {
+	original := &api.Comment{
+		Author: &api.User{DisplayName: "Ada"},
+		Body:   api.MarkdownToADF("First line\n\nSecond *line*"),
+	}
+
+	// The quote is ADF, so a wiki reply appended after it stays valid.
+	reply, err := api.BodyToADF("*Agreed*", api.FormatWiki)
+	if err != nil {
+		t.Fatalf("BodyToADF() error = %v", err)
+	}
+	reply.Content = append(replyQuote(original), reply.Content...)
+	if err := api.ValidateADF(reply); err != nil {
+		t.Fatalf("ValidateADF() error = %v", err)
+	}
+
+	if len(reply.Content) != 3 {
+		t.Fatalf("content = %+v, want header, blockquote, reply", reply.Content)
+	}
+	header := reply.Content[0].Content[0]
+	if header.Text != "Replying to Ada:" || len(header.Marks) != 1 || header.Marks[0].Type != "strong" {
+		t.Errorf("header = %+v, want bold \"Replying to Ada:\"", header)
+	}
+	quote := reply.Content[1]
+	if quote.Type != "blockquote" || len(quote.Content) != 2 || quote.Content[0].Content[0].Text != "First line" {
+		t.Errorf("quote = %+v, want a blockquote of both lines", quote)
+	}
+	if got := reply.Content[2].Content[0]; got.Text != "Agreed" || len(got.Marks) != 1 || got.Marks[0].Type != "strong" {
+		t.Errorf("reply = %+v, want bold wiki text", got)
+	}
+
+	empty := replyQuote(&api.Comment{})
+	if empty[0].Content[0].Text != "Replying to Unknown:" || len(empty[1].Content) != 1 {
+		t.Errorf("replyQuote() for an empty comment = %+v", empty)
+	}
+}
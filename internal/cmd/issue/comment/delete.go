@@ -16,6 +16,8 @@ type DeleteOptions struct {
 	IO        *iostreams.IOStreams
 	IssueKey  string
 	CommentID string
+	All       bool
+	Author    string
 	Force     bool
 	JSON      bool
 }
@@ -32,46 +34,80 @@ func NewCmdDelete(ios *iostreams.IOStreams) *cobra.Command {
 		Short:   "Delete a comment from an issue",
 		Long: `Delete an existing comment from a Jira issue.
 
-Requires the comment ID which can be found using 'atl issue comment list'.`,
+Requires the comment ID which can be found using 'atl issue comment list'.
+
+Use --all with --author to delete every comment by one user, for example
+to clean up comments left by a bot. Each deletion is attempted even if an
+earlier one fails, and failures are reported at the end. Deleting more than
+one comment requires confirmation, or --force when not running interactively.`,
 		Example: `  # Delete a comment (prompts for confirmation)
   atl issue comment delete PROJ-1234 --id 12345
 
   # Delete without confirmation
   atl issue comment delete PROJ-1234 --id 12345 --force
 
+  # Delete all of your own comments
+  atl issue comment delete PROJ-1234 --all --author @me
+
+  # Delete all comments by a bot account without prompting
+  atl issue comment delete PROJ-1234 --all --author 557058:abcd-1234 --yes
+
   # Output as JSON
   atl issue comment delete PROJ-1234 --id 12345 --json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+
+			if opts.All {
+				if opts.CommentID != "" {
+					return fmt.Errorf("--id and --all cannot be used together")
+				}
+				if opts.Author == "" {
+					return fmt.Errorf("--author is required with --all\n\nUse --author @me to delete your own comments")
+				}
+				return runDeleteAll(cmd.Context(), opts)
+			}
+			if opts.Author != "" {
+				return fmt.Errorf("--author can only be used with --all")
+			}
 
 			if opts.CommentID == "" {
-				return fmt.Errorf("--id is required\n\nUse 'atl issue comment list %s' to see comment IDs", args[0])
+				return fmt.Errorf("--id is required\n\nUse 'atl issue comment list %s' to see comment IDs", opts.IssueKey)
 			}
 
-			return runDelete(opts)
+			return runDelete(cmd.Context(), opts)
 		},
 	}
 
-	cmd.Flags().StringVar(&opts.CommentID, "id", "", "Comment ID to delete (required)")
+	cmd.Flags().StringVar(&opts.CommentID, "id", "", "Comment ID to delete (required unless --all)")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Delete all comments by --author")
+	cmd.Flags().StringVar(&opts.Author, "author", "", "Account ID whose comments to delete, or @me (with --all)")
 	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Skip confirmation prompt")
+	cmd.Flags().BoolVarP(&opts.Force, "yes", "y", false, "Alias for --force")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
 
+	// --json skips the confirmation, so the default output format must not set it.
+	output.MarkConfirms(cmd)
+
 	return cmd
 }
 
-func runDelete(opts *DeleteOptions) error {
+func runDelete(ctx context.Context, opts *DeleteOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
-	hostname := client.Hostname()
+	browseBase := client.BrowseBaseURL()
 
 	// Confirm deletion unless --force
 	if !opts.Force && !opts.JSON {
+		opts.IO.StopPager()
 		fmt.Fprintf(opts.IO.Out, "Delete comment %s from %s? [y/N]: ", opts.CommentID, opts.IssueKey)
 		var confirm string
 		fmt.Fscanln(opts.IO.In, &confirm)
@@ -90,14 +126,129 @@ func runDelete(opts *DeleteOptions) error {
 		IssueKey:  opts.IssueKey,
 		CommentID: opts.CommentID,
 		Action:    "deleted",
-		URL:       fmt.Sprintf("https://%s/browse/%s", hostname, opts.IssueKey),
+		URL:       api.IssueBrowseURL(browseBase, opts.IssueKey),
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, deleteOutput)
+		return output.PrintJSON(opts.IO, deleteOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Deleted comment %s from %s\n", opts.CommentID, opts.IssueKey)
 
 	return nil
 }
+
+// DeleteAllOutput represents the result of deleting comments by author.
+type DeleteAllOutput struct {
+	IssueKey string           `json:"issue_key"`
+	AuthorID string           `json:"author_id"`
+	Matched  int              `json:"matched"`
+	Deleted  []string         `json:"deleted"`
+	Failed   []*DeleteFailure `json:"failed,omitempty"`
+}
+
+// DeleteFailure records a comment that could not be deleted.
+type DeleteFailure struct {
+	CommentID string `json:"comment_id"`
+	Error     string `json:"error"`
+}
+
+func runDeleteAll(ctx context.Context, opts *DeleteOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	authorID := opts.Author
+	if authorID == "@me" {
+		me, err := jira.GetMyself(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get current user: %w", err)
+		}
+		authorID = me.AccountID
+	}
+
+	comments, err := jira.GetCommentsAll(ctx, opts.IssueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	matches := commentsByAuthor(comments, authorID)
+
+	deleteOutput := &DeleteAllOutput{
+		IssueKey: opts.IssueKey,
+		AuthorID: authorID,
+		Matched:  len(matches),
+		Deleted:  make([]string, 0, len(matches)),
+	}
+
+	if len(matches) == 0 {
+		if opts.JSON {
+			return output.PrintJSON(opts.IO, deleteOutput)
+		}
+		fmt.Fprintf(opts.IO.Out, "No comments by %s on %s\n", opts.Author, opts.IssueKey)
+		return nil
+	}
+
+	if !opts.Force {
+		if opts.JSON || !opts.IO.IsStdinTTY {
+			return fmt.Errorf("refusing to delete %d comments without confirmation\n\nPass --force (or --yes) to delete without prompting", len(matches))
+		}
+		opts.IO.StopPager()
+		fmt.Fprintf(opts.IO.Out, "Delete %d comments by %s from %s? [y/N]: ", len(matches), commentAuthorName(matches[0]), opts.IssueKey)
+		var confirm string
+		fmt.Fscanln(opts.IO.In, &confirm)
+		if confirm != "y" && confirm != "Y" {
+			fmt.Fprintln(opts.IO.Out, "Canceled")
+			return nil
+		}
+	}
+
+	for _, c := range matches {
+		if err := jira.DeleteComment(ctx, opts.IssueKey, c.ID); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			deleteOutput.Failed = append(deleteOutput.Failed, &DeleteFailure{CommentID: c.ID, Error: err.Error()})
+			if !opts.JSON {
+				fmt.Fprintf(opts.IO.ErrOut, "Failed to delete comment %s: %v\n", c.ID, err)
+			}
+			continue
+		}
+		deleteOutput.Deleted = append(deleteOutput.Deleted, c.ID)
+	}
+
+	if opts.JSON {
+		if err := output.PrintJSON(opts.IO, deleteOutput); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(opts.IO.Out, "Deleted %d of %d comments from %s\n", len(deleteOutput.Deleted), len(matches), opts.IssueKey)
+	}
+
+	if len(deleteOutput.Failed) > 0 {
+		return fmt.Errorf("failed to delete %d of %d comments", len(deleteOutput.Failed), len(matches))
+	}
+
+	return nil
+}
+
+// commentsByAuthor returns the comments written by the given account.
+func commentsByAuthor(comments []*api.Comment, accountID string) []*api.Comment {
+	var matches []*api.Comment
+	for _, c := range comments {
+		if c.Author != nil && c.Author.AccountID == accountID {
+			matches = append(matches, c)
+		}
+	}
+	return matches
+}
+
+func commentAuthorName(c *api.Comment) string {
+	if c.Author != nil && c.Author.DisplayName != "" {
+		return c.Author.DisplayName
+	}
+	return "unknown user"
+}
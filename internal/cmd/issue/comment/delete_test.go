@@ -0,0 +1,29 @@
+package comment
+
+import (
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestCommentsByAuthor(t *testing.T) {
+	comments := []*api.Comment{
+		{ID: "1", Author: &api.User{AccountID: "bot"}},
+		{ID: "2", Author: &api.User{AccountID: "human"}},
+		{ID: "3"},
+		{ID: "4", Author: &api.User{AccountID: "bot"}},
+	}
+
+	got := commentsByAuthor(comments, "bot")
+	if len(got) != 2 || got[0].ID != "1" || got[1].ID != "4" {
+		ids := make([]string, 0, len(got))
+		for _, c := range got {
+			ids = append(ids, c.ID)
+		}
+		t.Errorf("commentsByAuthor() = %v, want [1 4]", ids)
+	}
+
+	if got := commentsByAuthor(comments, "nobody"); len(got) != 0 {
+		t.Errorf("commentsByAuthor() for unknown author returned %d comments, want 0", len(got))
+	}
+}
@@ -19,6 +19,7 @@ type EditOptions struct {
 	Body           string
 	VisibilityType string
 	VisibilityName string
+	Format         string
 	JSON           bool
 }
 
@@ -44,21 +45,29 @@ Requires the comment ID which can be found using 'atl issue comment list'.`,
   atl issue comment edit PROJ-1234 --id 12345 --body "Text" --json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
 
 			if opts.CommentID == "" {
-				return fmt.Errorf("--id is required\n\nUse 'atl issue comment list %s' to see comment IDs", args[0])
+				return fmt.Errorf("--id is required\n\nUse 'atl issue comment list %s' to see comment IDs", opts.IssueKey)
 			}
 			if opts.Body == "" {
 				return fmt.Errorf("--body is required")
 			}
+			if err := api.CheckBodyFormat(opts.Format); err != nil {
+				return err
+			}
 
-			return runEdit(opts)
+			return runEdit(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().StringVar(&opts.CommentID, "id", "", "Comment ID to edit (required)")
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "New comment text (required)")
+	cmd.Flags().StringVar(&opts.Format, "format", api.FormatMarkdown, "Text format of --body: markdown or wiki (Jira wiki markup)")
 	cmd.Flags().StringVar(&opts.VisibilityType, "visibility-type", "", "Visibility type: 'role' or 'group'")
 	cmd.Flags().StringVar(&opts.VisibilityName, "visibility-name", "", "Role or group name for visibility restriction")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
@@ -66,20 +75,20 @@ Requires the comment ID which can be found using 'atl issue comment list'.`,
 	return cmd
 }
 
-func runEdit(opts *EditOptions) error {
+func runEdit(ctx context.Context, opts *EditOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
-	hostname := client.Hostname()
+	browseBase := client.BrowseBaseURL()
 
 	commentOpts := &api.CommentOptions{
 		Body:           opts.Body,
 		VisibilityType: opts.VisibilityType,
 		VisibilityName: opts.VisibilityName,
+		Format:         opts.Format,
 	}
 
 	comment, err := jira.UpdateComment(ctx, opts.IssueKey, opts.CommentID, commentOpts)
@@ -91,11 +100,11 @@ func runEdit(opts *EditOptions) error {
 		IssueKey:  opts.IssueKey,
 		CommentID: comment.ID,
 		Action:    "edited",
-		URL:       fmt.Sprintf("https://%s/browse/%s?focusedCommentId=%s", hostname, opts.IssueKey, comment.ID),
+		URL:       api.IssueBrowseURL(browseBase, opts.IssueKey) + "?focusedCommentId=" + comment.ID,
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, editOutput)
+		return output.PrintJSON(opts.IO, editOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Edited comment on %s\n", opts.IssueKey)
@@ -3,6 +3,8 @@ package comment
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -15,32 +17,59 @@ import (
 type ListOptions struct {
 	IO       *iostreams.IOStreams
 	IssueKey string
+	Follow   bool
+	Interval time.Duration
 	JSON     bool
 }
 
 // NewCmdList creates the list command.
 func NewCmdList(ios *iostreams.IOStreams) *cobra.Command {
 	opts := &ListOptions{
-		IO: ios,
+		IO:       ios,
+		Interval: 15 * time.Second,
 	}
 
 	cmd := &cobra.Command{
 		Use:     "list <issue-key>",
 		Aliases: []string{"ls"},
 		Short:   "List comments on an issue",
-		Long:    `View all comments on a Jira issue.`,
+		Long: `View all comments on a Jira issue.
+
+With --follow, keep polling and print each new comment as it is added, like a
+live feed during an incident. Press Ctrl-C to stop.`,
 		Example: `  # List comments on an issue
   atl issue comment list PROJ-1234
 
+  # Follow new comments, checking every 15 seconds
+  atl issue comment list PROJ-1234 --follow --interval 15s
+
   # Output as JSON
   atl issue comment list PROJ-1234 --json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
-			return runList(opts)
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			if opts.Follow {
+				if opts.JSON {
+					return fmt.Errorf("--follow cannot be used with --json")
+				}
+				if !opts.IO.IsStdoutTTY {
+					return fmt.Errorf("--follow requires an interactive terminal")
+				}
+				if opts.Interval < time.Second {
+					return fmt.Errorf("--interval must be at least 1s")
+				}
+				return runListFollow(cmd.Context(), opts)
+			}
+			return runList(cmd.Context(), opts)
 		},
 	}
 
+	cmd.Flags().BoolVarP(&opts.Follow, "follow", "f", false, "Keep polling and print new comments as they are added")
+	cmd.Flags().DurationVar(&opts.Interval, "interval", 15*time.Second, "Polling interval for --follow")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
 
 	return cmd
@@ -63,13 +92,12 @@ type CommentOutput struct {
 	Visibility string `json:"visibility,omitempty"`
 }
 
-func runList(opts *ListOptions) error {
+func runList(ctx context.Context, opts *ListOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	comments, err := jira.GetComments(ctx, opts.IssueKey)
@@ -84,22 +112,11 @@ func runList(opts *ListOptions) error {
 	}
 
 	for _, c := range comments {
-		comment := &CommentOutput{
-			ID:      c.ID,
-			Created: formatTime(c.Created),
-			Updated: formatTime(c.Updated),
-		}
-		if c.Author != nil {
-			comment.Author = c.Author.DisplayName
-		}
-		if c.Body != nil {
-			comment.Body = api.ADFToText(c.Body)
-		}
-		listOutput.Comments = append(listOutput.Comments, comment)
+		listOutput.Comments = append(listOutput.Comments, toCommentOutput(c))
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, listOutput)
+		return output.PrintJSON(opts.IO, listOutput)
 	}
 
 	if len(listOutput.Comments) == 0 {
@@ -113,14 +130,102 @@ func runList(opts *ListOptions) error {
 		if i > 0 {
 			fmt.Fprintln(opts.IO.Out, "---")
 		}
-		fmt.Fprintf(opts.IO.Out, "**%s** (%s) [ID: %s]\n\n", c.Author, c.Created, c.ID)
-		fmt.Fprintln(opts.IO.Out, c.Body)
-		fmt.Fprintln(opts.IO.Out)
+		printComment(opts.IO.Out, c)
 	}
 
 	return nil
 }
 
+// runListFollow prints the existing comments, then polls every opts.Interval
+// and prints comments that were not seen before, until interrupted.
+func runListFollow(ctx context.Context, opts *ListOptions) error {
+	// New comments should appear as they arrive, not when a pager exits.
+	opts.IO.StopPager()
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	fmt.Fprintf(opts.IO.Out, "# Comments on %s (following every %s, Ctrl-C to stop)\n\n", opts.IssueKey, opts.Interval)
+
+	return followComments(ctx, opts, func(ctx context.Context) ([]*api.Comment, error) {
+		return jira.GetCommentsAll(ctx, opts.IssueKey)
+	})
+}
+
+// followComments is the polling loop of runListFollow. A failed poll warns
+// and is retried at the next interval without losing track of the comments
+// already printed; only errors that a retry cannot fix, such as a missing
+// issue or lost access, end the feed.
+func followComments(ctx context.Context, opts *ListOptions, fetch func(ctx context.Context) ([]*api.Comment, error)) error {
+	seen := make(map[string]bool)
+	printed := false
+	for {
+		comments, err := fetch(ctx)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return nil
+		case err != nil && api.IsPermanentError(err):
+			return fmt.Errorf("failed to get comments: %w", err)
+		case err != nil:
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: %s: failed to get comments, retrying in %s: %v\n",
+				time.Now().Format("2006-01-02 15:04:05"), opts.Interval, err)
+		}
+
+		for _, c := range newComments(seen, comments) {
+			if printed {
+				fmt.Fprintln(opts.IO.Out, "---")
+			}
+			printComment(opts.IO.Out, toCommentOutput(c))
+			printed = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
+// newComments returns the comments whose IDs are not in seen, in their
+// original order, and adds them to seen.
+func newComments(seen map[string]bool, comments []*api.Comment) []*api.Comment {
+	var added []*api.Comment
+	for _, c := range comments {
+		if seen[c.ID] {
+			continue
+		}
+		seen[c.ID] = true
+		added = append(added, c)
+	}
+	return added
+}
+
+func toCommentOutput(c *api.Comment) *CommentOutput {
+	comment := &CommentOutput{
+		ID:      c.ID,
+		Created: formatTime(c.Created),
+		Updated: formatTime(c.Updated),
+	}
+	if c.Author != nil {
+		comment.Author = c.Author.DisplayName
+	}
+	if c.Body != nil {
+		comment.Body = api.ADFToText(c.Body)
+	}
+	return comment
+}
+
+func printComment(w io.Writer, c *CommentOutput) {
+	fmt.Fprintf(w, "**%s** (%s) [ID: %s]\n\n", c.Author, c.Created, c.ID)
+	fmt.Fprintln(w, c.Body)
+	fmt.Fprintln(w)
+}
+
 func formatTime(t string) string {
 	if len(t) >= 19 {
 		return t[:10] + " " + t[11:19]
@@ -0,0 +1,76 @@
+package comment
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func TestNewComments(t *testing.T) {
+	seen := make(map[string]bool)
+
+	first := newComments(seen, []*api.Comment{{ID: "1"}, {ID: "2"}})
+	if len(first) != 2 {
+		t.Fatalf("first poll returned %d comments, want 2", len(first))
+	}
+
+	// A later poll returns the old comments plus new ones; a deleted comment
+	// (2) simply disappears.
+	got := newComments(seen, []*api.Comment{{ID: "1"}, {ID: "3"}, {ID: "4"}})
+	if len(got) != 2 || got[0].ID != "3" || got[1].ID != "4" {
+		ids := make([]string, 0, len(got))
+		for _, c := range got {
+			ids = append(ids, c.ID)
+		}
+		t.Errorf("newComments() = %v, want [3 4]", ids)
+	}
+
+	if got := newComments(seen, []*api.Comment{{ID: "1"}, {ID: "3"}, {ID: "4"}}); len(got) != 0 {
+		t.Errorf("poll without changes returned %d comments, want 0", len(got))
+	}
+}
+
+func TestFollowCommentsSurvivesFailedPolls(t *testing.T) {
+	var out, errOut bytes.Buffer
+	ios := iostreams.Test()
+	ios.Out = &out
+	ios.ErrOut = &errOut
+	opts := &ListOptions{IO: ios, IssueKey: "PROJ-1", Interval: time.Millisecond}
+
+	comment := func(id, text string) *api.Comment {
+		return &api.Comment{ID: id, Author: &api.User{DisplayName: "Ana"}, Body: api.TextToADF(text)}
+	}
+	gone := &api.APIError{StatusCode: 404, Status: "404 Not Found"}
+	polls := []struct {
+		comments []*api.Comment
+		err      error
+	}{
+		{comments: []*api.Comment{comment("1", "first")}},
+		{err: &api.APIError{StatusCode: 502, Status: "502 Bad Gateway"}},
+		{comments: []*api.Comment{comment("1", "first"), comment("2", "second")}},
+		{err: gone},
+	}
+	n := 0
+	fetch := func(ctx context.Context) ([]*api.Comment, error) {
+		p := polls[n]
+		n++
+		return p.comments, p.err
+	}
+
+	err := followComments(context.Background(), opts, fetch)
+	if !errors.Is(err, gone) {
+		t.Errorf("followComments() error = %v, want the 404", err)
+	}
+	if !strings.Contains(errOut.String(), "failed to get comments, retrying") {
+		t.Errorf("stderr = %q, want a retry warning", errOut.String())
+	}
+	if strings.Count(out.String(), "first") != 1 || strings.Count(out.String(), "second") != 1 {
+		t.Errorf("output = %q, want each comment printed once", out.String())
+	}
+}
@@ -3,14 +3,19 @@ package issue
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/enthus-appdev/atl-cli/internal/api"
 	"github.com/enthus-appdev/atl-cli/internal/auth"
+	"github.com/enthus-appdev/atl-cli/internal/config"
 	"github.com/enthus-appdev/atl-cli/internal/iostreams"
 	"github.com/enthus-appdev/atl-cli/internal/output"
 )
@@ -22,14 +27,29 @@ type CreateOptions struct {
 	IssueType    string
 	Summary      string
 	Description  string
+	Format       string
 	Assignee     string
+	AssignMe     bool
+	Reporter     string
 	Labels       []string
 	Priority     string
 	Parent       string
+	EpicName     string
+	Security     string
+	Due          string
+	Comment      string
+	Watchers     []string
 	CustomFields []string
 	FieldFile    string
+	FromFile     string
+	DryRun       bool
+	ShowADF      bool
 	Web          bool
 	JSON         bool
+
+	// FieldValues holds already-typed field values keyed by field name or ID.
+	// Used for rows loaded via --from-file; merged like --field-file values.
+	FieldValues map[string]interface{}
 }
 
 // NewCmdCreate creates the create command.
@@ -41,13 +61,46 @@ func NewCmdCreate(ios *iostreams.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new Jira issue",
-		Long:  `Create a new Jira issue in a project.`,
+		Long: `Create a new Jira issue in a project.
+
+When creating an epic (--type Epic, or whatever the project's epic type is
+called) in a project whose create screen has an "Epic Name" field
+(company-managed projects), it is filled from --summary unless --epic-name
+or --field "Epic Name=..." is given.
+
+Without --assignee, Jira's default assignee for the project is used. Pass
+--assign-me, or set 'atl config set create.assign_self true', to assign
+new issues to yourself instead; --assign-me=false overrides the setting for
+one run. With --from-file this applies to rows without an assignee column.`,
 		Example: `  # Create a bug
   atl issue create --project PROJ --type Bug --summary "Fix login issue"
 
   # Create a task with description
   atl issue create --project PROJ --type Task --summary "New feature" --description "Implement new feature"
 
+  # Create and assign to yourself
+  atl issue create --project PROJ --type Task --summary "Update runbook" --assign-me
+
+  # Create on behalf of someone else (requires Modify Reporter permission)
+  atl issue create --project PROJ --type Bug --summary "Printer on fire" --reporter jane@example.com
+
+  # Create with a due date two weeks out (also: today, tomorrow, +3d, next friday)
+  atl issue create --project PROJ --type Task --summary "Renew certificate" --due +2w
+
+  # Create and add a first comment
+  atl issue create --project PROJ --type Bug --summary "Flaky test" --comment "Seen in builds 101 and 104"
+
+  # Create and add watchers (email, name, or @me; can be repeated)
+  atl issue create --project PROJ --type Bug --summary "Outage follow-up" --watcher @me --watcher jane@example.com
+
+  # Description in Jira wiki markup instead of Markdown
+  atl issue create --project PROJ --type Bug --summary "Crash" --format wiki --description "h2. Steps
+# Open the app
+# Tap {{Sync}}"
+
+  # See how the Markdown description was converted to ADF
+  atl issue create --project PROJ --type Task --summary "Docs" --description "**Note:** see [the runbook](https://example.com/runbook)" --show-adf
+
   # Create and open in browser
   atl issue create --project PROJ --type Task --summary "New feature" --web
 
@@ -57,6 +110,9 @@ func NewCmdCreate(ios *iostreams.IOStreams) *cobra.Command {
   # Or specify the subtask type explicitly
   atl issue create --project PROJ --type "Sub-task" --parent PROJ-123 --summary "Subtask"
 
+  # Create an epic with a short Epic Name
+  atl issue create --project PROJ --type Epic --summary "Checkout redesign 2026" --epic-name "Checkout"
+
   # Create with custom fields by name (Story Points, etc.)
   atl issue create --project PROJ --type Story --summary "New story" --field "Story Points=5"
 
@@ -67,8 +123,33 @@ func NewCmdCreate(ios *iostreams.IOStreams) *cobra.Command {
   atl issue create --project PROJ --type Task --summary "Task" --field-file fields.json
 
   # Output as JSON
-  atl issue create --project PROJ --type Bug --summary "Bug report" --json`,
+  atl issue create --project PROJ --type Bug --summary "Bug report" --json
+
+  # Create many issues from a CSV or JSON file (--project is the default for rows)
+  atl issue create --project PROJ --from-file backlog.csv
+
+  # Validate every row without creating anything
+  atl issue create --project PROJ --from-file backlog.json --dry-run`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := api.CheckBodyFormat(opts.Format); err != nil {
+				return err
+			}
+			if opts.FromFile != "" {
+				if opts.Comment != "" {
+					return fmt.Errorf("--comment cannot be used with --from-file")
+				}
+				if len(opts.Watchers) > 0 {
+					return fmt.Errorf("--watcher cannot be used with --from-file")
+				}
+				if opts.ShowADF {
+					return fmt.Errorf("--show-adf cannot be used with --from-file")
+				}
+				opts.AssignMe = resolveAssignSelf(cmd, opts.AssignMe)
+				return runCreateFromFile(cmd.Context(), opts)
+			}
+			if opts.DryRun {
+				return fmt.Errorf("--dry-run requires --from-file")
+			}
 			var missing []string
 			if opts.Project == "" {
 				missing = append(missing, "--project")
@@ -83,7 +164,11 @@ func NewCmdCreate(ios *iostreams.IOStreams) *cobra.Command {
 			if len(missing) > 0 {
 				return fmt.Errorf("required flags not set: %v\n\nExample: atl issue create --project PROJ --type Bug --summary \"Issue title\"", missing)
 			}
-			return runCreate(opts)
+			if opts.AssignMe && opts.Assignee != "" {
+				return fmt.Errorf("--assign-me and --assignee cannot be used together")
+			}
+			opts.Assignee = createAssignee(opts.Assignee, resolveAssignSelf(cmd, opts.AssignMe))
+			return runCreate(cmd.Context(), opts)
 		},
 	}
 
@@ -91,12 +176,23 @@ func NewCmdCreate(ios *iostreams.IOStreams) *cobra.Command {
 	cmd.Flags().StringVarP(&opts.IssueType, "type", "t", "", "Issue type (e.g., Bug, Task, Story) (required)")
 	cmd.Flags().StringVarP(&opts.Summary, "summary", "s", "", "Issue summary (required)")
 	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Issue description")
+	cmd.Flags().StringVar(&opts.Format, "format", api.FormatMarkdown, "Text format of --description and --comment: markdown or wiki (Jira wiki markup)")
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Assignee (use @me for yourself)")
+	cmd.Flags().BoolVar(&opts.AssignMe, "assign-me", false, "Assign the issue to yourself (default: create.assign_self; =false to keep Jira's default)")
+	cmd.Flags().StringVar(&opts.Reporter, "reporter", "", "Reporter by email or name (use @me for yourself; requires Modify Reporter permission)")
 	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Labels to add")
 	cmd.Flags().StringVar(&opts.Priority, "priority", "", "Priority level")
 	cmd.Flags().StringVar(&opts.Parent, "parent", "", "Parent issue key (for subtasks)")
+	cmd.Flags().StringVar(&opts.EpicName, "epic-name", "", "Epic Name for epics (default: the summary)")
+	cmd.Flags().StringVar(&opts.Security, "security-level", "", "Issue security level name")
+	cmd.Flags().StringVar(&opts.Due, "due", "", "Due date: YYYY-MM-DD or relative (today, tomorrow, +3d, +2w)")
+	cmd.Flags().StringVarP(&opts.Comment, "comment", "c", "", "Add a comment to the issue after creating it")
+	cmd.Flags().StringArrayVar(&opts.Watchers, "watcher", nil, "Add a watcher after creating the issue: email, name, or @me (can be repeated)")
 	cmd.Flags().StringSliceVarP(&opts.CustomFields, "field", "f", nil, "Custom field in key=value format (can be repeated)")
 	cmd.Flags().StringVar(&opts.FieldFile, "field-file", "", "JSON file with field values (for complex types like ADF)")
+	cmd.Flags().StringVar(&opts.FromFile, "from-file", "", "Create one issue per row of a CSV or JSON file")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Validate --from-file rows without creating issues")
+	cmd.Flags().BoolVar(&opts.ShowADF, "show-adf", false, "Show the ADF generated from --description (stderr, or description_adf with --json)")
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open created issue in browser")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
 
@@ -111,48 +207,230 @@ type CreateOutput struct {
 	Type    string `json:"type"`
 	Project string `json:"project"`
 	URL     string `json:"url"`
+
+	// Set when --comment is given. The issue exists even if the comment
+	// failed; CommentError then holds the reason.
+	CommentID    string `json:"comment_id,omitempty"`
+	CommentError string `json:"comment_error,omitempty"`
+
+	// Set when --watcher is given: the display names of the watchers added,
+	// and one message per watcher that could not be resolved or added.
+	WatchersAdded []string `json:"watchers_added,omitempty"`
+	WatcherErrors []string `json:"watcher_errors,omitempty"`
+
+	// The description as sent to Jira; set with --show-adf.
+	DescriptionADF *api.ADF `json:"description_adf,omitempty"`
 }
 
-func runCreate(opts *CreateOptions) error {
+func runCreate(ctx context.Context, opts *CreateOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
+	req, err := buildCreateRequest(ctx, jira, opts)
+	if err != nil {
+		return err
+	}
+
+	// Printed before creating, so the conversion can be inspected even when
+	// Jira rejects the issue.
+	if opts.ShowADF && !opts.JSON {
+		if err := printDescriptionADF(opts.IO.ErrOut, req.Fields.Description, opts.IO.JSONIndent()); err != nil {
+			return err
+		}
+	}
+
+	result, err := jira.CreateIssue(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to create issue: %w", explainReporterError(err, opts.Reporter != ""))
+	}
+
+	createOutput := &CreateOutput{
+		Key:     result.Key,
+		ID:      result.ID,
+		Summary: opts.Summary,
+		Type:    opts.IssueType,
+		Project: opts.Project,
+		URL:     client.BrowseURL(result.Key),
+	}
+	if opts.ShowADF {
+		createOutput.DescriptionADF = req.Fields.Description
+	}
+
+	if opts.Comment != "" {
+		addComment := func(ctx context.Context, key, body string) (*api.Comment, error) {
+			return jira.AddCommentWithOptions(ctx, key, &api.CommentOptions{Body: body, Format: opts.Format})
+		}
+		addCreateComment(ctx, addComment, createOutput, opts.Comment)
+		if createOutput.CommentError != "" {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: issue %s created but failed to add comment: %s\n", createOutput.Key, createOutput.CommentError)
+		}
+	}
+
+	if len(opts.Watchers) > 0 {
+		resolve := func(ctx context.Context, user string) (string, string, error) {
+			return resolveAssignee(ctx, jira, user)
+		}
+		addCreateWatchers(ctx, resolve, jira.AddWatcher, createOutput, opts.Watchers)
+		for _, msg := range createOutput.WatcherErrors {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: issue %s created but failed to add watcher %s\n", createOutput.Key, msg)
+		}
+	}
+
+	if opts.Web {
+		auth.OpenBrowser(createOutput.URL)
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, createOutput)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Created issue: %s\n", createOutput.Key)
+	fmt.Fprintf(opts.IO.Out, "Summary: %s\n", createOutput.Summary)
+	fmt.Fprintf(opts.IO.Out, "Type: %s\n", createOutput.Type)
+	fmt.Fprintf(opts.IO.Out, "URL: %s\n", createOutput.URL)
+	if createOutput.CommentID != "" {
+		fmt.Fprintf(opts.IO.Out, "Comment: %s\n", createOutput.CommentID)
+	}
+	if len(createOutput.WatchersAdded) > 0 {
+		fmt.Fprintf(opts.IO.Out, "Watchers: %s\n", strings.Join(createOutput.WatchersAdded, ", "))
+	}
+
+	return nil
+}
+
+// printDescriptionADF writes the generated description ADF for --show-adf.
+func printDescriptionADF(w io.Writer, doc *api.ADF, indent int) error {
+	if doc == nil {
+		fmt.Fprintln(w, "Description ADF: (no description)")
+		return nil
+	}
+	fmt.Fprintln(w, "Description ADF:")
+	return output.JSONIndent(w, doc, indent)
+}
+
+// addCreateComment adds body as a comment on the issue just created and
+// records the comment ID, or the error, in createOutput. A failed comment does
+// not undo the create, so the error is recorded rather than returned.
+func addCreateComment(ctx context.Context, addComment func(ctx context.Context, key, body string) (*api.Comment, error), createOutput *CreateOutput, body string) {
+	comment, err := addComment(ctx, createOutput.Key, body)
+	if err != nil {
+		createOutput.CommentError = err.Error()
+		return
+	}
+	createOutput.CommentID = comment.ID
+}
+
+// addCreateWatchers resolves each watcher and adds it to the issue just
+// created, recording the added display names and the failures in
+// createOutput. Like a failed comment, a failed watcher does not undo the
+// create.
+func addCreateWatchers(ctx context.Context, resolve func(ctx context.Context, user string) (string, string, error), addWatcher func(ctx context.Context, key, accountID string) error, createOutput *CreateOutput, watchers []string) {
+	for _, watcher := range watchers {
+		accountID, name, err := resolve(ctx, watcher)
+		if err == nil && accountID == "" {
+			err = fmt.Errorf("pass a user or @me")
+		}
+		if err == nil {
+			err = addWatcher(ctx, createOutput.Key, accountID)
+		}
+		if err != nil {
+			createOutput.WatcherErrors = append(createOutput.WatcherErrors, fmt.Sprintf("%s: %v", watcher, err))
+			continue
+		}
+		createOutput.WatchersAdded = append(createOutput.WatchersAdded, name)
+	}
+}
+
+// createAssignee returns the assignee to create an issue with: the one
+// given, @me when assigning to yourself, or "" for Jira's default assignee.
+func createAssignee(assignee string, assignSelf bool) string {
+	if assignee == "" && assignSelf {
+		return "@me"
+	}
+	return assignee
+}
+
+// resolveAssignSelf reports whether issues created without an assignee go
+// to the current user. An explicit --assign-me (including --assign-me=false)
+// wins; otherwise create.assign_self decides.
+func resolveAssignSelf(cmd *cobra.Command, assignMe bool) bool {
+	if cmd.Flags().Changed("assign-me") {
+		return assignMe
+	}
+	return assignSelfConfigured()
+}
+
+// assignSelfConfigured reports whether create.assign_self is set. An
+// unreadable config keeps Jira's default assignee.
+func assignSelfConfigured() bool {
+	cfg, err := config.Load()
+	return err == nil && cfg.AssignSelfOnCreate()
+}
+
+// explainReporterError adds a hint when Jira rejects the reporter field
+// because the user lacks the Modify Reporter permission. Jira reports this as
+// a 403 or as a 400 with a "reporter" field error.
+func explainReporterError(err error, reporterSet bool) error {
+	var apiErr *api.APIError
+	if !reporterSet || !errors.As(err, &apiErr) {
+		return err
+	}
+	if apiErr.StatusCode == http.StatusForbidden || (apiErr.StatusCode == http.StatusBadRequest && strings.Contains(apiErr.Body, `"reporter"`)) {
+		return fmt.Errorf("%w\n\nSetting the reporter requires the Modify Reporter project permission, and the field must be on the create screen; retry without --reporter or ask a project admin", err)
+	}
+	return err
+}
+
+// buildCreateRequest resolves users, issue type, and field names in opts
+// into a create request. It performs lookups but never creates anything.
+func buildCreateRequest(ctx context.Context, jira *api.JiraService, opts *CreateOptions) (*api.CreateIssueRequest, error) {
 	// Resolve @me assignee
 	var assigneeID string
 	if opts.Assignee != "" {
 		if opts.Assignee == "@me" {
 			user, err := jira.GetMyself(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to get current user: %w", err)
+				return nil, fmt.Errorf("failed to get current user: %w", err)
 			}
 			assigneeID = user.AccountID
 		} else {
 			// Search for user
 			users, err := jira.SearchUsers(ctx, opts.Assignee)
 			if err != nil {
-				return fmt.Errorf("failed to search for user: %w", err)
+				return nil, fmt.Errorf("failed to search for user: %w", err)
 			}
 			if len(users) == 0 {
-				return fmt.Errorf("user not found: %s", opts.Assignee)
+				return nil, fmt.Errorf("user not found: %s", opts.Assignee)
 			}
 			assigneeID = users[0].AccountID
 		}
 	}
 
+	var reporterID string
+	if opts.Reporter != "" {
+		id, _, err := resolveAssignee(ctx, jira, opts.Reporter)
+		if err != nil {
+			return nil, fmt.Errorf("reporter: %w", err)
+		}
+		if id == "" {
+			return nil, fmt.Errorf("reporter cannot be empty; pass a user or @me")
+		}
+		reporterID = id
+	}
+
 	// Auto-discover subtask type if --parent is provided but --type is not
 	issueTypeName := opts.IssueType
 	if opts.Parent != "" && opts.IssueType == "" {
 		subtaskType, err := jira.GetSubtaskType(ctx, opts.Project)
 		if err != nil {
-			return fmt.Errorf("failed to discover subtask type: %w", err)
+			return nil, fmt.Errorf("failed to discover subtask type: %w", err)
 		}
 		if subtaskType == nil {
-			return fmt.Errorf("no subtask type found for project %s\n\nUse 'atl issue types --project %s' to list available types", opts.Project, opts.Project)
+			return nil, fmt.Errorf("no subtask type found for project %s\n\nUse 'atl issue types --project %s' to list available types", opts.Project, opts.Project)
 		}
 		issueTypeName = subtaskType.Name
 	}
@@ -167,13 +445,21 @@ func runCreate(opts *CreateOptions) error {
 	}
 
 	if opts.Description != "" {
-		req.Fields.Description = api.TextToADF(opts.Description)
+		description, err := api.BodyToADF(opts.Description, opts.Format)
+		if err != nil {
+			return nil, err
+		}
+		req.Fields.Description = description
 	}
 
 	if assigneeID != "" {
 		req.Fields.Assignee = &api.AccountID{AccountID: assigneeID}
 	}
 
+	if reporterID != "" {
+		req.Fields.Reporter = &api.AccountID{AccountID: reporterID}
+	}
+
 	if opts.Priority != "" {
 		req.Fields.Priority = &api.PriorityID{Name: opts.Priority}
 	}
@@ -182,35 +468,44 @@ func runCreate(opts *CreateOptions) error {
 		req.Fields.Parent = &api.ParentID{Key: opts.Parent}
 	}
 
+	if opts.Due != "" {
+		due, err := ParseRelativeDate(opts.Due)
+		if err != nil {
+			return nil, fmt.Errorf("--due: %w", err)
+		}
+		req.Fields.DueDate = due.Format(time.DateOnly)
+	}
+
+	if opts.Security != "" {
+		level, err := resolveSecurityLevel(ctx, jira, opts.Project, opts.Security)
+		if err != nil {
+			return nil, err
+		}
+		req.Fields.Security = &api.SecurityLevelID{ID: level.ID}
+	}
+
 	// Parse custom fields from file first (if provided)
 	if opts.FieldFile != "" {
 		data, err := os.ReadFile(opts.FieldFile)
 		if err != nil {
-			return fmt.Errorf("failed to read field file: %w", err)
+			return nil, fmt.Errorf("failed to read field file: %w", err)
 		}
 
 		var fileFields map[string]interface{}
 		if err := json.Unmarshal(data, &fileFields); err != nil {
-			return fmt.Errorf("failed to parse field file as JSON: %w", err)
+			return nil, fmt.Errorf("failed to parse field file as JSON: %w", err)
 		}
 
-		req.Fields.CustomFields = make(map[string]interface{})
-		for key, value := range fileFields {
-			// Resolve field name to ID if needed
-			if !strings.HasPrefix(key, "customfield_") && !isSystemField(key) {
-				resolvedField, err := jira.GetFieldByName(ctx, key)
-				if err != nil {
-					return fmt.Errorf("failed to look up field '%s': %w", key, err)
-				}
-				if resolvedField == nil {
-					return fmt.Errorf("field not found: %s\n\nUse 'atl issue fields --search \"%s\"' to find available fields", key, key)
-				}
-				key = resolvedField.ID
-			}
-			req.Fields.CustomFields[key] = value
+		if err := mergeFieldValues(ctx, jira, req, fileFields); err != nil {
+			return nil, err
 		}
 	}
 
+	// Typed values from --from-file rows
+	if err := mergeFieldValues(ctx, jira, req, opts.FieldValues); err != nil {
+		return nil, err
+	}
+
 	// Parse custom fields from command line (override file values)
 	if len(opts.CustomFields) > 0 {
 		if req.Fields.CustomFields == nil {
@@ -219,38 +514,127 @@ func runCreate(opts *CreateOptions) error {
 		for _, field := range opts.CustomFields {
 			key, fieldValue, err := ParseCustomField(ctx, jira, field)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			req.Fields.CustomFields[key] = fieldValue
 		}
 	}
 
-	result, err := jira.CreateIssue(ctx, req)
+	if err := applyEpicName(ctx, jira, opts, issueTypeName, req); err != nil {
+		return nil, err
+	}
+
+	if err := api.ValidateADF(req.Fields.Description); err != nil {
+		return nil, fmt.Errorf("description: %w", err)
+	}
+	if err := validateADFFields(req.Fields.CustomFields); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// mergeFieldValues resolves field names to IDs and adds the values to req.
+func mergeFieldValues(ctx context.Context, jira *api.JiraService, req *api.CreateIssueRequest, values map[string]interface{}) error {
+	if len(values) == 0 {
+		return nil
+	}
+	if req.Fields.CustomFields == nil {
+		req.Fields.CustomFields = make(map[string]interface{})
+	}
+	for key, value := range values {
+		// Resolve field name to ID if needed
+		if !strings.HasPrefix(key, "customfield_") && !isSystemField(key) {
+			resolvedField, err := jira.GetFieldByName(ctx, key)
+			if err != nil {
+				return fmt.Errorf("failed to look up field '%s': %w", key, err)
+			}
+			if resolvedField == nil {
+				return fmt.Errorf("field not found: %s\n\nUse 'atl issue fields --search \"%s\"' to find available fields", key, key)
+			}
+			key = resolvedField.ID
+		}
+		req.Fields.CustomFields[key] = value
+	}
+	return nil
+}
+
+// epicNameFieldName is the company-managed custom field that older Jira
+// configurations still require when creating an epic.
+const epicNameFieldName = "Epic Name"
+
+// epicNameLookup is the part of the Jira service used to fill Epic Name.
+type epicNameLookup interface {
+	GetProjectIssueTypes(ctx context.Context, projectKey string) ([]*api.ProjectIssueType, error)
+	GetFieldOptions(ctx context.Context, projectKey, issueTypeID string) ([]*api.FieldMeta, error)
+}
+
+// applyEpicName fills the Epic Name field when creating an epic whose create
+// screen has one. The value comes from --epic-name, else the summary; a value
+// already set with --field or --field-file is kept. Epics are recognized by
+// their hierarchy level, so renamed or localized epic types are covered too.
+func applyEpicName(ctx context.Context, jira epicNameLookup, opts *CreateOptions, issueTypeName string, req *api.CreateIssueRequest) error {
+	types, err := jira.GetProjectIssueTypes(ctx, opts.Project)
 	if err != nil {
-		return fmt.Errorf("failed to create issue: %w", err)
+		return fmt.Errorf("failed to get issue types: %w", err)
+	}
+	issueType := findIssueType(types, issueTypeName)
+	if !isEpicType(issueType) {
+		if opts.EpicName != "" {
+			return fmt.Errorf("--epic-name can only be used when creating an epic")
+		}
+		return nil
 	}
 
-	createOutput := &CreateOutput{
-		Key:     result.Key,
-		ID:      result.ID,
-		Summary: opts.Summary,
-		Type:    opts.IssueType,
-		Project: opts.Project,
-		URL:     fmt.Sprintf("https://%s/browse/%s", client.Hostname(), result.Key),
+	metas, err := jira.GetFieldOptions(ctx, opts.Project, issueType.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get fields for %s: %w", issueType.Name, err)
+	}
+	field := findEpicNameField(metas)
+	if field == nil {
+		// Team-managed projects have no Epic Name; the summary is enough.
+		if opts.EpicName != "" {
+			return fmt.Errorf("--epic-name: the %q field is not on the create screen for %s in %s", epicNameFieldName, issueType.Name, opts.Project)
+		}
+		return nil
 	}
 
-	if opts.Web {
-		auth.OpenBrowser(createOutput.URL)
+	if _, ok := req.Fields.CustomFields[field.FieldID]; ok {
+		return nil
+	}
+	name := opts.EpicName
+	if name == "" {
+		name = opts.Summary
 	}
+	if req.Fields.CustomFields == nil {
+		req.Fields.CustomFields = make(map[string]interface{})
+	}
+	req.Fields.CustomFields[field.FieldID] = name
+	return nil
+}
 
-	if opts.JSON {
-		return output.JSON(opts.IO.Out, createOutput)
+// findIssueType returns the project issue type with the given name, ignoring case.
+func findIssueType(types []*api.ProjectIssueType, name string) *api.ProjectIssueType {
+	for _, t := range types {
+		if strings.EqualFold(t.Name, name) {
+			return t
+		}
 	}
+	return nil
+}
 
-	fmt.Fprintf(opts.IO.Out, "Created issue: %s\n", createOutput.Key)
-	fmt.Fprintf(opts.IO.Out, "Summary: %s\n", createOutput.Summary)
-	fmt.Fprintf(opts.IO.Out, "Type: %s\n", createOutput.Type)
-	fmt.Fprintf(opts.IO.Out, "URL: %s\n", createOutput.URL)
+// isEpicType reports whether an issue type is an epic. Epics sit one level
+// above standard issues in the hierarchy, whatever the type is called.
+func isEpicType(t *api.ProjectIssueType) bool {
+	return t != nil && t.HierarchyLevel == 1
+}
 
+// findEpicNameField returns the Epic Name field from create metadata, or nil.
+func findEpicNameField(metas []*api.FieldMeta) *api.FieldMeta {
+	for _, m := range metas {
+		if strings.EqualFold(m.Name, epicNameFieldName) {
+			return m
+		}
+	}
 	return nil
 }
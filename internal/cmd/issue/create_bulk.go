@@ -0,0 +1,297 @@
+package issue
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// bulkRow is a single issue parsed from a --from-file input.
+type bulkRow struct {
+	Row   int // 1-based row (CSV rows count the header line)
+	Issue *CreateOptions
+}
+
+// BulkCreateOutput represents the result of creating issues from a file.
+type BulkCreateOutput struct {
+	Total     int             `json:"total"`
+	DryRun    bool            `json:"dry_run"`
+	Created   []*CreateOutput `json:"created,omitempty"`
+	ValidRows []int           `json:"valid_rows,omitempty"`
+	Failed    []*BulkRowError `json:"failed,omitempty"`
+}
+
+// BulkRowError describes a row that could not be validated or created.
+type BulkRowError struct {
+	Row     int    `json:"row"`
+	Summary string `json:"summary,omitempty"`
+	Error   string `json:"error"`
+}
+
+func runCreateFromFile(ctx context.Context, opts *CreateOptions) error {
+	rows, err := parseBulkFile(opts.FromFile, opts)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("no rows found in %s", opts.FromFile)
+	}
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	bulkOutput := &BulkCreateOutput{
+		Total:  len(rows),
+		DryRun: opts.DryRun,
+	}
+
+	fail := func(row *bulkRow, err error) {
+		bulkOutput.Failed = append(bulkOutput.Failed, &BulkRowError{
+			Row:     row.Row,
+			Summary: row.Issue.Summary,
+			Error:   err.Error(),
+		})
+		if !opts.JSON {
+			fmt.Fprintf(opts.IO.Out, "Row %d: failed: %s\n", row.Row, err)
+		}
+	}
+
+	for _, row := range rows {
+		row.Issue.Assignee = createAssignee(row.Issue.Assignee, opts.AssignMe)
+		if missing := missingCreateFields(row.Issue); len(missing) > 0 {
+			fail(row, fmt.Errorf("missing required fields: %s", strings.Join(missing, ", ")))
+			continue
+		}
+
+		req, err := buildCreateRequest(ctx, jira, row.Issue)
+		if err != nil {
+			fail(row, err)
+			continue
+		}
+
+		if opts.DryRun {
+			bulkOutput.ValidRows = append(bulkOutput.ValidRows, row.Row)
+			if !opts.JSON {
+				fmt.Fprintf(opts.IO.Out, "Row %d: OK - %s\n", row.Row, row.Issue.Summary)
+			}
+			continue
+		}
+
+		result, err := jira.CreateIssue(ctx, req)
+		if err != nil {
+			fail(row, fmt.Errorf("failed to create issue: %w", explainReporterError(err, row.Issue.Reporter != "")))
+			continue
+		}
+
+		created := &CreateOutput{
+			Key:     result.Key,
+			ID:      result.ID,
+			Summary: row.Issue.Summary,
+			Type:    row.Issue.IssueType,
+			Project: row.Issue.Project,
+			URL:     client.BrowseURL(result.Key),
+		}
+		bulkOutput.Created = append(bulkOutput.Created, created)
+		if !opts.JSON {
+			fmt.Fprintf(opts.IO.Out, "Row %d: created %s - %s\n", row.Row, created.Key, created.Summary)
+		}
+	}
+
+	if opts.JSON {
+		if err := output.PrintJSON(opts.IO, bulkOutput); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintln(opts.IO.Out)
+		if opts.DryRun {
+			fmt.Fprintf(opts.IO.Out, "Validated %d rows: %d valid, %d invalid. No issues were created.\n",
+				bulkOutput.Total, len(bulkOutput.ValidRows), len(bulkOutput.Failed))
+		} else {
+			fmt.Fprintf(opts.IO.Out, "Created %d of %d issues (%d failed)\n",
+				len(bulkOutput.Created), bulkOutput.Total, len(bulkOutput.Failed))
+		}
+	}
+
+	if len(bulkOutput.Failed) > 0 {
+		return fmt.Errorf("%d of %d rows failed", len(bulkOutput.Failed), bulkOutput.Total)
+	}
+	return nil
+}
+
+// missingCreateFields returns the required flags/columns a row lacks.
+func missingCreateFields(opts *CreateOptions) []string {
+	var missing []string
+	if opts.Project == "" {
+		missing = append(missing, "project")
+	}
+	// type is optional if parent is provided (auto-discovers subtask type)
+	if opts.IssueType == "" && opts.Parent == "" {
+		missing = append(missing, "type")
+	}
+	if opts.Summary == "" {
+		missing = append(missing, "summary")
+	}
+	return missing
+}
+
+// parseBulkFile reads issues from a .csv or .json file. Values not set in a
+// row fall back to defaults (e.g. --project, --type, --label).
+func parseBulkFile(path string, defaults *CreateOptions) ([]*bulkRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return parseBulkCSV(f, defaults)
+	case ".json":
+		return parseBulkJSON(f, defaults)
+	default:
+		return nil, fmt.Errorf("unsupported file type: %s (expected .csv or .json)", filepath.Ext(path))
+	}
+}
+
+// parseBulkCSV parses a CSV file whose first line is a header row.
+func parseBulkCSV(r io.Reader, defaults *CreateOptions) ([]*bulkRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	rows := make([]*bulkRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		issue := newBulkIssue(defaults)
+		for col, value := range record {
+			if col >= len(header) {
+				break
+			}
+			if err := applyBulkValue(issue, header[col], value); err != nil {
+				return nil, fmt.Errorf("row %d: %w", i+2, err)
+			}
+		}
+		rows = append(rows, &bulkRow{Row: i + 2, Issue: issue})
+	}
+
+	return rows, nil
+}
+
+// parseBulkJSON parses a JSON array of objects.
+func parseBulkJSON(r io.Reader, defaults *CreateOptions) ([]*bulkRow, error) {
+	var objects []map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&objects); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON (expected an array of objects): %w", err)
+	}
+
+	rows := make([]*bulkRow, 0, len(objects))
+	for i, obj := range objects {
+		issue := newBulkIssue(defaults)
+		for key, value := range obj {
+			if err := applyBulkValue(issue, key, value); err != nil {
+				return nil, fmt.Errorf("row %d: %w", i+1, err)
+			}
+		}
+		rows = append(rows, &bulkRow{Row: i + 1, Issue: issue})
+	}
+
+	return rows, nil
+}
+
+// newBulkIssue returns row options seeded from the command-line defaults.
+func newBulkIssue(defaults *CreateOptions) *CreateOptions {
+	return &CreateOptions{
+		IO:          defaults.IO,
+		Project:     defaults.Project,
+		IssueType:   defaults.IssueType,
+		Priority:    defaults.Priority,
+		Parent:      defaults.Parent,
+		Format:      defaults.Format,
+		Labels:      append([]string(nil), defaults.Labels...),
+		FieldValues: make(map[string]interface{}),
+	}
+}
+
+// applyBulkValue sets a single column/key on the row options.
+// Unknown keys are treated as custom fields (by name or ID).
+func applyBulkValue(issue *CreateOptions, key string, value interface{}) error {
+	key = strings.TrimSpace(key)
+	if key == "" || value == nil {
+		return nil
+	}
+
+	if s, ok := value.(string); ok {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return nil
+		}
+		switch strings.ToLower(key) {
+		case "summary":
+			issue.Summary = s
+		case "type", "issuetype", "issue type":
+			issue.IssueType = s
+		case "project":
+			issue.Project = s
+		case "description":
+			issue.Description = s
+		case "assignee":
+			issue.Assignee = s
+		case "reporter":
+			issue.Reporter = s
+		case "priority":
+			issue.Priority = s
+		case "parent":
+			issue.Parent = s
+		case "labels", "label":
+			for _, label := range strings.Split(s, ",") {
+				if label = strings.TrimSpace(label); label != "" {
+					issue.Labels = append(issue.Labels, label)
+				}
+			}
+		default:
+			// Let ParseCustomField coerce the string by field schema
+			issue.CustomFields = append(issue.CustomFields, key+"="+s)
+		}
+		return nil
+	}
+
+	switch strings.ToLower(key) {
+	case "labels", "label":
+		list, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("labels must be a string or an array of strings")
+		}
+		for _, item := range list {
+			label, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("labels must be a string or an array of strings")
+			}
+			issue.Labels = append(issue.Labels, label)
+		}
+	case "summary", "type", "issuetype", "issue type", "project", "description", "assignee", "reporter", "priority", "parent":
+		return fmt.Errorf("%s must be a string", key)
+	default:
+		// Structured JSON values (numbers, objects, ADF) are passed through as-is
+		issue.FieldValues[key] = value
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package issue
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+	return path
+}
+
+func TestParseBulkFileCSV(t *testing.T) {
+	path := writeTempFile(t, "backlog.csv", `summary,type,labels,Story Points,assignee
+First task,Task,"a, b",5,@me
+Second bug,Bug,,,
+`)
+
+	rows, err := parseBulkFile(path, &CreateOptions{Project: "PROJ", Labels: []string{"imported"}})
+	if err != nil {
+		t.Fatalf("parseBulkFile() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+
+	first := rows[0]
+	if first.Row != 2 {
+		t.Errorf("first row number = %d, want 2 (header is row 1)", first.Row)
+	}
+	if first.Issue.Project != "PROJ" {
+		t.Errorf("Project = %q, want default %q", first.Issue.Project, "PROJ")
+	}
+	if first.Issue.Summary != "First task" || first.Issue.IssueType != "Task" || first.Issue.Assignee != "@me" {
+		t.Errorf("unexpected first row: %+v", first.Issue)
+	}
+	if want := []string{"imported", "a", "b"}; !reflect.DeepEqual(first.Issue.Labels, want) {
+		t.Errorf("Labels = %v, want %v", first.Issue.Labels, want)
+	}
+	if want := []string{"Story Points=5"}; !reflect.DeepEqual(first.Issue.CustomFields, want) {
+		t.Errorf("CustomFields = %v, want %v", first.Issue.CustomFields, want)
+	}
+
+	second := rows[1]
+	if second.Issue.IssueType != "Bug" || len(second.Issue.CustomFields) != 0 {
+		t.Errorf("unexpected second row: %+v", second.Issue)
+	}
+}
+
+func TestParseBulkFileJSON(t *testing.T) {
+	path := writeTempFile(t, "backlog.json", `[
+  {"summary": "Story one", "type": "Story", "project": "OTHER", "labels": ["x", "y"], "Story Points": 8},
+  {"summary": "Missing type"}
+]`)
+
+	rows, err := parseBulkFile(path, &CreateOptions{Project: "PROJ"})
+	if err != nil {
+		t.Fatalf("parseBulkFile() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+
+	first := rows[0].Issue
+	if first.Project != "OTHER" {
+		t.Errorf("Project = %q, want row override %q", first.Project, "OTHER")
+	}
+	if want := []string{"x", "y"}; !reflect.DeepEqual(first.Labels, want) {
+		t.Errorf("Labels = %v, want %v", first.Labels, want)
+	}
+	if first.FieldValues["Story Points"] != float64(8) {
+		t.Errorf("FieldValues[Story Points] = %v, want 8", first.FieldValues["Story Points"])
+	}
+
+	if missing := missingCreateFields(rows[1].Issue); !reflect.DeepEqual(missing, []string{"type"}) {
+		t.Errorf("missingCreateFields() = %v, want [type]", missing)
+	}
+}
+
+func TestParseBulkFileUnsupported(t *testing.T) {
+	path := writeTempFile(t, "backlog.txt", "summary\n")
+	if _, err := parseBulkFile(path, &CreateOptions{}); err == nil {
+		t.Error("parseBulkFile() should reject unsupported extensions")
+	}
+}
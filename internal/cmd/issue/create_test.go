@@ -0,0 +1,299 @@
+package issue
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/config"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func TestEpicTypeDetection(t *testing.T) {
+	types := []*api.ProjectIssueType{
+		{ID: "1", Name: "Task", HierarchyLevel: 0},
+		{ID: "2", Name: "Initiative", HierarchyLevel: 1}, // renamed epic
+		{ID: "3", Name: "Sub-task", Subtask: true, HierarchyLevel: -1},
+	}
+
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"Task", false},
+		{"initiative", true},
+		{"Sub-task", false},
+		{"Unknown", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isEpicType(findIssueType(types, tt.name)); got != tt.want {
+				t.Errorf("isEpicType(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeEpicNameLookup serves issue types and create metadata for applyEpicName.
+type fakeEpicNameLookup struct {
+	types  []*api.ProjectIssueType
+	metas  map[string][]*api.FieldMeta
+	lookup []string
+}
+
+func (f *fakeEpicNameLookup) GetProjectIssueTypes(ctx context.Context, projectKey string) ([]*api.ProjectIssueType, error) {
+	return f.types, nil
+}
+
+func (f *fakeEpicNameLookup) GetFieldOptions(ctx context.Context, projectKey, issueTypeID string) ([]*api.FieldMeta, error) {
+	f.lookup = append(f.lookup, issueTypeID)
+	return f.metas[issueTypeID], nil
+}
+
+func TestApplyEpicNameRenamedEpicType(t *testing.T) {
+	jira := &fakeEpicNameLookup{
+		types: []*api.ProjectIssueType{
+			{ID: "1", Name: "Task", HierarchyLevel: 0},
+			{ID: "2", Name: "Épica", HierarchyLevel: 1},
+		},
+		metas: map[string][]*api.FieldMeta{
+			"2": {{Name: "Epic Name", FieldID: "customfield_10011", Required: true}},
+		},
+	}
+
+	opts := &CreateOptions{Project: "PROJ", Summary: "Checkout redesign"}
+	req := &api.CreateIssueRequest{}
+	if err := applyEpicName(context.Background(), jira, opts, "épica", req); err != nil {
+		t.Fatalf("applyEpicName() error = %v", err)
+	}
+	if got := req.Fields.CustomFields["customfield_10011"]; got != "Checkout redesign" {
+		t.Errorf("Epic Name = %v, want the summary", got)
+	}
+
+	req = &api.CreateIssueRequest{}
+	if err := applyEpicName(context.Background(), jira, opts, "Task", req); err != nil {
+		t.Fatalf("applyEpicName() error = %v", err)
+	}
+	if len(req.Fields.CustomFields) != 0 || !slices.Equal(jira.lookup, []string{"2"}) {
+		t.Errorf("a Task got %v and looked up create metadata for %v", req.Fields.CustomFields, jira.lookup)
+	}
+}
+
+func TestFindEpicNameField(t *testing.T) {
+	metas := []*api.FieldMeta{
+		{Name: "Summary", FieldID: "summary"},
+		{Name: "Epic Name", FieldID: "customfield_10011", Required: true},
+	}
+	if got := findEpicNameField(metas); got == nil || got.FieldID != "customfield_10011" {
+		t.Errorf("findEpicNameField() = %+v, want customfield_10011", got)
+	}
+	if got := findEpicNameField(metas[:1]); got != nil {
+		t.Errorf("findEpicNameField() = %+v, want nil when the screen has no Epic Name", got)
+	}
+}
+
+func TestCreateAssignee(t *testing.T) {
+	tests := []struct {
+		assignee   string
+		assignSelf bool
+		want       string
+	}{
+		{"", false, ""}, // Jira's default assignee
+		{"", true, "@me"},
+		{"jane@example.com", true, "jane@example.com"},
+		{"@me", false, "@me"},
+	}
+
+	for _, tt := range tests {
+		if got := createAssignee(tt.assignee, tt.assignSelf); got != tt.want {
+			t.Errorf("createAssignee(%q, %v) = %q, want %q", tt.assignee, tt.assignSelf, got, tt.want)
+		}
+	}
+}
+
+func TestAssignSelfConfigured(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(config.ConfigFileEnv, path)
+
+	if assignSelfConfigured() {
+		t.Error("assign self should be off without a config file")
+	}
+
+	if err := os.WriteFile(path, []byte("version: 1\ncreate:\n  assign_self: true\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if !assignSelfConfigured() {
+		t.Error("assign self should be on with create.assign_self: true")
+	}
+}
+
+func TestResolveAssignSelf(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(config.ConfigFileEnv, path)
+	if err := os.WriteFile(path, []byte("version: 1\ncreate:\n  assign_self: true\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{nil, true}, // create.assign_self
+		{[]string{"--assign-me"}, true},
+		{[]string{"--assign-me=false"}, false},
+	}
+
+	for _, tt := range tests {
+		cmd := NewCmdCreate(iostreams.Test())
+		if err := cmd.Flags().Parse(tt.args); err != nil {
+			t.Fatal(err)
+		}
+		assignMe, _ := cmd.Flags().GetBool("assign-me")
+		if got := resolveAssignSelf(cmd, assignMe); got != tt.want {
+			t.Errorf("resolveAssignSelf(%v) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestExplainReporterError(t *testing.T) {
+	forbidden := &api.APIError{StatusCode: http.StatusForbidden}
+	fieldError := &api.APIError{StatusCode: http.StatusBadRequest, Body: `{"errors":{"reporter":"Field 'reporter' cannot be set."}}`}
+	otherError := &api.APIError{StatusCode: http.StatusBadRequest, Body: `{"errors":{"summary":"required"}}`}
+
+	tests := []struct {
+		name        string
+		err         error
+		reporterSet bool
+		wantHint    bool
+	}{
+		{"forbidden with reporter", forbidden, true, true},
+		{"reporter field error", fieldError, true, true},
+		{"forbidden without reporter", forbidden, false, false},
+		{"unrelated field error", otherError, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := explainReporterError(tt.err, tt.reporterSet)
+			if hasHint := strings.Contains(got.Error(), "Modify Reporter"); hasHint != tt.wantHint {
+				t.Errorf("explainReporterError() = %q, want hint: %v", got, tt.wantHint)
+			}
+			if !errors.Is(got, tt.err) {
+				t.Error("explainReporterError() should wrap the original error")
+			}
+		})
+	}
+}
+
+func TestAddCreateComment(t *testing.T) {
+	t.Run("comment added to the created issue", func(t *testing.T) {
+		var gotKey, gotBody string
+		addComment := func(ctx context.Context, key, body string) (*api.Comment, error) {
+			gotKey, gotBody = key, body
+			return &api.Comment{ID: "10500"}, nil
+		}
+
+		createOutput := &CreateOutput{Key: "PROJ-42"}
+		addCreateComment(context.Background(), addComment, createOutput, "First!")
+
+		if gotKey != "PROJ-42" || gotBody != "First!" {
+			t.Errorf("AddComment(%q, %q), want (PROJ-42, First!)", gotKey, gotBody)
+		}
+		if createOutput.CommentID != "10500" || createOutput.CommentError != "" {
+			t.Errorf("CommentID = %q, CommentError = %q", createOutput.CommentID, createOutput.CommentError)
+		}
+	})
+
+	t.Run("failed comment keeps the created issue", func(t *testing.T) {
+		addComment := func(ctx context.Context, key, body string) (*api.Comment, error) {
+			return nil, &api.APIError{StatusCode: http.StatusForbidden, Status: "403 Forbidden"}
+		}
+
+		createOutput := &CreateOutput{Key: "PROJ-42", URL: "https://example.atlassian.net/browse/PROJ-42"}
+		addCreateComment(context.Background(), addComment, createOutput, "First!")
+
+		if createOutput.CommentID != "" {
+			t.Errorf("CommentID = %q, want empty", createOutput.CommentID)
+		}
+		if createOutput.CommentError == "" {
+			t.Error("CommentError is empty, want the comment error")
+		}
+		if createOutput.Key != "PROJ-42" || createOutput.URL == "" {
+			t.Errorf("created issue details lost: %+v", createOutput)
+		}
+	})
+}
+
+func TestAddCreateWatchers(t *testing.T) {
+	resolve := func(ctx context.Context, user string) (string, string, error) {
+		switch user {
+		case "@me":
+			return "acc-me", "Me Myself", nil
+		case "jane@example.com":
+			return "acc-jane", "Jane Doe", nil
+		case "locked@example.com":
+			return "acc-locked", "Locked Out", nil
+		case "none":
+			return "", "Unassigned", nil
+		}
+		return "", "", errors.New("user not found: " + user)
+	}
+
+	var added []string
+	addWatcher := func(ctx context.Context, key, accountID string) error {
+		if key != "PROJ-42" {
+			t.Errorf("AddWatcher key = %q, want PROJ-42", key)
+		}
+		if accountID == "acc-locked" {
+			return &api.APIError{StatusCode: http.StatusForbidden, Status: "403 Forbidden"}
+		}
+		added = append(added, accountID)
+		return nil
+	}
+
+	createOutput := &CreateOutput{Key: "PROJ-42"}
+	addCreateWatchers(context.Background(), resolve, addWatcher, createOutput,
+		[]string{"@me", "nobody", "locked@example.com", "none", "jane@example.com"})
+
+	if want := []string{"acc-me", "acc-jane"}; !slices.Equal(added, want) {
+		t.Errorf("AddWatcher calls = %v, want %v", added, want)
+	}
+	if want := []string{"Me Myself", "Jane Doe"}; !slices.Equal(createOutput.WatchersAdded, want) {
+		t.Errorf("WatchersAdded = %v, want %v", createOutput.WatchersAdded, want)
+	}
+	if len(createOutput.WatcherErrors) != 3 {
+		t.Fatalf("WatcherErrors = %q, want 3 errors", createOutput.WatcherErrors)
+	}
+	for i, prefix := range []string{"nobody: ", "locked@example.com: ", "none: "} {
+		if !strings.HasPrefix(createOutput.WatcherErrors[i], prefix) {
+			t.Errorf("WatcherErrors[%d] = %q, want prefix %q", i, createOutput.WatcherErrors[i], prefix)
+		}
+	}
+}
+
+func TestPrintDescriptionADF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := printDescriptionADF(&buf, api.TextToADF("**bold** text"), 2); err != nil {
+		t.Fatalf("printDescriptionADF() error = %v", err)
+	}
+	got := buf.String()
+	if !strings.HasPrefix(got, "Description ADF:\n{") || !strings.Contains(got, `"type": "strong"`) {
+		t.Errorf("printDescriptionADF() = %q, want the ADF with a strong mark", got)
+	}
+
+	buf.Reset()
+	if err := printDescriptionADF(&buf, nil, 2); err != nil {
+		t.Fatalf("printDescriptionADF(nil) error = %v", err)
+	}
+	if got := buf.String(); got != "Description ADF: (no description)\n" {
+		t.Errorf("printDescriptionADF(nil) = %q", got)
+	}
+}
@@ -0,0 +1,65 @@
+package issue
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// relativeDateHelp describes the values ParseRelativeDate accepts.
+const relativeDateHelp = "YYYY-MM-DD, today, tomorrow, yesterday, +Nd, -Nd, +Nw, -Nw, or next <weekday>"
+
+// ParseRelativeDate parses an absolute (YYYY-MM-DD) or relative date such as
+// today, tomorrow, +3d, +2w or "next friday", relative to the local date.
+// Format the result with time.DateOnly for Jira date fields.
+func ParseRelativeDate(s string) (time.Time, error) {
+	return parseRelativeDate(s, time.Now())
+}
+
+func parseRelativeDate(s string, now time.Time) (time.Time, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch value {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	if date, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return date, nil
+	}
+
+	if weekday, ok := strings.CutPrefix(value, "next "); ok {
+		for d := time.Sunday; d <= time.Saturday; d++ {
+			if strings.ToLower(d.String()) == strings.TrimSpace(weekday) {
+				days := (int(d) - int(today.Weekday()) + 7) % 7
+				if days == 0 {
+					days = 7
+				}
+				return today.AddDate(0, 0, days), nil
+			}
+		}
+	}
+
+	if len(value) >= 3 && (value[0] == '+' || value[0] == '-') {
+		n, err := strconv.Atoi(value[1 : len(value)-1])
+		if err == nil && value[1] >= '0' && value[1] <= '9' {
+			if value[0] == '-' {
+				n = -n
+			}
+			switch value[len(value)-1] {
+			case 'd':
+				return today.AddDate(0, 0, n), nil
+			case 'w':
+				return today.AddDate(0, 0, 7*n), nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q (use %s)", s, relativeDateHelp)
+}
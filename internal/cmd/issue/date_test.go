@@ -0,0 +1,50 @@
+package issue
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRelativeDate(t *testing.T) {
+	// A Wednesday, late in the day so a UTC conversion would change the date.
+	now := time.Date(2026, time.March, 4, 23, 30, 0, 0, time.FixedZone("UTC-5", -5*3600))
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"today", "2026-03-04"},
+		{"Tomorrow", "2026-03-05"},
+		{"yesterday", "2026-03-03"},
+		{"+3d", "2026-03-07"},
+		{"+30d", "2026-04-03"},
+		{"-1d", "2026-03-03"},
+		{"+2w", "2026-03-18"},
+		{"-1w", "2026-02-25"},
+		{"next friday", "2026-03-06"},
+		{"next wednesday", "2026-03-11"},
+		{" 2026-12-24 ", "2026-12-24"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseRelativeDate(tt.input, now)
+			if err != nil {
+				t.Fatalf("parseRelativeDate(%q) error: %v", tt.input, err)
+			}
+			if s := got.Format(time.DateOnly); s != tt.want {
+				t.Errorf("parseRelativeDate(%q) = %s, want %s", tt.input, s, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRelativeDateInvalid(t *testing.T) {
+	now := time.Date(2026, time.March, 4, 12, 0, 0, 0, time.UTC)
+
+	for _, input := range []string{"", "soon", "+d", "3d", "+3m", "+-3d", "next someday", "2026-13-01"} {
+		if _, err := parseRelativeDate(input, now); err == nil {
+			t.Errorf("parseRelativeDate(%q) succeeded, want error", input)
+		}
+	}
+}
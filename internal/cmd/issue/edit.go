@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -20,26 +21,41 @@ type EditOptions struct {
 	IssueKey     string
 	Summary      string
 	Description  string
+	Format       string
 	Append       bool
+	Separator    string
 	Assignee     string
 	AddLabels    []string
 	RemoveLabels []string
 	Priority     string
+	Security     string
+	Due          string
 	CustomFields []string
+	UnsetFields  []string
 	FieldFile    string
+	Preview      bool
+	Yes          bool
+	NoNotify     bool
 	JSON         bool
 }
 
 // NewCmdEdit creates the edit command.
 func NewCmdEdit(ios *iostreams.IOStreams) *cobra.Command {
 	opts := &EditOptions{
-		IO: ios,
+		IO:        ios,
+		Separator: appendSeparatorRule,
 	}
 
 	cmd := &cobra.Command{
 		Use:   "edit <issue-key>",
 		Short: "Edit a Jira issue",
-		Long:  `Edit fields of an existing Jira issue.`,
+		Long: `Edit fields of an existing Jira issue.
+
+When the description is changed from an interactive terminal, a diff of the
+current and new description is shown and the edit is applied only after you
+confirm. Use --yes to skip the confirmation. With --preview the diff is shown
+even when not in a terminal; without a terminal to confirm, nothing is
+changed unless --yes is also given.`,
 		Example: `  # Edit issue summary
   atl issue edit PROJ-1234 --summary "Updated summary"
 
@@ -49,6 +65,18 @@ func NewCmdEdit(ios *iostreams.IOStreams) *cobra.Command {
   # Append to existing description (preserves embedded media)
   atl issue edit PROJ-1234 --description "Additional notes" --append
 
+  # Append with a blank paragraph instead of a horizontal rule between old and new
+  atl issue edit PROJ-1234 --description "Update: fixed in 2.3" --append --append-separator blank
+
+  # Description in Jira wiki markup instead of Markdown
+  atl issue edit PROJ-1234 --format wiki --description "h2. Workaround
+{code:bash}
+systemctl restart sync
+{code}"
+
+  # Review the description change in a script without applying it
+  atl issue edit PROJ-1234 --description "Rewritten" --preview < /dev/null
+
   # Add labels
   atl issue edit PROJ-1234 --add-label bug --add-label urgent
 
@@ -61,35 +89,72 @@ func NewCmdEdit(ios *iostreams.IOStreams) *cobra.Command {
   # Change priority
   atl issue edit PROJ-1234 --priority High
 
+  # Set the due date relative to today (none to clear it)
+  atl issue edit PROJ-1234 --due "next friday"
+
+  # Restrict visibility with a security level (none to clear it)
+  atl issue edit PROJ-1234 --security-level "Internal"
+
   # Set custom fields by name (Story Points, etc.)
   atl issue edit PROJ-1234 --field "Story Points=8"
 
   # Or use field ID directly
   atl issue edit PROJ-1234 --field customfield_10016=8
 
+  # Clear a field (null for single values, empty for multi-value fields)
+  atl issue edit PROJ-1234 --unset-field "Story Points" --unset-field Teams
+
   # Use a JSON file for complex field values (like ADF rich text)
   atl issue edit PROJ-1234 --field-file fields.json
 
+  # Edit without emailing watchers (project admins only)
+  atl issue edit PROJ-1234 --add-label triaged --no-notify
+
   # Output result as JSON
   atl issue edit PROJ-1234 --summary "New summary" --json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
-			return runEdit(opts)
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			if cmd.Flags().Changed("append-separator") && !opts.Append {
+				return fmt.Errorf("--append-separator requires --append")
+			}
+			if err := validateAppendSeparator(opts.Separator); err != nil {
+				return err
+			}
+			if err := api.CheckBodyFormat(opts.Format); err != nil {
+				return err
+			}
+			return runEdit(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().StringVarP(&opts.Summary, "summary", "s", "", "New summary")
 	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "New description")
+	cmd.Flags().StringVar(&opts.Format, "format", api.FormatMarkdown, "Text format of --description: markdown or wiki (Jira wiki markup)")
 	cmd.Flags().BoolVar(&opts.Append, "append", false, "Append to existing description instead of replacing")
+	cmd.Flags().StringVar(&opts.Separator, "append-separator", appendSeparatorRule, "Separator between existing and appended description: rule, blank, or none")
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "New assignee (use @me for yourself, empty to unassign)")
 	cmd.Flags().StringSliceVar(&opts.AddLabels, "add-label", nil, "Labels to add")
 	cmd.Flags().StringSliceVar(&opts.RemoveLabels, "remove-label", nil, "Labels to remove")
 	cmd.Flags().StringVar(&opts.Priority, "priority", "", "New priority")
+	cmd.Flags().StringVar(&opts.Security, "security-level", "", "New issue security level name (none to clear)")
+	cmd.Flags().StringVar(&opts.Due, "due", "", "New due date: YYYY-MM-DD, relative (today, tomorrow, +3d, +2w), or none to clear")
 	cmd.Flags().StringSliceVarP(&opts.CustomFields, "field", "f", nil, "Custom field in key=value format (can be repeated)")
+	cmd.Flags().StringArrayVar(&opts.UnsetFields, "unset-field", nil, "Clear a field by name or ID (can be repeated)")
 	cmd.Flags().StringVar(&opts.FieldFile, "field-file", "", "JSON file with field values (for complex types like ADF)")
+	cmd.Flags().BoolVar(&opts.Preview, "preview", false, "Show a diff of the description change before applying it")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Apply the description change without asking for confirmation")
+	cmd.Flags().BoolVar(&opts.NoNotify, "no-notify", false, noNotifyFlagUsage)
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
 
+	// --json skips the description preview, so the default output format must
+	// not set it.
+	output.MarkConfirms(cmd)
+
 	return cmd
 }
 
@@ -102,11 +167,11 @@ type EditOutput struct {
 	URL           string   `json:"url"`
 }
 
-func runEdit(opts *EditOptions) error {
+func runEdit(ctx context.Context, opts *EditOptions) error {
 	// Check that at least one field is being edited
 	if opts.Summary == "" && opts.Description == "" && opts.Assignee == "" &&
 		len(opts.AddLabels) == 0 && len(opts.RemoveLabels) == 0 && opts.Priority == "" &&
-		len(opts.CustomFields) == 0 && opts.FieldFile == "" {
+		opts.Security == "" && opts.Due == "" && len(opts.CustomFields) == 0 && len(opts.UnsetFields) == 0 && opts.FieldFile == "" {
 		return fmt.Errorf("at least one field must be specified to edit")
 	}
 
@@ -120,13 +185,12 @@ func runEdit(opts *EditOptions) error {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	editOutput := &EditOutput{
 		Key:           opts.IssueKey,
 		FieldsUpdated: []string{},
-		URL:           fmt.Sprintf("https://%s/browse/%s", client.Hostname(), opts.IssueKey),
+		URL:           client.BrowseURL(opts.IssueKey),
 	}
 
 	// Build update request
@@ -140,21 +204,29 @@ func runEdit(opts *EditOptions) error {
 		editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, "summary")
 	}
 
+	// The description diff, shown before anything is changed
+	var descriptionPreview []string
+	preview := opts.Description != "" && (opts.Preview || (!opts.JSON && !opts.Yes && opts.IO.IsStdinTTY && opts.IO.IsStdoutTTY))
+
 	if opts.Description != "" {
-		newADF := api.TextToADF(opts.Description)
+		newADF, err := api.BodyToADF(opts.Description, opts.Format)
+		if err != nil {
+			return err
+		}
 
-		if opts.Append {
+		if opts.Append || preview {
 			// Fetch existing issue to get current description
 			issue, err := jira.GetIssue(ctx, opts.IssueKey)
 			if err != nil {
 				return fmt.Errorf("failed to fetch existing issue: %w", err)
 			}
 
-			// Merge existing and new description content
-			if issue.Fields.Description != nil && len(issue.Fields.Description.Content) > 0 {
-				// Append new content to existing content
-				mergedContent := append(issue.Fields.Description.Content, newADF.Content...)
-				newADF.Content = mergedContent
+			if opts.Append {
+				newADF = appendDescription(issue.Fields.Description, newADF, opts.Separator)
+			}
+
+			if preview {
+				descriptionPreview = descriptionDiff(opts.IssueKey, api.ADFToText(issue.Fields.Description), api.ADFToText(newADF))
 			}
 		}
 
@@ -167,27 +239,43 @@ func runEdit(opts *EditOptions) error {
 		editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, "priority")
 	}
 
-	// Handle labels
-	if len(opts.AddLabels) > 0 {
-		var ops []api.UpdateOp
-		for _, label := range opts.AddLabels {
-			ops = append(ops, api.UpdateOp{Add: label})
+	if opts.Security == "none" {
+		req.Fields["security"] = nil
+		editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, "security")
+	} else if opts.Security != "" {
+		issue, err := jira.GetIssueWithOptions(ctx, opts.IssueKey, api.GetIssueOptions{Fields: []string{"project"}})
+		if err != nil {
+			return fmt.Errorf("failed to fetch existing issue: %w", err)
 		}
-		req.Update["labels"] = ops
-		editOutput.LabelsAdded = opts.AddLabels
-		editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, "labels")
+		if issue.Fields.Project == nil {
+			return fmt.Errorf("could not determine the project of %s", opts.IssueKey)
+		}
+		level, err := resolveSecurityLevel(ctx, jira, issue.Fields.Project.Key, opts.Security)
+		if err != nil {
+			return err
+		}
+		req.Fields["security"] = map[string]string{"id": level.ID}
+		editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, "security")
 	}
 
-	if len(opts.RemoveLabels) > 0 {
-		ops := req.Update["labels"]
-		for _, label := range opts.RemoveLabels {
-			ops = append(ops, api.UpdateOp{Remove: label})
+	if opts.Due == "none" {
+		req.Fields["duedate"] = nil
+		editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, "duedate")
+	} else if opts.Due != "" {
+		due, err := ParseRelativeDate(opts.Due)
+		if err != nil {
+			return fmt.Errorf("--due: %w", err)
 		}
+		req.Fields["duedate"] = due.Format(time.DateOnly)
+		editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, "duedate")
+	}
+
+	// Handle labels
+	if ops := labelUpdateOps(opts.AddLabels, opts.RemoveLabels); len(ops) > 0 {
 		req.Update["labels"] = ops
+		editOutput.LabelsAdded = opts.AddLabels
 		editOutput.LabelsRemoved = opts.RemoveLabels
-		if len(opts.AddLabels) == 0 {
-			editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, "labels")
-		}
+		editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, "labels")
 	}
 
 	// Parse custom fields from file first (if provided)
@@ -229,34 +317,39 @@ func runEdit(opts *EditOptions) error {
 		editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, key)
 	}
 
+	for _, name := range opts.UnsetFields {
+		key, clearValue, err := ParseUnsetField(ctx, jira, name)
+		if err != nil {
+			return err
+		}
+		if _, ok := req.Fields[key]; ok {
+			return fmt.Errorf("field %s is both set and unset", name)
+		}
+		req.Fields[key] = clearValue
+		editOutput.FieldsUpdated = append(editOutput.FieldsUpdated, key)
+	}
+
+	if err := validateADFFields(req.Fields); err != nil {
+		return err
+	}
+
+	if preview && !confirmDescriptionChange(opts, descriptionPreview) {
+		return nil
+	}
+
 	// Update the issue fields first
 	if len(req.Fields) > 0 || len(req.Update) > 0 {
-		if err := jira.UpdateIssue(ctx, opts.IssueKey, req); err != nil {
-			return fmt.Errorf("failed to update issue: %w", err)
+		err := jira.UpdateIssueWithOptions(ctx, opts.IssueKey, req, api.NotifyOptions{Notify: !opts.NoNotify})
+		if err != nil {
+			return fmt.Errorf("failed to update issue: %w", explainNoNotifyError(err, opts.NoNotify))
 		}
 	}
 
 	// Handle assignee separately (uses different endpoint)
 	if opts.Assignee != "" {
-		var accountID string
-		switch opts.Assignee {
-		case "@me":
-			user, err := jira.GetMyself(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to get current user: %w", err)
-			}
-			accountID = user.AccountID
-		case "-", "none":
-			accountID = "" // Unassign
-		default:
-			users, err := jira.SearchUsers(ctx, opts.Assignee)
-			if err != nil {
-				return fmt.Errorf("failed to search for user: %w", err)
-			}
-			if len(users) == 0 {
-				return fmt.Errorf("user not found: %s", opts.Assignee)
-			}
-			accountID = users[0].AccountID
+		accountID, _, err := resolveAssignee(ctx, jira, opts.Assignee)
+		if err != nil {
+			return err
 		}
 
 		if err := jira.AssignIssue(ctx, opts.IssueKey, accountID); err != nil {
@@ -266,7 +359,7 @@ func runEdit(opts *EditOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, editOutput)
+		return output.PrintJSON(opts.IO, editOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Updated issue: %s\n", editOutput.Key)
@@ -275,3 +368,16 @@ func runEdit(opts *EditOptions) error {
 
 	return nil
 }
+
+// labelUpdateOps builds the "update.labels" operations that add and remove
+// labels without replacing the rest of the issue's labels.
+func labelUpdateOps(add, remove []string) []api.UpdateOp {
+	ops := make([]api.UpdateOp, 0, len(add)+len(remove))
+	for _, label := range add {
+		ops = append(ops, api.UpdateOp{Add: label})
+	}
+	for _, label := range remove {
+		ops = append(ops, api.UpdateOp{Remove: label})
+	}
+	return ops
+}
@@ -0,0 +1,103 @@
+package issue
+
+import (
+	"fmt"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+// Separators for --append-separator.
+const (
+	appendSeparatorRule  = "rule"
+	appendSeparatorBlank = "blank"
+	appendSeparatorNone  = "none"
+)
+
+// adfInlineTypes are node types that are only valid inside a block such as a
+// paragraph.
+var adfInlineTypes = map[string]bool{
+	"text":        true,
+	"hardBreak":   true,
+	"mention":     true,
+	"emoji":       true,
+	"inlineCard":  true,
+	"date":        true,
+	"status":      true,
+	"mediaInline": true,
+}
+
+func validateAppendSeparator(separator string) error {
+	switch separator {
+	case appendSeparatorRule, appendSeparatorBlank, appendSeparatorNone:
+		return nil
+	}
+	return fmt.Errorf("invalid --append-separator %q: must be rule, blank, or none", separator)
+}
+
+// appendDescription returns a document with the existing blocks, the
+// separator, and then the added blocks. Existing nodes (including embedded
+// media) are kept as they are; trailing empty paragraphs are dropped so
+// separators don't pile up over repeated appends. Loose inline nodes in the
+// added content are wrapped in a paragraph so it starts on a new block.
+func appendDescription(existing, added *api.ADF, separator string) *api.ADF {
+	var existingContent []api.ADFContent
+	if existing != nil {
+		existingContent = existing.Content
+	}
+	for len(existingContent) > 0 && isEmptyParagraph(existingContent[len(existingContent)-1]) {
+		existingContent = existingContent[:len(existingContent)-1]
+	}
+
+	addedContent := wrapInlineNodes(added.Content)
+
+	merged := &api.ADF{
+		Type:    "doc",
+		Version: 1,
+		Content: make([]api.ADFContent, 0, len(existingContent)+len(addedContent)+1),
+	}
+	merged.Content = append(merged.Content, existingContent...)
+	if len(existingContent) > 0 && len(addedContent) > 0 {
+		switch separator {
+		case appendSeparatorRule:
+			merged.Content = append(merged.Content, api.ADFContent{Type: "rule"})
+		case appendSeparatorBlank:
+			merged.Content = append(merged.Content, api.ADFContent{Type: "paragraph"})
+		}
+	}
+	merged.Content = append(merged.Content, addedContent...)
+	return merged
+}
+
+// wrapInlineNodes groups runs of top-level inline nodes into paragraphs.
+func wrapInlineNodes(content []api.ADFContent) []api.ADFContent {
+	var out []api.ADFContent
+	var inline []api.ADFContent
+	flush := func() {
+		if len(inline) > 0 {
+			out = append(out, api.ADFContent{Type: "paragraph", Content: inline})
+			inline = nil
+		}
+	}
+	for _, node := range content {
+		if adfInlineTypes[node.Type] {
+			inline = append(inline, node)
+			continue
+		}
+		flush()
+		out = append(out, node)
+	}
+	flush()
+	return out
+}
+
+func isEmptyParagraph(node api.ADFContent) bool {
+	if node.Type != "paragraph" {
+		return false
+	}
+	for _, child := range node.Content {
+		if child.Type != "text" || child.Text != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package issue
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func paragraph(text string) api.ADFContent {
+	return api.ADFContent{Type: "paragraph", Content: []api.ADFContent{{Type: "text", Text: text}}}
+}
+
+func nodeTypes(content []api.ADFContent) string {
+	types := make([]string, len(content))
+	for i, node := range content {
+		types[i] = node.Type
+	}
+	return strings.Join(types, ",")
+}
+
+func TestAppendDescription(t *testing.T) {
+	media := api.ADFContent{
+		Type:    "mediaSingle",
+		Content: []api.ADFContent{{Type: "media", Attrs: &api.ADFAttrs{ID: "abc-123", Type: "file", Collection: "jira"}}},
+	}
+	existing := &api.ADF{
+		Type:    "doc",
+		Version: 1,
+		Content: []api.ADFContent{paragraph("Old text"), media, {Type: "paragraph"}},
+	}
+	added := &api.ADF{Type: "doc", Version: 1, Content: []api.ADFContent{paragraph("New text")}}
+
+	tests := []struct {
+		name      string
+		existing  *api.ADF
+		separator string
+		want      string
+	}{
+		{name: "rule", existing: existing, separator: appendSeparatorRule, want: "paragraph,mediaSingle,rule,paragraph"},
+		{name: "blank paragraph", existing: existing, separator: appendSeparatorBlank, want: "paragraph,mediaSingle,paragraph,paragraph"},
+		{name: "none", existing: existing, separator: appendSeparatorNone, want: "paragraph,mediaSingle,paragraph"},
+		{name: "empty description", existing: nil, separator: appendSeparatorRule, want: "paragraph"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := appendDescription(tt.existing, added, tt.separator)
+			if types := nodeTypes(got.Content); types != tt.want {
+				t.Fatalf("node types = %s, want %s", types, tt.want)
+			}
+			last := got.Content[len(got.Content)-1]
+			if last.Content[0].Text != "New text" {
+				t.Errorf("last node = %+v, want the appended paragraph", last)
+			}
+			if tt.existing != nil && got.Content[1].Content[0].Attrs.ID != "abc-123" {
+				t.Errorf("embedded media not preserved: %+v", got.Content[1])
+			}
+		})
+	}
+
+	if len(existing.Content) != 3 {
+		t.Errorf("existing description modified: %s", nodeTypes(existing.Content))
+	}
+}
+
+func TestAppendDescriptionWrapsInlineNodes(t *testing.T) {
+	existing := &api.ADF{Type: "doc", Content: []api.ADFContent{paragraph("Old")}}
+	added := &api.ADF{Type: "doc", Content: []api.ADFContent{
+		{Type: "text", Text: "loose "},
+		{Type: "mention", Attrs: &api.ADFAttrs{ID: "user-1"}},
+		{Type: "bulletList"},
+	}}
+
+	got := appendDescription(existing, added, appendSeparatorNone)
+	if types := nodeTypes(got.Content); types != "paragraph,paragraph,bulletList" {
+		t.Fatalf("node types = %s, want paragraph,paragraph,bulletList", types)
+	}
+	if inner := nodeTypes(got.Content[1].Content); inner != "text,mention" {
+		t.Errorf("wrapped paragraph = %s, want text,mention", inner)
+	}
+}
+
+func TestValidateAppendSeparator(t *testing.T) {
+	for _, s := range []string{"rule", "blank", "none"} {
+		if err := validateAppendSeparator(s); err != nil {
+			t.Errorf("validateAppendSeparator(%q) error = %v", s, err)
+		}
+	}
+	if err := validateAppendSeparator("hr"); err == nil {
+		t.Error("validateAppendSeparator(\"hr\") should fail")
+	}
+}
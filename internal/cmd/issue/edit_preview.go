@@ -0,0 +1,77 @@
+package issue
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// descriptionDiffContext is the number of unchanged lines shown around each
+// change in the description preview.
+const descriptionDiffContext = 3
+
+// descriptionDiff returns a unified diff of the current and new description,
+// both rendered as Markdown, or nil if the text doesn't change.
+func descriptionDiff(issueKey, current, updated string) []string {
+	hunks := output.UnifiedDiff(descriptionLines(current), descriptionLines(updated), descriptionDiffContext)
+	if len(hunks) == 0 {
+		return nil
+	}
+	header := []string{
+		fmt.Sprintf("--- %s description (current)", issueKey),
+		fmt.Sprintf("+++ %s description (new)", issueKey),
+	}
+	return append(header, hunks...)
+}
+
+func descriptionLines(text string) []string {
+	text = strings.TrimRight(text, "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// confirmDescriptionChange shows the description diff and reports whether the
+// edit should be applied. Without a terminal to ask on, the edit is applied
+// only with --yes. With --json the diff goes to stderr so stdout stays JSON.
+func confirmDescriptionChange(opts *EditOptions, diff []string) bool {
+	opts.IO.StopPager()
+
+	w := opts.IO.Out
+	color := opts.IO.ColorEnabled()
+	if opts.JSON {
+		w = opts.IO.ErrOut
+		color = false
+	}
+
+	if diff == nil {
+		fmt.Fprintln(w, "Description text is unchanged")
+		return true
+	}
+
+	for _, line := range diff {
+		if color {
+			line = output.StyleDiffLine(line)
+		}
+		fmt.Fprintln(w, line)
+	}
+
+	if opts.Yes {
+		return true
+	}
+	if !opts.IO.IsStdinTTY {
+		fmt.Fprintln(w, "\nNot applied: no terminal to confirm. Re-run with --yes to apply.")
+		return false
+	}
+
+	fmt.Fprintf(w, "\nApply these changes to %s? [y/N]: ", opts.IssueKey)
+	var confirm string
+	fmt.Fscanln(opts.IO.In, &confirm)
+	if confirm != "y" && confirm != "Y" {
+		fmt.Fprintln(w, "Canceled")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package issue
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func TestDescriptionDiff(t *testing.T) {
+	current := "## Steps\n\n1. Open the app\n2. Click login\n"
+	updated := "## Steps\n\n1. Open the app\n2. Click sign in\n"
+
+	got := descriptionDiff("PROJ-1", current, updated)
+	want := []string{
+		"--- PROJ-1 description (current)",
+		"+++ PROJ-1 description (new)",
+		"@@ -1,4 +1,4 @@",
+		" ## Steps",
+		" ",
+		" 1. Open the app",
+		"-2. Click login",
+		"+2. Click sign in",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("descriptionDiff() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if got := descriptionDiff("PROJ-1", current, current+"\n"); got != nil {
+		t.Errorf("descriptionDiff() of unchanged text = %v, want nil", got)
+	}
+}
+
+func TestConfirmDescriptionChange(t *testing.T) {
+	diff := descriptionDiff("PROJ-1", "old", "new")
+
+	tests := []struct {
+		name      string
+		opts      EditOptions
+		stdinTTY  bool
+		input     string
+		wantApply bool
+	}{
+		{name: "no terminal", wantApply: false},
+		{name: "no terminal with --yes", opts: EditOptions{Yes: true}, wantApply: true},
+		{name: "confirmed", stdinTTY: true, input: "y\n", wantApply: true},
+		{name: "declined", stdinTTY: true, input: "n\n", wantApply: false},
+		{name: "empty answer", stdinTTY: true, input: "\n", wantApply: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios := iostreams.Test()
+			var out bytes.Buffer
+			ios.Out = &out
+			ios.In = strings.NewReader(tt.input)
+			ios.IsStdinTTY = tt.stdinTTY
+
+			opts := tt.opts
+			opts.IO = ios
+			opts.IssueKey = "PROJ-1"
+
+			apply := confirmDescriptionChange(&opts, diff)
+			if apply != tt.wantApply {
+				t.Errorf("confirmDescriptionChange() = %v, want %v", apply, tt.wantApply)
+			}
+			if !strings.Contains(out.String(), "+new") {
+				t.Errorf("diff not shown:\n%s", out.String())
+			}
+		})
+	}
+}
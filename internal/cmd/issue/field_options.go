@@ -48,7 +48,7 @@ func NewCmdFieldOptions(ios *iostreams.IOStreams) *cobra.Command {
 			if opts.IssueType == "" {
 				return fmt.Errorf("--type flag is required\n\nUse 'atl issue types --project %s' to list available issue types", opts.Project)
 			}
-			return runFieldOptions(opts)
+			return runFieldOptions(cmd.Context(), opts)
 		},
 	}
 
@@ -70,13 +70,12 @@ type FieldOptionOutput struct {
 	AllowedValues []string `json:"allowed_values"`
 }
 
-func runFieldOptions(opts *FieldOptionsOptions) error {
+func runFieldOptions(ctx context.Context, opts *FieldOptionsOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	// Resolve issue type name to ID
@@ -150,7 +149,7 @@ func runFieldOptions(opts *FieldOptionsOptions) error {
 	})
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, results)
+		return output.PrintJSON(opts.IO, results)
 	}
 
 	if len(results) == 0 {
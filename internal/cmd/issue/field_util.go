@@ -3,8 +3,10 @@ package issue
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/enthus-appdev/atl-cli/internal/api"
 )
@@ -25,6 +27,7 @@ func isSystemField(name string) bool {
 // ParseCustomField resolves a key=value pair into a field ID and properly
 // typed value for the Jira API. Handles name-to-ID resolution and
 // type-aware value coercion (select -> {value:...}, textarea -> ADF, number).
+// Multi-value fields take a comma-separated list: "Teams=A,B,C".
 func ParseCustomField(ctx context.Context, jira *api.JiraService, raw string) (string, interface{}, error) {
 	parts := strings.SplitN(raw, "=", 2)
 	if len(parts) != 2 {
@@ -32,53 +35,94 @@ func ParseCustomField(ctx context.Context, jira *api.JiraService, raw string) (s
 	}
 	key, value := parts[0], parts[1]
 
-	var resolvedField *api.Field
+	key, resolvedField, err := resolveField(ctx, jira, key)
+	if err != nil {
+		return "", nil, err
+	}
 
-	if strings.HasPrefix(key, "customfield_") {
-		resolvedField, _ = jira.GetFieldByID(ctx, key)
-	} else if !isSystemField(key) {
-		var err error
-		resolvedField, err = jira.GetFieldByName(ctx, key)
-		if err != nil {
-			return "", nil, fmt.Errorf("failed to look up field '%s': %w", key, err)
-		}
-		if resolvedField == nil {
-			return "", nil, fmt.Errorf("field not found: %s\n\nUse 'atl issue fields --search \"%s\"' to find available fields", key, key)
-		}
-		key = resolvedField.ID
+	if resolvedField == nil && key == "duedate" {
+		// Due date is a system field; give it the date schema so relative
+		// values are converted like date custom fields.
+		resolvedField = &api.Field{ID: key, Schema: &api.FieldSchema{Type: "date"}}
 	}
 
 	fieldValue := coerceFieldValue(resolvedField, value)
 	return key, fieldValue, nil
 }
 
+// ParseUnsetField resolves a field name or ID for --unset-field and returns
+// the value that clears it.
+func ParseUnsetField(ctx context.Context, jira *api.JiraService, name string) (string, interface{}, error) {
+	key, resolvedField, err := resolveField(ctx, jira, strings.TrimSpace(name))
+	if err != nil {
+		return "", nil, err
+	}
+	if resolvedField == nil && isSystemField(key) {
+		// System fields are not resolved by name; look up their schema to
+		// tell arrays (labels, components) from scalars (duedate).
+		resolvedField, _ = jira.GetFieldByID(ctx, key)
+	}
+	return key, unsetFieldValue(resolvedField), nil
+}
+
+// resolveField maps a field name to its ID. Custom field IDs are looked up
+// for their schema; system fields are returned as given with a nil field.
+func resolveField(ctx context.Context, jira *api.JiraService, key string) (string, *api.Field, error) {
+	if strings.HasPrefix(key, "customfield_") {
+		field, _ := jira.GetFieldByID(ctx, key)
+		return key, field, nil
+	}
+	if isSystemField(key) {
+		return key, nil, nil
+	}
+
+	field, err := jira.GetFieldByName(ctx, key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to look up field '%s': %w", key, err)
+	}
+	if field == nil {
+		return "", nil, fmt.Errorf("field not found: %s\n\nUse 'atl issue fields --search \"%s\"' to find available fields", key, key)
+	}
+	return field.ID, field, nil
+}
+
+// unsetFieldValue returns the value that clears a field: an empty list for
+// array fields (multi-selects, labels, versions), removing every value, and
+// null for everything else. Sprint-style arrays of JSON objects are cleared
+// with null too, as they are not set as lists.
+func unsetFieldValue(field *api.Field) interface{} {
+	if field != nil && field.Schema != nil && field.Schema.Type == "array" && field.Schema.Items != "json" {
+		return []interface{}{}
+	}
+	return nil
+}
+
 // coerceFieldValue converts a string value to the appropriate type
 // based on the field's schema.
 func coerceFieldValue(field *api.Field, value string) interface{} {
 	if field != nil && field.Schema != nil {
-		customType := field.Schema.Custom
-		if strings.Contains(customType, "select") || strings.Contains(customType, "radiobuttons") {
-			return map[string]string{"value": value}
+		if field.Schema.Type == "array" {
+			if values := coerceArrayValue(field.Schema.Items, value); values != nil {
+				return values
+			}
 		}
-		if strings.Contains(customType, "multiselect") || strings.Contains(customType, "multicheckboxes") {
-			vals := strings.Split(value, ",")
-			options := make([]map[string]string, len(vals))
-			for i, v := range vals {
-				options[i] = map[string]string{"value": strings.TrimSpace(v)}
+
+		if field.Schema.Type == "date" {
+			// Relative dates (today, +3d, next friday) become YYYY-MM-DD;
+			// anything else is sent as given for Jira to validate.
+			if date, err := ParseRelativeDate(value); err == nil {
+				return date.Format(time.DateOnly)
 			}
-			return options
+			return value
+		}
+
+		customType := field.Schema.Custom
+		if field.Schema.Type == "option" || strings.Contains(customType, "select") || strings.Contains(customType, "radiobuttons") {
+			return map[string]string{"value": value}
 		}
 		if strings.Contains(customType, "textarea") {
 			return api.TextToADF(value)
 		}
-		if field.Schema.Type == "array" && field.Schema.Custom == "" {
-			// Labels-type array of strings.
-			vals := strings.Split(value, ",")
-			for i := range vals {
-				vals[i] = strings.TrimSpace(vals[i])
-			}
-			return vals
-		}
 	}
 
 	if numVal, err := strconv.ParseFloat(value, 64); err == nil {
@@ -86,3 +130,59 @@ func coerceFieldValue(field *api.Field, value string) interface{} {
 	}
 	return value
 }
+
+// coerceArrayValue splits a comma-separated value into the element shape
+// Jira expects for an array field's item type: {value} for options (multi
+// select, checkboxes), {name} for versions and components, plain strings for
+// labels-style fields. Returns nil for item types that are not set this way
+// (e.g. sprints, which take a single ID).
+func coerceArrayValue(items, value string) interface{} {
+	var parts []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			parts = append(parts, v)
+		}
+	}
+
+	switch items {
+	case "option":
+		options := make([]map[string]string, len(parts))
+		for i, v := range parts {
+			options[i] = map[string]string{"value": v}
+		}
+		return options
+	case "version", "component":
+		named := make([]map[string]string, len(parts))
+		for i, v := range parts {
+			named[i] = map[string]string{"name": v}
+		}
+		return named
+	case "string", "":
+		if parts == nil {
+			parts = []string{}
+		}
+		return parts
+	default:
+		return nil
+	}
+}
+
+// validateADFFields runs api.ValidateADF on every ADF value among the
+// fields (descriptions and textarea custom fields converted from Markdown),
+// naming the field when one is invalid. Raw JSON values are sent as given.
+func validateADFFields(fields map[string]interface{}) error {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if adf, ok := fields[key].(*api.ADF); ok {
+			if err := api.ValidateADF(adf); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+	return nil
+}
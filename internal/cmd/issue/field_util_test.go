@@ -0,0 +1,111 @@
+package issue
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestCoerceFieldValue(t *testing.T) {
+	field := func(typ, items, custom string) *api.Field {
+		return &api.Field{Schema: &api.FieldSchema{Type: typ, Items: items, Custom: custom}}
+	}
+
+	tests := []struct {
+		name  string
+		field *api.Field
+		value string
+		want  interface{}
+	}{
+		{
+			name:  "array of options (multi-select)",
+			field: field("array", "option", "com.atlassian.jira.plugin.system.customfieldtypes:multiselect"),
+			value: "A, B,C",
+			want:  []map[string]string{{"value": "A"}, {"value": "B"}, {"value": "C"}},
+		},
+		{
+			name:  "array of strings (labels)",
+			field: field("array", "string", "com.atlassian.jira.plugin.system.customfieldtypes:labels"),
+			value: "frontend,,backend ",
+			want:  []string{"frontend", "backend"},
+		},
+		{
+			name:  "array of versions",
+			field: field("array", "version", "com.atlassian.jira.plugin.system.customfieldtypes:multiversion"),
+			value: "1.0,2.0",
+			want:  []map[string]string{{"name": "1.0"}, {"name": "2.0"}},
+		},
+		{
+			name:  "single select stays scalar",
+			field: field("option", "", "com.atlassian.jira.plugin.system.customfieldtypes:select"),
+			value: "A,B",
+			want:  map[string]string{"value": "A,B"},
+		},
+		{
+			name:  "sprint takes a number, not an array",
+			field: field("array", "json", "com.pyxis.greenhopper.jira:gh-sprint"),
+			value: "42",
+			want:  float64(42),
+		},
+		{
+			name:  "date keeps absolute value",
+			field: field("date", "", "com.atlassian.jira.plugin.system.customfieldtypes:datepicker"),
+			value: "2026-03-01",
+			want:  "2026-03-01",
+		},
+		{
+			name:  "date passes unparsed value through",
+			field: field("date", "", "com.atlassian.jira.plugin.system.customfieldtypes:datepicker"),
+			value: "1/Mar/26",
+			want:  "1/Mar/26",
+		},
+		{
+			name:  "unknown field",
+			field: nil,
+			value: "text",
+			want:  "text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := coerceFieldValue(tt.field, tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("coerceFieldValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnsetFieldValue(t *testing.T) {
+	field := func(typ, items, custom string) *api.Field {
+		return &api.Field{Schema: &api.FieldSchema{Type: typ, Items: items, Custom: custom}}
+	}
+
+	tests := []struct {
+		name  string
+		field *api.Field
+		want  string
+	}{
+		{name: "number (story points)", field: field("number", "", "com.atlassian.jira.plugin.system.customfieldtypes:float"), want: `null`},
+		{name: "single select", field: field("option", "", "com.atlassian.jira.plugin.system.customfieldtypes:select"), want: `null`},
+		{name: "multi-select", field: field("array", "option", "com.atlassian.jira.plugin.system.customfieldtypes:multiselect"), want: `[]`},
+		{name: "labels", field: field("array", "string", ""), want: `[]`},
+		{name: "sprint", field: field("array", "json", "com.pyxis.greenhopper.jira:gh-sprint"), want: `null`},
+		{name: "unknown field", field: nil, want: `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &api.UpdateIssueRequest{Fields: map[string]interface{}{"customfield_1": unsetFieldValue(tt.field)}}
+			data, err := json.Marshal(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := `{"fields":{"customfield_1":` + tt.want + `}}`; string(data) != want {
+				t.Errorf("payload = %s, want %s", data, want)
+			}
+		})
+	}
+}
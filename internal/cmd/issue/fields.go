@@ -17,6 +17,8 @@ type FieldsOptions struct {
 	IO         *iostreams.IOStreams
 	CustomOnly bool
 	Search     string
+	LinkTypes  bool
+	Priorities bool
 	JSON       bool
 }
 
@@ -32,7 +34,10 @@ func NewCmdFields(ios *iostreams.IOStreams) *cobra.Command {
 		Long: `List all available fields in Jira, including custom fields.
 
 Use this command to discover field IDs for custom fields like "Story Points"
-which are needed when using the --field flag with create or edit commands.`,
+which are needed when using the --field flag with create or edit commands.
+
+--link-types and --priorities print the exact names expected by
+'atl issue link --type' and the --priority flag of create and edit.`,
 		Example: `  # List all fields
   atl issue fields
 
@@ -42,15 +47,35 @@ which are needed when using the --field flag with create or edit commands.`,
   # Search for a specific field
   atl issue fields --search "story points"
 
+  # List valid link type names
+  atl issue fields --link-types
+
+  # List valid priority names
+  atl issue fields --priorities
+
   # Output as JSON
   atl issue fields --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runFields(opts)
+			if opts.LinkTypes && opts.Priorities {
+				return fmt.Errorf("--link-types and --priorities cannot be used together")
+			}
+			if (opts.LinkTypes || opts.Priorities) && (opts.CustomOnly || opts.Search != "") {
+				return fmt.Errorf("--custom and --search only apply to fields")
+			}
+			switch {
+			case opts.LinkTypes:
+				return runListLinkTypes(cmd.Context(), &LinkOptions{IO: opts.IO, JSON: opts.JSON})
+			case opts.Priorities:
+				return runPriorities(cmd.Context(), &PrioritiesOptions{IO: opts.IO, JSON: opts.JSON})
+			}
+			return runFields(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&opts.CustomOnly, "custom", "c", false, "Show only custom fields")
 	cmd.Flags().StringVarP(&opts.Search, "search", "s", "", "Search for fields by name")
+	cmd.Flags().BoolVar(&opts.LinkTypes, "link-types", false, "List valid issue link type names")
+	cmd.Flags().BoolVar(&opts.Priorities, "priorities", false, "List valid priority names")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
 
 	return cmd
@@ -70,13 +95,12 @@ type FieldsOutput struct {
 	Total  int            `json:"total"`
 }
 
-func runFields(opts *FieldsOptions) error {
+func runFields(ctx context.Context, opts *FieldsOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	fields, err := jira.GetFields(ctx)
@@ -124,7 +148,7 @@ func runFields(opts *FieldsOptions) error {
 	fieldsOutput.Total = len(fieldsOutput.Fields)
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, fieldsOutput)
+		return output.PrintJSON(opts.IO, fieldsOutput)
 	}
 
 	if fieldsOutput.Total == 0 {
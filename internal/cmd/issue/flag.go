@@ -3,6 +3,7 @@ package issue
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -17,25 +18,40 @@ type FlagOptions struct {
 	IssueKey string
 	Unflag   bool
 	Status   bool
+	Reason   string
 	JSON     bool
+
+	Stdin       bool
+	Concurrency int
 }
 
 // NewCmdFlag creates the flag command.
 func NewCmdFlag(ios *iostreams.IOStreams) *cobra.Command {
 	opts := &FlagOptions{
-		IO: ios,
+		IO:          ios,
+		Concurrency: 5,
 	}
 
 	cmd := &cobra.Command{
-		Use:   "flag <issue-key>",
+		Use:   "flag [issue-key]",
 		Short: "Flag or unflag a Jira issue",
 		Long: `Flag or unflag a Jira issue.
 
 Flagged issues are marked as having an impediment and are highlighted
-in sprint boards and backlogs. Use flags to indicate blocked work.`,
+in sprint boards and backlogs. Use flags to indicate blocked work.
+
+With --reason, a comment "Flagged: <reason>" is added after flagging, and
+'atl issue view' shows the reason of a flagged issue. If the comment
+fails, the issue stays flagged and the failure is reported.
+
+With --stdin, issue keys are read one per line and each one is flagged (or
+unflagged); a failure on one issue does not stop the rest.`,
 		Example: `  # Flag an issue
   atl issue flag PROJ-123
 
+  # Flag an issue and say why
+  atl issue flag PROJ-123 --reason "waiting on the payments team"
+
   # Unflag an issue
   atl issue flag PROJ-123 --unflag
 
@@ -43,16 +59,44 @@ in sprint boards and backlogs. Use flags to indicate blocked work.`,
   atl issue flag PROJ-123 --status
 
   # Output as JSON
-  atl issue flag PROJ-123 --json`,
-		Args: cobra.ExactArgs(1),
+  atl issue flag PROJ-123 --json
+
+  # Flag every issue a query returns
+  atl issue list --jql "project = PROJ AND status = Blocked" --json | jq -r '.issues[].key' | atl issue flag --stdin`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
-			return runFlag(opts)
+			if opts.Reason != "" && (opts.Unflag || opts.Status || opts.Stdin) {
+				return fmt.Errorf("--reason cannot be used with --unflag, --status, or --stdin")
+			}
+			if opts.Stdin {
+				if len(args) > 0 {
+					return fmt.Errorf("--stdin cannot be used with an issue key argument")
+				}
+				if opts.Status {
+					return fmt.Errorf("--stdin cannot be used with --status")
+				}
+				if opts.Concurrency < 1 {
+					return fmt.Errorf("--concurrency must be at least 1")
+				}
+				return runFlagStdin(cmd.Context(), opts)
+			}
+			if len(args) == 0 {
+				return fmt.Errorf("an issue key is required (or use --stdin)")
+			}
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			return runFlag(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&opts.Unflag, "unflag", "u", false, "Remove the flag from the issue")
 	cmd.Flags().BoolVarP(&opts.Status, "status", "s", false, "Check if the issue is flagged (don't change)")
+	cmd.Flags().StringVarP(&opts.Reason, "reason", "r", "", "Add a comment explaining why the issue is flagged")
+	cmd.Flags().BoolVar(&opts.Stdin, "stdin", false, stdinFlagUsage)
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of issues changed in parallel with --stdin")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
 
 	return cmd
@@ -63,15 +107,24 @@ type FlagOutput struct {
 	IssueKey string `json:"issue_key"`
 	Flagged  bool   `json:"flagged"`
 	Action   string `json:"action"`
+
+	// Set with --reason. The issue is flagged even if the comment failed;
+	// CommentError then holds the reason.
+	Reason       string `json:"reason,omitempty"`
+	CommentID    string `json:"comment_id,omitempty"`
+	CommentError string `json:"comment_error,omitempty"`
 }
 
-func runFlag(opts *FlagOptions) error {
+// flagReasonPrefix starts the comment added by flag --reason. issue view
+// looks for it to show why an issue is flagged.
+const flagReasonPrefix = "Flagged: "
+
+func runFlag(ctx context.Context, opts *FlagOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	// Check status only
@@ -88,7 +141,7 @@ func runFlag(opts *FlagOptions) error {
 		}
 
 		if opts.JSON {
-			return output.JSON(opts.IO.Out, flagOutput)
+			return output.PrintJSON(opts.IO, flagOutput)
 		}
 
 		if flagged {
@@ -115,29 +168,94 @@ func runFlag(opts *FlagOptions) error {
 		}
 
 		if opts.JSON {
-			return output.JSON(opts.IO.Out, flagOutput)
+			return output.PrintJSON(opts.IO, flagOutput)
 		}
 
 		fmt.Fprintf(opts.IO.Out, "Removed flag from %s\n", opts.IssueKey)
 	} else {
 		// Flag the issue
-		err = jira.FlagIssue(ctx, opts.IssueKey)
+		flagOutput, err = flagWithReason(ctx, jira.FlagIssue, jira.AddComment, opts.IssueKey, opts.Reason)
 		if err != nil {
-			return fmt.Errorf("failed to flag issue: %w", err)
+			return err
 		}
-
-		flagOutput = &FlagOutput{
-			IssueKey: opts.IssueKey,
-			Flagged:  true,
-			Action:   "flagged",
+		if flagOutput.CommentError != "" {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: %s flagged but failed to add the reason comment: %s\n", opts.IssueKey, flagOutput.CommentError)
 		}
 
 		if opts.JSON {
-			return output.JSON(opts.IO.Out, flagOutput)
+			return output.PrintJSON(opts.IO, flagOutput)
 		}
 
 		fmt.Fprintf(opts.IO.Out, "Flagged %s\n", opts.IssueKey)
+		if flagOutput.CommentID != "" {
+			fmt.Fprintf(opts.IO.Out, "Reason: %s\n", flagOutput.Reason)
+		}
 	}
 
 	return nil
 }
+
+// flagWithReason flags an issue and, if a reason is given, comments it on
+// the issue. Only a failed flag is an error; a failed comment is recorded
+// in the output, as the issue is flagged either way.
+func flagWithReason(ctx context.Context, flag func(context.Context, string) error, addComment func(context.Context, string, string) (*api.Comment, error), key, reason string) (*FlagOutput, error) {
+	if err := flag(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to flag issue: %w", err)
+	}
+
+	flagOutput := &FlagOutput{
+		IssueKey: key,
+		Flagged:  true,
+		Action:   "flagged",
+		Reason:   reason,
+	}
+	if reason == "" {
+		return flagOutput, nil
+	}
+
+	comment, err := addComment(ctx, key, flagReasonPrefix+reason)
+	if err != nil {
+		flagOutput.CommentError = err.Error()
+		return flagOutput, nil
+	}
+	flagOutput.CommentID = comment.ID
+	return flagOutput, nil
+}
+
+// flagReason returns the reason of the most recent flag comment, or "" if
+// there is none. Besides the comments added by flag --reason, it recognizes
+// the "Flag added" comments Jira's own flag dialog creates.
+func flagReason(comments []*api.Comment) string {
+	for i := len(comments) - 1; i >= 0; i-- {
+		text := strings.TrimSpace(api.ADFToText(comments[i].Body))
+		text = strings.TrimSpace(strings.TrimPrefix(text, ":flag_on:"))
+		for _, prefix := range []string{flagReasonPrefix, "Flag added"} {
+			if rest, ok := strings.CutPrefix(text, prefix); ok {
+				return strings.TrimSpace(rest)
+			}
+		}
+	}
+	return ""
+}
+
+func runFlagStdin(ctx context.Context, opts *FlagOptions) error {
+	keys, err := readIssueKeys(opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	action, done, apply := "flagged", "Flagged", jira.FlagIssue
+	if opts.Unflag {
+		action, done, apply = "unflagged", "Removed flag from", jira.UnflagIssue
+	}
+
+	batchOutput := applyToIssues(ctx, keys, opts.Concurrency, action, apply)
+	return finishIssueBatch(opts.IO, batchOutput, done, opts.JSON)
+}
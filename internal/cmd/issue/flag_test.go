@@ -0,0 +1,107 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestFlagWithReason(t *testing.T) {
+	var calls []string
+	flag := func(_ context.Context, key string) error {
+		calls = append(calls, "flag "+key)
+		return nil
+	}
+	addComment := func(_ context.Context, key, body string) (*api.Comment, error) {
+		calls = append(calls, "comment "+key+" "+body)
+		return &api.Comment{ID: "10500"}, nil
+	}
+
+	got, err := flagWithReason(context.Background(), flag, addComment, "PROJ-1", "waiting on payments")
+	if err != nil {
+		t.Fatalf("flagWithReason() error = %v", err)
+	}
+	if len(calls) != 2 || calls[0] != "flag PROJ-1" || calls[1] != "comment PROJ-1 Flagged: waiting on payments" {
+		t.Errorf("calls = %q, want flag then comment", calls)
+	}
+	if !got.Flagged || got.CommentID != "10500" || got.Reason != "waiting on payments" || got.CommentError != "" {
+		t.Errorf("output = %+v", got)
+	}
+}
+
+func TestFlagWithReason_NoReason(t *testing.T) {
+	addComment := func(context.Context, string, string) (*api.Comment, error) {
+		t.Error("no comment should be added without a reason")
+		return nil, nil
+	}
+
+	got, err := flagWithReason(context.Background(), func(context.Context, string) error { return nil }, addComment, "PROJ-1", "")
+	if err != nil || !got.Flagged || got.CommentID != "" {
+		t.Errorf("flagWithReason() = %+v, %v", got, err)
+	}
+}
+
+func TestFlagWithReason_CommentFails(t *testing.T) {
+	addComment := func(context.Context, string, string) (*api.Comment, error) {
+		return nil, errors.New("forbidden")
+	}
+
+	got, err := flagWithReason(context.Background(), func(context.Context, string) error { return nil }, addComment, "PROJ-1", "blocked")
+	if err != nil {
+		t.Fatalf("a failed comment should not be an error, got %v", err)
+	}
+	if !got.Flagged || got.CommentError != "forbidden" || got.CommentID != "" {
+		t.Errorf("output = %+v, want flagged with comment_error", got)
+	}
+}
+
+func TestFlagWithReason_FlagFails(t *testing.T) {
+	addComment := func(context.Context, string, string) (*api.Comment, error) {
+		t.Error("no comment should be added when flagging fails")
+		return nil, nil
+	}
+
+	_, err := flagWithReason(context.Background(), func(context.Context, string) error { return errors.New("no permission") }, addComment, "PROJ-1", "blocked")
+	if err == nil {
+		t.Fatal("expected an error when flagging fails")
+	}
+}
+
+func TestFlagReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		comments []*api.Comment
+		want     string
+	}{
+		{name: "no comments", want: ""},
+		{
+			name:     "unrelated comments",
+			comments: []*api.Comment{{Body: api.TextToADF("Looks good")}},
+			want:     "",
+		},
+		{
+			name: "latest flag comment wins",
+			comments: []*api.Comment{
+				{Body: api.TextToADF("Flagged: old reason")},
+				{Body: api.TextToADF("Flagged: waiting on payments")},
+				{Body: api.TextToADF("Any update?")},
+			},
+			want: "waiting on payments",
+		},
+		{
+			name:     "jira flag dialog",
+			comments: []*api.Comment{{Body: api.TextToADF(":flag_on: Flag added\nvendor outage")}},
+			want:     "vendor outage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := flagReason(tt.comments); got != tt.want {
+				t.Errorf("flagReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// ChildrenOptions holds the options for the children command.
+type ChildrenOptions struct {
+	IO       *iostreams.IOStreams
+	IssueKey string
+	Limit    int
+	All      bool
+	JSON     bool
+}
+
+// NewCmdChildren creates the children command.
+func NewCmdChildren(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &ChildrenOptions{
+		IO:    ios,
+		Limit: 50,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "children <issue-key>",
+		Short: "List the child issues of an epic or parent issue",
+		Long: `List the issues whose parent is the given issue: the issues in an epic, or
+the subtasks of a task.
+
+Searches with 'parent = <key>'. If that finds nothing, falls back to the
+classic '"Epic Link" = <key>' query used by older company-managed projects.`,
+		Example: `  # Issues in an epic
+  atl issue children PROJ-100
+
+  # Every child, as JSON
+  atl issue children PROJ-100 --all --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			if opts.Limit < 1 {
+				return fmt.Errorf("--limit must be at least 1")
+			}
+			return runChildren(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 50, "Maximum number of issues")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Fetch all child issues (ignores --limit)")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// childrenJQL finds issues whose parent is key.
+func childrenJQL(key string) string {
+	return fmt.Sprintf("parent = %q ORDER BY created ASC", key)
+}
+
+// epicLinkJQL finds issues linked to an epic through the classic Epic Link
+// field.
+func epicLinkJQL(key string) string {
+	return fmt.Sprintf("%q = %q ORDER BY created ASC", "Epic Link", key)
+}
+
+func runChildren(ctx context.Context, opts *ChildrenOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	listOpts := &ListOptions{
+		IO:    opts.IO,
+		JQL:   childrenJQL(opts.IssueKey),
+		Limit: opts.Limit,
+		All:   opts.All,
+		JSON:  opts.JSON,
+	}
+
+	listOutput, err := fetchIssueList(ctx, jira, listOpts)
+	if err != nil {
+		return err
+	}
+
+	if len(listOutput.Issues) == 0 {
+		listOpts.JQL = epicLinkJQL(opts.IssueKey)
+		epicOutput, err := fetchIssueList(ctx, jira, listOpts)
+		var apiErr *api.APIError
+		switch {
+		case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest:
+			// No Epic Link field on this site; keep the empty parent result.
+		case err != nil:
+			return err
+		default:
+			listOutput = epicOutput
+		}
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, listOutput)
+	}
+
+	if len(listOutput.Issues) == 0 {
+		fmt.Fprintf(opts.IO.Out, "%s has no child issues\n", opts.IssueKey)
+		return nil
+	}
+
+	printIssueList(listOpts, listOutput, nil)
+	return nil
+}
+
+// ParentOptions holds the options for the parent command.
+type ParentOptions struct {
+	IO       *iostreams.IOStreams
+	IssueKey string
+	JSON     bool
+}
+
+// NewCmdParent creates the parent command.
+func NewCmdParent(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &ParentOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "parent <issue-key>",
+		Short: "Show the parent of an issue",
+		Long:  `Show the parent of a subtask, or the epic an issue belongs to.`,
+		Example: `  # Which epic is this story in?
+  atl issue parent PROJ-1234
+
+  # Parent key only, for scripts
+  atl issue parent PROJ-1234 --json | jq -r '.parent.key'`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			return runParent(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// ParentOutput represents the output of the parent command.
+type ParentOutput struct {
+	IssueKey string         `json:"issue_key"`
+	Parent   *IssueListItem `json:"parent"` // null when the issue has no parent
+	URL      string         `json:"url,omitempty"`
+}
+
+func runParent(ctx context.Context, opts *ParentOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	issue, err := jira.GetIssueWithOptions(ctx, opts.IssueKey, api.GetIssueOptions{
+		Fields: []string{"parent"},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	parentOutput := &ParentOutput{IssueKey: opts.IssueKey}
+	if issue.Fields.Parent != nil {
+		parentOutput.Parent = newIssueListItem(issue.Fields.Parent)
+		parentOutput.URL = client.BrowseURL(issue.Fields.Parent.Key)
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, parentOutput)
+	}
+
+	if parentOutput.Parent == nil {
+		fmt.Fprintf(opts.IO.Out, "%s has no parent\n", opts.IssueKey)
+		return nil
+	}
+
+	p := parentOutput.Parent
+	fmt.Fprintf(opts.IO.Out, "%s: %s\n", p.Key, p.Summary)
+	fmt.Fprintf(opts.IO.Out, "Type: %s\n", p.Type)
+	fmt.Fprintf(opts.IO.Out, "Status: %s\n", p.Status)
+	fmt.Fprintf(opts.IO.Out, "URL: %s\n", parentOutput.URL)
+
+	return nil
+}
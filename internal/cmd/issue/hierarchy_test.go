@@ -0,0 +1,12 @@
+package issue
+
+import "testing"
+
+func TestHierarchyJQL(t *testing.T) {
+	if got, want := childrenJQL("PROJ-100"), `parent = "PROJ-100" ORDER BY created ASC`; got != want {
+		t.Errorf("childrenJQL() = %q, want %q", got, want)
+	}
+	if got, want := epicLinkJQL("PROJ-100"), `"Epic Link" = "PROJ-100" ORDER BY created ASC`; got != want {
+		t.Errorf("epicLinkJQL() = %q, want %q", got, want)
+	}
+}
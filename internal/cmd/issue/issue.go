@@ -3,6 +3,7 @@ package issue
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/enthus-appdev/atl-cli/internal/api"
 	"github.com/enthus-appdev/atl-cli/internal/cmd/issue/comment"
 	"github.com/enthus-appdev/atl-cli/internal/iostreams"
 )
@@ -18,21 +19,55 @@ func NewCmdIssue(ios *iostreams.IOStreams) *cobra.Command {
 
 	cmd.AddCommand(NewCmdView(ios))
 	cmd.AddCommand(NewCmdList(ios))
+	cmd.AddCommand(NewCmdRecent(ios))
+	cmd.AddCommand(NewCmdSummary(ios))
 	cmd.AddCommand(NewCmdCreate(ios))
+	cmd.AddCommand(NewCmdSubtask(ios))
 	cmd.AddCommand(NewCmdEdit(ios))
+	cmd.AddCommand(NewCmdBulkLabel(ios))
+	cmd.AddCommand(NewCmdRelabel(ios))
 	cmd.AddCommand(NewCmdTransition(ios))
+	cmd.AddCommand(NewCmdTransitions(ios))
+	cmd.AddCommand(NewCmdResolve(ios))
+	cmd.AddCommand(NewCmdReopen(ios))
 	cmd.AddCommand(comment.NewCmdComment(ios))
 	cmd.AddCommand(NewCmdAssign(ios))
 	cmd.AddCommand(NewCmdLink(ios))
+	cmd.AddCommand(NewCmdBlock(ios))
+	cmd.AddCommand(NewCmdDup(ios))
+	cmd.AddCommand(NewCmdRelates(ios))
+	cmd.AddCommand(NewCmdChildren(ios))
+	cmd.AddCommand(NewCmdParent(ios))
 	cmd.AddCommand(NewCmdFields(ios))
 	cmd.AddCommand(NewCmdFieldOptions(ios))
 	cmd.AddCommand(NewCmdSprint(ios))
 	cmd.AddCommand(NewCmdFlag(ios))
+	cmd.AddCommand(NewCmdRank(ios))
+	cmd.AddCommand(NewCmdTime(ios))
+	cmd.AddCommand(NewCmdVote(ios))
 	cmd.AddCommand(NewCmdWebLink(ios))
+	cmd.AddCommand(NewCmdRemoteLink(ios))
+	cmd.AddCommand(NewCmdLinkPage(ios))
+	cmd.AddCommand(NewCmdProperty(ios))
 	cmd.AddCommand(NewCmdTypes(ios))
 	cmd.AddCommand(NewCmdPriorities(ios))
+	cmd.AddCommand(NewCmdLabels(ios))
 	cmd.AddCommand(NewCmdAttachment(ios))
 	cmd.AddCommand(NewCmdChangelog(ios))
 
 	return cmd
 }
+
+// normalizeIssueKeys applies api.NormalizeIssueKey to each argument, so issue
+// URLs can be passed wherever a key is expected.
+func normalizeIssueKeys(args []string) ([]string, error) {
+	keys := make([]string, 0, len(args))
+	for _, arg := range args {
+		key, err := api.NormalizeIssueKey(arg)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
@@ -0,0 +1,99 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// LabelsOptions holds the options for the labels command.
+type LabelsOptions struct {
+	IO     *iostreams.IOStreams
+	Search string
+	JSON   bool
+}
+
+// NewCmdLabels creates the labels command.
+func NewCmdLabels(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &LabelsOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "labels",
+		Short: "List labels in use",
+		Long: `List the labels already used on issues in the Jira instance.
+
+Check here before adding a label to avoid typos and near-duplicates. Use
+--search to show only labels starting with a prefix (case-insensitive).`,
+		Example: `  # All labels
+  atl issue labels
+
+  # Labels starting with "back"
+  atl issue labels --search back
+
+  # Output as JSON
+  atl issue labels --search back --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLabels(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Search, "search", "s", "", "Only show labels starting with this prefix")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// LabelsOutput represents the list output.
+type LabelsOutput struct {
+	Labels []string `json:"labels"`
+	Total  int      `json:"total"`
+}
+
+func runLabels(ctx context.Context, opts *LabelsOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	labels, err := jira.GetLabels(ctx, opts.Search)
+	if err != nil {
+		return fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	labelsOutput := &LabelsOutput{
+		Labels: labels,
+		Total:  len(labels),
+	}
+	if labelsOutput.Labels == nil {
+		labelsOutput.Labels = []string{}
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, labelsOutput)
+	}
+
+	if len(labels) == 0 {
+		if opts.Search != "" {
+			fmt.Fprintf(opts.IO.Out, "No labels starting with %q\n", opts.Search)
+		} else {
+			fmt.Fprintln(opts.IO.Out, "No labels found")
+		}
+		return nil
+	}
+
+	for _, label := range labels {
+		fmt.Fprintln(opts.IO.Out, label)
+	}
+
+	return nil
+}
@@ -39,6 +39,9 @@ Common link types:
   - Duplicate   (A duplicates B)
   - Relates     (A relates to B)
 
+Either issue can be given as a key or a browse URL. Both issues are looked
+up before the link is created, so a wrong key is reported by name.
+
 Use --list-types to see all available link types for your Jira instance.`,
 		Example: `  # Link PROJ-1 blocks PROJ-2
   atl issue link PROJ-1 PROJ-2 --type Blocks
@@ -46,6 +49,9 @@ Use --list-types to see all available link types for your Jira instance.`,
   # Link PROJ-1 relates to PROJ-2
   atl issue link PROJ-1 PROJ-2 --type Relates
 
+  # Use a browse URL for either side
+  atl issue link PROJ-1 https://mycompany.atlassian.net/browse/PROJ-2 --type Blocks
+
   # List available link types
   atl issue link --list-types`,
 		Args: func(cmd *cobra.Command, args []string) error {
@@ -59,14 +65,18 @@ Use --list-types to see all available link types for your Jira instance.`,
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.ListTypes {
-				return runListLinkTypes(opts)
+				return runListLinkTypes(cmd.Context(), opts)
+			}
+			inward, outward, err := linkIssueKeys(args)
+			if err != nil {
+				return err
 			}
-			opts.InwardKey = args[0]
-			opts.OutwardKey = args[1]
+			opts.InwardKey = inward
+			opts.OutwardKey = outward
 			if opts.LinkType == "" {
 				return fmt.Errorf("--type flag is required\n\nUse 'atl issue link --list-types' to see available link types")
 			}
-			return runLink(opts)
+			return runLink(cmd.Context(), opts)
 		},
 	}
 
@@ -98,13 +108,12 @@ type LinkTypesOutput struct {
 	Types []*LinkTypeOutput `json:"types"`
 }
 
-func runLink(opts *LinkOptions) error {
+func runLink(ctx context.Context, opts *LinkOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	// Find the link type
@@ -127,6 +136,10 @@ func runLink(opts *LinkOptions) error {
 		return fmt.Errorf("link type not found: %s\n\nUse 'atl issue link --list-types' to see available types", opts.LinkType)
 	}
 
+	if _, err := lookupIssues(ctx, jira, []string{opts.InwardKey, opts.OutwardKey}); err != nil {
+		return err
+	}
+
 	// Create the link
 	err = jira.CreateIssueLink(ctx, opts.InwardKey, opts.OutwardKey, matchedType.Name)
 	if err != nil {
@@ -141,20 +154,32 @@ func runLink(opts *LinkOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, linkOutput)
+		return output.PrintJSON(opts.IO, linkOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Linked: %s %s %s\n", opts.InwardKey, matchedType.Outward, opts.OutwardKey)
 	return nil
 }
 
-func runListLinkTypes(opts *LinkOptions) error {
+// linkIssueKeys normalizes the two sides of a link, so either can be given
+// as a key or a browse URL.
+func linkIssueKeys(args []string) (inward, outward string, err error) {
+	keys, err := normalizeIssueKeys(args)
+	if err != nil {
+		return "", "", err
+	}
+	if strings.EqualFold(keys[0], keys[1]) {
+		return "", "", fmt.Errorf("cannot link %s to itself", keys[0])
+	}
+	return keys[0], keys[1], nil
+}
+
+func runListLinkTypes(ctx context.Context, opts *LinkOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	linkTypes, err := jira.GetIssueLinkTypes(ctx)
@@ -176,7 +201,7 @@ func runListLinkTypes(opts *LinkOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, typesOutput)
+		return output.PrintJSON(opts.IO, typesOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Available link types:\n\n")
@@ -0,0 +1,160 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// confluenceApplication marks a remote link as a Confluence page, so Jira
+// shows it with the Confluence icon alongside its own page links.
+var confluenceApplication = &api.RemoteLinkApp{Type: "com.atlassian.confluence", Name: "Confluence"}
+
+// LinkPageOptions holds the options for the link-page command.
+type LinkPageOptions struct {
+	IO           *iostreams.IOStreams
+	IssueKey     string
+	PageRef      string
+	Relationship string
+	Backlink     bool
+	JSON         bool
+}
+
+// NewCmdLinkPage creates the link-page command.
+func NewCmdLinkPage(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &LinkPageOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "link-page <issue-key> <page-id-or-url>",
+		Short: "Link a Jira issue to a Confluence page",
+		Long: `Add a remote link on an issue that points at a Confluence page.
+
+The link title is the page title, and the link is marked as a Confluence
+link so Jira shows it with the pages linked from Confluence. Linking the
+same page again updates the existing link instead of adding a duplicate.
+
+With --backlink, a footer comment linking back to the issue is also added
+to the page. A failed backlink is reported but keeps the issue link.`,
+		Example: `  # Link an issue to a page by URL
+  atl issue link-page PROJ-123 https://mycompany.atlassian.net/wiki/spaces/DOCS/pages/12345/Runbook
+
+  # By page ID, with a comment on the page pointing back to the issue
+  atl issue link-page PROJ-123 12345 --backlink
+
+  # Output as JSON
+  atl issue link-page PROJ-123 12345 --json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			opts.PageRef = args[1]
+			return runLinkPage(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Relationship, "relationship", "Wiki Page", "Relationship shown in Jira")
+	cmd.Flags().BoolVar(&opts.Backlink, "backlink", false, "Also comment on the page with a link to the issue")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// LinkPageOutput represents the result of linking an issue to a page.
+type LinkPageOutput struct {
+	IssueKey  string `json:"issue_key"`
+	LinkID    int    `json:"link_id"`
+	PageID    string `json:"page_id"`
+	PageTitle string `json:"page_title"`
+	PageURL   string `json:"page_url"`
+
+	// Set with --backlink. The issue link exists even if the backlink
+	// failed; BacklinkError then holds the reason.
+	BacklinkCommentID string `json:"backlink_comment_id,omitempty"`
+	BacklinkError     string `json:"backlink_error,omitempty"`
+}
+
+func runLinkPage(ctx context.Context, opts *LinkPageOptions) error {
+	pageID, err := api.ParsePageRef(opts.PageRef)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+	confluence := api.NewConfluenceService(client)
+
+	page, err := confluence.GetPage(ctx, pageID)
+	if err != nil {
+		return fmt.Errorf("failed to get page: %w", err)
+	}
+
+	linkOpts := pageRemoteLink(page, client.Hostname(), opts.Relationship)
+	link, err := jira.CreateRemoteLinkWithOptions(ctx, opts.IssueKey, linkOpts)
+	if err != nil {
+		return fmt.Errorf("failed to link %s to page: %w", opts.IssueKey, err)
+	}
+
+	linkOutput := &LinkPageOutput{
+		IssueKey:  opts.IssueKey,
+		LinkID:    link.ID,
+		PageID:    page.ID,
+		PageTitle: page.Title,
+		PageURL:   linkOpts.URL,
+	}
+
+	if opts.Backlink {
+		issueURL := client.BrowseURL(opts.IssueKey)
+		comment, err := confluence.AddPageFooterComment(ctx, page.ID, fmt.Sprintf("Linked from Jira issue [%s](%s)", opts.IssueKey, issueURL))
+		if err != nil {
+			linkOutput.BacklinkError = err.Error()
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: %s linked but failed to comment on the page: %s\n", opts.IssueKey, linkOutput.BacklinkError)
+		} else {
+			linkOutput.BacklinkCommentID = comment.ID
+		}
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, linkOutput)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Linked %s to page %q\n", opts.IssueKey, page.Title)
+	fmt.Fprintf(opts.IO.Out, "Link ID: %d\n", linkOutput.LinkID)
+	fmt.Fprintf(opts.IO.Out, "Page: %s\n", linkOutput.PageURL)
+	if linkOutput.BacklinkCommentID != "" {
+		fmt.Fprintf(opts.IO.Out, "Backlink comment: %s\n", linkOutput.BacklinkCommentID)
+	}
+
+	return nil
+}
+
+// pageRemoteLink builds the remote link for a Confluence page. The globalId
+// is derived from the page ID, so linking the same page twice updates the
+// existing link.
+func pageRemoteLink(page *api.Page, hostname, relationship string) *api.RemoteLinkOptions {
+	url := fmt.Sprintf("https://%s/wiki/pages/viewpage.action?pageId=%s", hostname, page.ID)
+	if page.Links != nil && page.Links.WebUI != "" {
+		url = fmt.Sprintf("https://%s/wiki%s", hostname, page.Links.WebUI)
+	}
+
+	return &api.RemoteLinkOptions{
+		URL:          url,
+		Title:        page.Title,
+		Relationship: relationship,
+		GlobalID:     "pageId=" + page.ID,
+		Application:  confluenceApplication,
+	}
+}
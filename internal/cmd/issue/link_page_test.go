@@ -0,0 +1,36 @@
+package issue
+
+import (
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestPageRemoteLink(t *testing.T) {
+	page := &api.Page{
+		ID:    "12345",
+		Title: "Runbook",
+		Links: &api.PageLinks{WebUI: "/spaces/DOCS/pages/12345/Runbook"},
+	}
+
+	got := pageRemoteLink(page, "example.atlassian.net", "Wiki Page")
+
+	if got.URL != "https://example.atlassian.net/wiki/spaces/DOCS/pages/12345/Runbook" {
+		t.Errorf("URL = %q, want the page's web UI URL", got.URL)
+	}
+	if got.Title != "Runbook" || got.Relationship != "Wiki Page" {
+		t.Errorf("Title = %q, Relationship = %q", got.Title, got.Relationship)
+	}
+	if got.GlobalID != "pageId=12345" {
+		t.Errorf("GlobalID = %q, want pageId=12345", got.GlobalID)
+	}
+	if got.Application == nil || got.Application.Type != "com.atlassian.confluence" {
+		t.Errorf("Application = %+v, want type com.atlassian.confluence", got.Application)
+	}
+
+	// Without a web UI link, the URL falls back to viewpage.action.
+	got = pageRemoteLink(&api.Page{ID: "12345", Title: "Runbook"}, "example.atlassian.net", "Wiki Page")
+	if got.URL != "https://example.atlassian.net/wiki/pages/viewpage.action?pageId=12345" {
+		t.Errorf("fallback URL = %q", got.URL)
+	}
+}
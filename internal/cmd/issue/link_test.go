@@ -0,0 +1,33 @@
+package issue
+
+import "testing"
+
+func TestLinkIssueKeys(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantInward  string
+		wantOutward string
+		wantErr     bool
+	}{
+		{name: "keys", args: []string{"PROJ-1", "PROJ-2"}, wantInward: "PROJ-1", wantOutward: "PROJ-2"},
+		{name: "url first", args: []string{"https://example.atlassian.net/browse/PROJ-1", "PROJ-2"}, wantInward: "PROJ-1", wantOutward: "PROJ-2"},
+		{name: "url second", args: []string{"PROJ-1", "https://example.atlassian.net/browse/PROJ-2?focusedCommentId=1"}, wantInward: "PROJ-1", wantOutward: "PROJ-2"},
+		{name: "urls both", args: []string{"https://example.atlassian.net/browse/PROJ-1", "https://example.atlassian.net/jira/software/projects/PROJ/boards/1?selectedIssue=PROJ-2"}, wantInward: "PROJ-1", wantOutward: "PROJ-2"},
+		{name: "invalid first", args: []string{"https://example.atlassian.net/wiki/spaces/DOCS", "PROJ-2"}, wantErr: true},
+		{name: "invalid second", args: []string{"PROJ-1", "not a key"}, wantErr: true},
+		{name: "same issue", args: []string{"PROJ-1", "https://example.atlassian.net/browse/proj-1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inward, outward, err := linkIssueKeys(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("linkIssueKeys(%q) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+			if inward != tt.wantInward || outward != tt.wantOutward {
+				t.Errorf("linkIssueKeys(%q) = %q, %q, want %q, %q", tt.args, inward, outward, tt.wantInward, tt.wantOutward)
+			}
+		})
+	}
+}
@@ -3,7 +3,10 @@ package issue
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -14,23 +17,58 @@ import (
 
 // ListOptions holds the options for the list command.
 type ListOptions struct {
-	IO        *iostreams.IOStreams
-	JQL       string
-	Project   string
-	Assignee  string
-	Status    string
-	Type      string
-	Limit     int
-	All       bool
-	JSON      bool
-	NextToken string // For cursor-based pagination
+	IO         *iostreams.IOStreams
+	JQL        string
+	Project    string
+	Assignee   string
+	Reporter   string
+	Status     string
+	Category   string
+	Type       string
+	Limit      int
+	All        bool
+	JSON       bool
+	NextToken  string // For cursor-based pagination
+	Watch      bool
+	Interval   time.Duration
+	Flagged    bool
+	Overdue    bool
+	NoTruncate bool
+	Output     string
+	CSVFlavor  string
+
+	// ContinueOnError keeps the issues fetched by --all when a page fails,
+	// reporting the failed page's token instead of failing the command.
+	ContinueOnError bool
+
+	// Fields overrides the search fields; nil uses the API defaults.
+	Fields []string
+
+	// CurrentSprint limits the list to open sprints; with BoardID, to the
+	// active sprints of that board.
+	CurrentSprint bool
+	BoardID       int
+
+	// FlaggedField is the resolved ID of the Flagged custom field (e.g.
+	// customfield_10021), looked up once when --flagged is set.
+	FlaggedField string
+
+	// AssigneeAccountID and ReporterAccountID are the account IDs that
+	// --assignee and --reporter names resolve to, looked up once.
+	AssigneeAccountID string
+	ReporterAccountID string
+
+	// SprintIDs are the board's active sprints, looked up once when
+	// --current-sprint is used with --board.
+	SprintIDs []int
 }
 
 // NewCmdList creates the list command.
 func NewCmdList(ios *iostreams.IOStreams) *cobra.Command {
 	opts := &ListOptions{
-		IO:    ios,
-		Limit: 50,
+		IO:       ios,
+		Limit:    50,
+		Interval: 30 * time.Second,
 	}
 
 	cmd := &cobra.Command{
@@ -40,7 +78,11 @@ func NewCmdList(ios *iostreams.IOStreams) *cobra.Command {
 		Long: `List and search for Jira issues using JQL or filters.
 
 By default, lists issues assigned to you. Use --project, --assignee, or --jql
-to specify different search criteria.`,
+to specify different search criteria.
+
+--assignee and --reporter accept @me, an email address, or a name. Names are
+resolved to an account ID first; if several users match, the candidates are
+listed so you can pick a more specific value.`,
 		Example: `  # List your issues (default)
   atl issue list
 
@@ -53,28 +95,116 @@ to specify different search criteria.`,
   # List open issues assigned to you
   atl issue list --assignee @me --status Open
 
+  # Everything in a project that is done, whatever the workflow calls it
+  atl issue list --project PROJ --category done
+
+  # List issues reported by a colleague
+  atl issue list --project PROJ --reporter jane@example.com
+
   # Get next page using token from previous result
   atl issue list --project PROJ --next-token "TOKEN_FROM_PREVIOUS_RESULT"
 
+  # Issues in any open sprint of a project
+  atl issue list --project PROJ --current-sprint
+
+  # Issues in the active sprint of board 42
+  atl issue list --board 42 --current-sprint
+
+  # List flagged or overdue issues in a project
+  atl issue list --project PROJ --flagged
+  atl issue list --project PROJ --overdue
+
   # Fetch all matching issues (may be slow for large result sets)
   atl issue list --project PROJ --all
 
+  # Resume an --all fetch that failed, from the token it reported
+  atl issue list --project PROJ --all --next-token "TOKEN_FROM_ERROR"
+
+  # Keep what was fetched if a later page fails (a failing first page is an error)
+  atl issue list --project PROJ --all --continue-on-error --json
+
   # Output as JSON for LLM processing
-  atl issue list --project PROJ --json`,
+  atl issue list --project PROJ --json
+
+  # Export to CSV for a spreadsheet
+  atl issue list --project PROJ --all -o csv > issues.csv
+
+  # Export in the layout Jira's CSV importer expects (for migrations)
+  atl issue list --jql "project = OLD" --all -o csv --csv-flavor jira > import.csv
+
+  # Re-run the query every 30 seconds, highlighting changed issues
+  atl issue list --jql "sprint in openSprints()" --watch --interval 30s`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runList(opts)
+			if err := validateListOutput(opts.Output, opts.CSVFlavor); err != nil {
+				return err
+			}
+			if opts.Output == outputJSON {
+				opts.JSON = true
+			}
+			if opts.Output == outputCSV {
+				if opts.JSON {
+					return fmt.Errorf("--json cannot be used with --output csv")
+				}
+				if opts.CSVFlavor == csvFlavorJira {
+					opts.Fields = jiraCSVFields
+				}
+			}
+			if opts.Category != "" {
+				category, err := normalizeStatusCategory(opts.Category)
+				if err != nil {
+					return err
+				}
+				opts.Category = category
+			}
+			if opts.ContinueOnError && !opts.All {
+				return fmt.Errorf("--continue-on-error requires --all")
+			}
+			if opts.BoardID != 0 && !opts.CurrentSprint {
+				return fmt.Errorf("--board requires --current-sprint")
+			}
+			if opts.CurrentSprint && opts.JQL != "" {
+				return fmt.Errorf("--current-sprint cannot be combined with --jql\n\nAdd \"sprint in openSprints()\" to the query instead")
+			}
+			if opts.Watch {
+				if opts.JSON || opts.Output == outputCSV {
+					return fmt.Errorf("--watch cannot be used with --json or --output csv")
+				}
+				if opts.Interval < time.Second {
+					return fmt.Errorf("--interval must be at least 1s")
+				}
+				if opts.IO.IsStdoutTTY {
+					return runListWatch(cmd.Context(), opts)
+				}
+				// Redrawing makes no sense in a pipe or log; list once instead.
+				fmt.Fprintln(opts.IO.ErrOut, "Warning: --watch needs an interactive terminal; listing once")
+				opts.Watch = false
+			}
+			return runList(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().StringVarP(&opts.JQL, "jql", "q", "", "JQL query to filter issues")
 	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Filter by project key")
-	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee (use @me for yourself)")
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee name or email (use @me for yourself)")
+	cmd.Flags().StringVarP(&opts.Reporter, "reporter", "r", "", "Filter by reporter name or email (use @me for yourself)")
 	cmd.Flags().StringVarP(&opts.Status, "status", "s", "", "Filter by status")
+	cmd.Flags().StringVar(&opts.Category, "category", "", "Filter by status category: new, indeterminate, or done")
 	cmd.Flags().StringVarP(&opts.Type, "type", "t", "", "Filter by issue type (e.g., Bug, Story, Task)")
+	cmd.Flags().BoolVar(&opts.Flagged, "flagged", false, "Only show flagged issues")
+	cmd.Flags().BoolVar(&opts.Overdue, "overdue", false, "Only show unresolved issues past their due date")
+	cmd.Flags().BoolVar(&opts.CurrentSprint, "current-sprint", false, "Only show issues in open sprints (the board's active sprint with --board)")
+	cmd.Flags().IntVar(&opts.BoardID, "board", 0, "Board ID whose active sprint --current-sprint uses")
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 50, "Maximum number of issues per page")
 	cmd.Flags().StringVar(&opts.NextToken, "next-token", "", "Pagination token for fetching next page")
-	cmd.Flags().BoolVar(&opts.All, "all", false, "Fetch all matching issues (ignores --limit)")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Fetch all matching issues (ignores --limit; starts at --next-token if given)")
+	cmd.Flags().BoolVar(&opts.ContinueOnError, "continue-on-error", false, "With --all, keep the issues fetched so far when a later page fails")
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", "Output format: table, json, or csv")
+	output.MarkFormatFlag(cmd.Flags(), "output")
+	cmd.Flags().StringVar(&opts.CSVFlavor, "csv-flavor", "", "CSV layout with --output csv: plain (default) or jira (Jira CSV importer columns)")
+	cmd.Flags().BoolVar(&opts.NoTruncate, "no-truncate", false, "Show full summaries instead of fitting the table to the terminal")
+	cmd.Flags().BoolVar(&opts.Watch, "watch", false, "Re-run the query on an interval until interrupted")
+	cmd.Flags().DurationVar(&opts.Interval, "interval", 30*time.Second, "Polling interval for --watch")
 
 	return cmd
 }
@@ -87,6 +217,10 @@ type IssueListOutput struct {
 	HasMore       bool             `json:"has_more"`
 	NextPageToken string           `json:"next_page_token,omitempty"`
 	JQL           string           `json:"jql"`
+
+	// issues are the search results the list items were built from, for
+	// output formats that need more than the list columns.
+	issues []*api.Issue
 }
 
 // IssueListItem represents a single issue in the list.
@@ -97,19 +231,144 @@ type IssueListItem struct {
 	Priority string `json:"priority,omitempty"`
 	Type     string `json:"type"`
 	Assignee string `json:"assignee,omitempty"`
+	Due      string `json:"due,omitempty"`
 	Created  string `json:"created"`
 	Updated  string `json:"updated"`
 }
 
-func runList(opts *ListOptions) error {
+func runList(ctx context.Context, opts *ListOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
+	listOutput, err := fetchIssueList(ctx, jira, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, listOutput)
+	}
+
+	if opts.Output == outputCSV {
+		if opts.CSVFlavor == csvFlavorJira {
+			return writeJiraImportCSV(opts.IO.Out, listOutput.issues)
+		}
+		return writeIssueListCSV(opts.IO.Out, listOutput)
+	}
+
+	printIssueList(opts, listOutput, nil)
+	return nil
+}
+
+// runListWatch re-runs the query every opts.Interval until interrupted,
+// redrawing the table and highlighting issues whose updated time changed.
+func runListWatch(ctx context.Context, opts *ListOptions) error {
+	// The screen is redrawn in place, which a pager would only buffer.
+	opts.IO.StopPager()
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	return watchIssueList(ctx, opts, func(ctx context.Context) (*IssueListOutput, error) {
+		return fetchIssueList(ctx, jira, opts)
+	})
+}
+
+// watchIssueList is the polling loop of runListWatch. A failed poll leaves
+// the last table on screen, warns, and is retried at the next interval;
+// only errors that a retry cannot fix, such as bad JQL or lost
+// credentials, end the watch.
+func watchIssueList(ctx context.Context, opts *ListOptions, fetch func(ctx context.Context) (*IssueListOutput, error)) error {
+	var previous map[string]string
+	for {
+		listOutput, err := fetch(ctx)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return nil
+		case err != nil && api.IsPermanentError(err):
+			return err
+		case err != nil:
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: %s: refresh failed, retrying in %s: %v\n",
+				time.Now().Format("2006-01-02 15:04:05"), opts.Interval, err)
+		default:
+			changed := diffIssueList(previous, listOutput.Issues)
+			previous = make(map[string]string, len(listOutput.Issues))
+			for _, issue := range listOutput.Issues {
+				previous[issue.Key] = issue.Updated
+			}
+
+			// Clear screen and move cursor home
+			fmt.Fprint(opts.IO.Out, "\033[H\033[2J")
+			fmt.Fprintf(opts.IO.Out, "Every %s: %s\n", opts.Interval, listOutput.JQL)
+			fmt.Fprintf(opts.IO.Out, "Last updated: %s (Ctrl-C to stop)\n\n", time.Now().Format("2006-01-02 15:04:05"))
+			printIssueList(opts, listOutput, changed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
+// diffIssueList returns the keys of issues that are new or whose updated
+// time differs from the previous poll. Returns nil on the first poll.
+func diffIssueList(previous map[string]string, issues []*IssueListItem) map[string]bool {
+	if previous == nil {
+		return nil
+	}
+
+	changed := make(map[string]bool)
+	for _, issue := range issues {
+		if updated, ok := previous[issue.Key]; !ok || updated != issue.Updated {
+			changed[issue.Key] = true
+		}
+	}
+	return changed
+}
+
+// fetchIssueList runs the search described by opts and converts the results
+// to list output.
+func fetchIssueList(ctx context.Context, jira *api.JiraService, opts *ListOptions) (*IssueListOutput, error) {
+	if opts.Flagged && opts.JQL == "" && opts.FlaggedField == "" {
+		field, err := jira.GetFlaggedField(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find Flagged field: %w", err)
+		}
+		if field == nil {
+			return nil, fmt.Errorf("no Flagged field found on this Jira instance")
+		}
+		opts.FlaggedField = field.ID
+	}
+
+	if opts.JQL == "" {
+		if err := resolveListUsers(ctx, jira, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.CurrentSprint && opts.BoardID != 0 && opts.SprintIDs == nil {
+		sprints, err := jira.GetSprints(ctx, opts.BoardID, "active")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sprints for board %d: %w", opts.BoardID, err)
+		}
+		if len(sprints) == 0 {
+			return nil, fmt.Errorf("board %d has no active sprint", opts.BoardID)
+		}
+		for _, s := range sprints {
+			opts.SprintIDs = append(opts.SprintIDs, s.ID)
+		}
+	}
+
 	// Build JQL query
 	jql := buildJQL(opts)
 
@@ -119,48 +378,52 @@ func runList(opts *ListOptions) error {
 	var isLast bool
 
 	if opts.All {
-		// Fetch all pages using cursor-based pagination
-		pageSize := 100 // Use larger page size for --all
-		var token string
-		for {
-			searchOpts := api.SearchOptions{
-				JQL:           jql,
-				MaxResults:    pageSize,
-				NextPageToken: token,
-			}
-			result, err := jira.Search(ctx, searchOpts)
-			if err != nil {
-				return fmt.Errorf("failed to search issues: %w", err)
-			}
-			if result.Total > 0 {
-				total = result.Total
-			}
-			allIssues = append(allIssues, result.Issues...)
-
-			if result.IsLast || result.NextPageToken == "" || len(result.Issues) == 0 {
-				break
+		// Fetch all pages using cursor-based pagination, starting at
+		// --next-token when resuming an earlier fetch
+		searchOpts := api.SearchOptions{
+			JQL:           jql,
+			MaxResults:    100, // Use larger page size for --all
+			NextPageToken: opts.NextToken,
+			Fields:        opts.Fields,
+		}
+		warnedRateLimit := false
+		// Progress would corrupt machine-readable output
+		showProgress := !opts.JSON && !opts.Watch && opts.Output != outputCSV
+		pages := fetchAllPages(ctx, jira.Search, searchOpts, func(fetched int, more bool) {
+			if msg := jira.LastRateLimit().Warning(); msg != "" && !warnedRateLimit {
+				fmt.Fprintf(opts.IO.ErrOut, "\nWarning: %s\n", msg)
+				warnedRateLimit = true
 			}
-			token = result.NextPageToken
-
 			// Progress indicator for large fetches
-			if !opts.JSON {
-				fmt.Fprintf(opts.IO.Out, "\rFetching issues... %d", len(allIssues))
+			if showProgress && more {
+				fmt.Fprintf(opts.IO.Out, "\rFetching issues... %d", fetched)
 			}
-		}
-		if !opts.JSON && len(allIssues) > 100 {
+		})
+		allIssues, total = pages.issues, pages.total
+		if showProgress && len(allIssues) > 100 {
 			fmt.Fprintln(opts.IO.Out, "") // Clear progress line
 		}
 		isLast = true
+
+		if err := pages.stopError(opts.ContinueOnError); err != nil {
+			return nil, err
+		}
+		if pages.err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: %s\n", pages.resumeError())
+			nextPageToken = pages.resumeToken
+			isLast = false
+		}
 	} else {
 		// Single page fetch
 		searchOpts := api.SearchOptions{
 			JQL:           jql,
 			MaxResults:    opts.Limit,
 			NextPageToken: opts.NextToken,
+			Fields:        opts.Fields,
 		}
 		result, err := jira.Search(ctx, searchOpts)
 		if err != nil {
-			return fmt.Errorf("failed to search issues: %w", err)
+			return nil, fmt.Errorf("failed to search issues: %w", err)
 		}
 		if result.Total > 0 {
 			total = result.Total
@@ -179,56 +442,135 @@ func runList(opts *ListOptions) error {
 		HasMore:       hasMore,
 		NextPageToken: nextPageToken,
 		JQL:           jql,
+		issues:        allIssues,
 	}
 
 	for _, issue := range allIssues {
-		item := &IssueListItem{
-			Key:     issue.Key,
-			Summary: issue.Fields.Summary,
-			Created: formatTime(issue.Fields.Created),
-			Updated: formatTime(issue.Fields.Updated),
-		}
+		listOutput.Issues = append(listOutput.Issues, newIssueListItem(issue))
+	}
 
-		if issue.Fields.Status != nil {
-			item.Status = issue.Fields.Status.Name
-		}
-		if issue.Fields.Priority != nil {
-			item.Priority = issue.Fields.Priority.Name
+	return listOutput, nil
+}
+
+// allPages is the result of fetching every page of a search.
+type allPages struct {
+	issues  []*api.Issue
+	total   int
+	fetched int // Pages fetched successfully
+
+	// err is the error of the page that failed, if any. resumeToken is that
+	// page's token, from which the fetch can be resumed with --next-token;
+	// it is empty if the first page failed.
+	err         error
+	resumeToken string
+}
+
+// resumeError describes a failed fetch, including how to resume it.
+func (p *allPages) resumeError() error {
+	if p.resumeToken == "" {
+		return fmt.Errorf("failed to search issues: %w", p.err)
+	}
+	return fmt.Errorf("failed to search issues after fetching %d: %w\n\nResume with --all --next-token %s (and the same filters)", len(p.issues), p.err, p.resumeToken)
+}
+
+// stopError returns the error a fetch stops with, or nil if it succeeded or
+// continues with the issues fetched so far. Continuing on error needs at
+// least one fetched page: a failing first page (bad JQL, no access) would
+// otherwise look like a search without results.
+func (p *allPages) stopError(continueOnError bool) error {
+	if p.err == nil || (continueOnError && p.fetched > 0) {
+		return nil
+	}
+	return p.resumeError()
+}
+
+// fetchAllPages fetches the pages of a search until the last one or the
+// first error, calling onPage after each page with the number of issues
+// fetched so far and whether more pages follow.
+func fetchAllPages(ctx context.Context, search func(context.Context, api.SearchOptions) (*api.SearchResult, error), searchOpts api.SearchOptions, onPage func(fetched int, more bool)) *allPages {
+	pages := &allPages{}
+	for {
+		result, err := search(ctx, searchOpts)
+		if err != nil {
+			pages.err = err
+			pages.resumeToken = searchOpts.NextPageToken
+			return pages
 		}
-		if issue.Fields.IssueType != nil {
-			item.Type = issue.Fields.IssueType.Name
+		if result.Total > 0 {
+			pages.total = result.Total
 		}
-		if issue.Fields.Assignee != nil {
-			item.Assignee = issue.Fields.Assignee.DisplayName
+		pages.issues = append(pages.issues, result.Issues...)
+		pages.fetched++
+
+		next, more := result.NextPage(searchOpts)
+		onPage(len(pages.issues), more)
+		if !more {
+			return pages
 		}
+		searchOpts = next
+	}
+}
 
-		listOutput.Issues = append(listOutput.Issues, item)
+// newIssueListItem converts an issue to its list representation.
+func newIssueListItem(issue *api.Issue) *IssueListItem {
+	item := &IssueListItem{
+		Key:     issue.Key,
+		Summary: issue.Fields.Summary,
+		Created: formatTime(issue.Fields.Created),
+		Updated: formatTime(issue.Fields.Updated),
+		Due:     issue.Fields.DueDate,
 	}
 
-	if opts.JSON {
-		return output.JSON(opts.IO.Out, listOutput)
+	if issue.Fields.Status != nil {
+		item.Status = issue.Fields.Status.Name
+	}
+	if issue.Fields.Priority != nil {
+		item.Priority = issue.Fields.Priority.Name
+	}
+	if issue.Fields.IssueType != nil {
+		item.Type = issue.Fields.IssueType.Name
+	}
+	if issue.Fields.Assignee != nil {
+		item.Assignee = issue.Fields.Assignee.DisplayName
 	}
 
+	return item
+}
+
+// printIssueList renders the issue table. Keys present in changed are
+// marked with "*" and highlighted.
+func printIssueList(opts *ListOptions, listOutput *IssueListOutput, changed map[string]bool) {
 	// Plain text output (LLM-friendly tabular format)
 	if len(listOutput.Issues) == 0 {
 		fmt.Fprintln(opts.IO.Out, "No issues found.")
-		return nil
+		return
 	}
 
 	// Header with pagination info
 	if opts.All {
-		fmt.Fprintf(opts.IO.Out, "Found %d issues\n\n", len(allIssues))
-	} else if total > 0 {
-		fmt.Fprintf(opts.IO.Out, "Showing %d of %d issues\n\n", len(allIssues), total)
+		fmt.Fprintf(opts.IO.Out, "Found %d issues\n\n", listOutput.Count)
+	} else if listOutput.Total > 0 {
+		fmt.Fprintf(opts.IO.Out, "Showing %d of %d issues\n\n", listOutput.Count, listOutput.Total)
 	} else {
-		fmt.Fprintf(opts.IO.Out, "Showing %d issues\n\n", len(allIssues))
+		fmt.Fprintf(opts.IO.Out, "Showing %d issues\n\n", listOutput.Count)
 	}
 
+	// On a terminal the table is fitted to its width below; elsewhere the
+	// summary is cut at a fixed length unless --no-truncate is given.
+	width := opts.IO.TerminalWidth()
+
 	// Table header
-	headers := []string{"KEY", "TYPE", "STATUS", "PRIORITY", "ASSIGNEE", "SUMMARY"}
+	headers := []string{"KEY", "TYPE", "STATUS", "PRIORITY", "ASSIGNEE", "DUE", "SUMMARY"}
 	rows := make([][]string, 0, len(listOutput.Issues))
 
 	for _, issue := range listOutput.Issues {
+		key := issue.Key
+		if changed[issue.Key] {
+			key = "*" + key
+			if opts.IO.ColorEnabled() {
+				key = output.Highlight.Render(key)
+			}
+		}
 		assignee := issue.Assignee
 		if assignee == "" {
 			assignee = "-"
@@ -237,30 +579,36 @@ func runList(opts *ListOptions) error {
 		if priority == "" {
 			priority = "-"
 		}
-		// Truncate summary for table display
+		due := issue.Due
+		if due == "" {
+			due = "-"
+		}
 		summary := issue.Summary
-		if len(summary) > 60 {
-			summary = summary[:57] + "..."
+		if !opts.NoTruncate && width == 0 {
+			summary = output.Truncate(summary, 60)
 		}
 		rows = append(rows, []string{
-			issue.Key,
+			key,
 			issue.Type,
 			issue.Status,
 			priority,
 			assignee,
+			due,
 			summary,
 		})
 	}
 
+	if !opts.NoTruncate {
+		output.FitColumns(headers, rows, width, len(headers)-1)
+	}
+
 	output.SimpleTable(opts.IO.Out, headers, rows)
 
 	// Show pagination hint
-	if hasMore {
+	if listOutput.HasMore && !opts.Watch {
 		fmt.Fprintln(opts.IO.Out, "")
 		fmt.Fprintln(opts.IO.Out, "More results available. Use --all to fetch everything, or use --json to get the next_page_token for pagination.")
 	}
-
-	return nil
 }
 
 func buildJQL(opts *ListOptions) string {
@@ -274,22 +622,39 @@ func buildJQL(opts *ListOptions) string {
 		clauses = append(clauses, fmt.Sprintf("project = %q", opts.Project))
 	}
 
-	if opts.Assignee != "" {
-		if opts.Assignee == "@me" {
-			clauses = append(clauses, "assignee = currentUser()")
-		} else {
-			clauses = append(clauses, fmt.Sprintf("assignee = %q", opts.Assignee))
-		}
+	if clause := userClause("assignee", opts.Assignee, opts.AssigneeAccountID); clause != "" {
+		clauses = append(clauses, clause)
+	}
+
+	if clause := userClause("reporter", opts.Reporter, opts.ReporterAccountID); clause != "" {
+		clauses = append(clauses, clause)
 	}
 
 	if opts.Status != "" {
 		clauses = append(clauses, fmt.Sprintf("status = %q", opts.Status))
 	}
 
+	if opts.Category != "" {
+		clauses = append(clauses, fmt.Sprintf("statusCategory = %q", opts.Category))
+	}
+
 	if opts.Type != "" {
 		clauses = append(clauses, fmt.Sprintf("issuetype = %q", opts.Type))
 	}
 
+	if opts.Flagged && opts.FlaggedField != "" {
+		// JQL addresses custom fields as cf[<number>]
+		clauses = append(clauses, fmt.Sprintf("cf[%s] is not EMPTY", strings.TrimPrefix(opts.FlaggedField, "customfield_")))
+	}
+
+	if opts.Overdue {
+		clauses = append(clauses, "duedate < now() AND resolution is EMPTY")
+	}
+
+	if clause := sprintClause(opts.CurrentSprint, opts.SprintIDs); clause != "" {
+		clauses = append(clauses, clause)
+	}
+
 	// The new /search/jql API requires bounded queries.
 	// Default to current user's issues if no filter is specified.
 	if len(clauses) == 0 {
@@ -298,3 +663,108 @@ func buildJQL(opts *ListOptions) string {
 
 	return strings.Join(clauses, " AND ") + " ORDER BY updated DESC"
 }
+
+// statusCategories are the status category keys every Jira workflow maps
+// its statuses to, so they filter the same way across projects.
+var statusCategories = []string{"new", "indeterminate", "done"}
+
+// normalizeStatusCategory validates a --category value and returns its key.
+func normalizeStatusCategory(category string) (string, error) {
+	key := strings.ToLower(strings.TrimSpace(category))
+	if !slices.Contains(statusCategories, key) {
+		return "", fmt.Errorf("invalid --category %q: must be one of %s", category, strings.Join(statusCategories, ", "))
+	}
+	return key, nil
+}
+
+// sprintClause builds the JQL clause for --current-sprint: the resolved
+// sprints of a board, or any open sprint when no board was given.
+func sprintClause(currentSprint bool, sprintIDs []int) string {
+	if !currentSprint {
+		return ""
+	}
+	if len(sprintIDs) == 0 {
+		return "sprint in openSprints()"
+	}
+	ids := make([]string, 0, len(sprintIDs))
+	for _, id := range sprintIDs {
+		ids = append(ids, strconv.Itoa(id))
+	}
+	return fmt.Sprintf("sprint in openSprints() AND sprint in (%s)", strings.Join(ids, ", "))
+}
+
+// userClause builds the JQL clause for a user field filter. A resolved
+// account ID is preferred over the raw value, which Jira matches unreliably.
+func userClause(field, value, accountID string) string {
+	switch {
+	case value == "":
+		return ""
+	case value == "@me":
+		return field + " = currentUser()"
+	case accountID != "":
+		return fmt.Sprintf("%s = %q", field, accountID)
+	default:
+		return fmt.Sprintf("%s = %q", field, value)
+	}
+}
+
+// resolveListUsers looks up the account IDs for --assignee and --reporter
+// names. Values already resolved (e.g. on a later --watch poll) are kept.
+func resolveListUsers(ctx context.Context, jira *api.JiraService, opts *ListOptions) error {
+	if opts.Assignee != "" && opts.Assignee != "@me" && opts.AssigneeAccountID == "" {
+		user, err := findUser(ctx, jira, opts.Assignee)
+		if err != nil {
+			return fmt.Errorf("--assignee: %w", err)
+		}
+		opts.AssigneeAccountID = user.AccountID
+	}
+	if opts.Reporter != "" && opts.Reporter != "@me" && opts.ReporterAccountID == "" {
+		user, err := findUser(ctx, jira, opts.Reporter)
+		if err != nil {
+			return fmt.Errorf("--reporter: %w", err)
+		}
+		opts.ReporterAccountID = user.AccountID
+	}
+	return nil
+}
+
+// findUser searches for a user by name or email and requires a single match.
+func findUser(ctx context.Context, jira *api.JiraService, query string) (*api.User, error) {
+	users, err := jira.SearchUsers(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for user: %w", err)
+	}
+	return matchUser(query, users)
+}
+
+// matchUser picks the user a query refers to. A single result is used as is;
+// otherwise exactly one user must match the email or display name exactly.
+func matchUser(query string, users []*api.User) (*api.User, error) {
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user not found: %s", query)
+	}
+	if len(users) == 1 {
+		return users[0], nil
+	}
+
+	var exact []*api.User
+	for _, u := range users {
+		if strings.EqualFold(u.EmailAddress, query) || strings.EqualFold(u.DisplayName, query) {
+			exact = append(exact, u)
+		}
+	}
+	if len(exact) == 1 {
+		return exact[0], nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%q matches %d users; use an email address or a more specific name:", query, len(users))
+	for _, u := range users {
+		if u.EmailAddress != "" {
+			fmt.Fprintf(&b, "\n  %s <%s>", u.DisplayName, u.EmailAddress)
+		} else {
+			fmt.Fprintf(&b, "\n  %s (%s)", u.DisplayName, u.AccountID)
+		}
+	}
+	return nil, fmt.Errorf("%s", b.String())
+}
@@ -0,0 +1,174 @@
+package issue
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+// Output formats for --output, and CSV flavors for --csv-flavor.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputCSV   = "csv"
+
+	csvFlavorPlain = "plain"
+	csvFlavorJira  = "jira"
+)
+
+// jiraCSVFields are the search fields fetched for a Jira import CSV: the list
+// defaults plus description, components, resolution, and parent.
+var jiraCSVFields = []string{
+	"summary", "status", "priority", "issuetype", "assignee", "reporter",
+	"created", "updated", "labels", "project", "duedate",
+	"description", "components", "resolution", "parent",
+}
+
+// writeIssueListCSV writes the list columns as CSV, one row per issue, with
+// the same names as the JSON output.
+func writeIssueListCSV(w io.Writer, listOutput *IssueListOutput) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"key", "type", "status", "priority", "assignee", "due", "created", "updated", "summary"}); err != nil {
+		return err
+	}
+	for _, issue := range listOutput.Issues {
+		if err := cw.Write([]string{issue.Key, issue.Type, issue.Status, issue.Priority, issue.Assignee, issue.Due, issue.Created, issue.Updated, issue.Summary}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeJiraImportCSV writes issues in the layout Jira's CSV importer expects:
+// its column names, and one repeated column per value of a multi-value field
+// (e.g. "Labels","Labels" for two labels).
+func writeJiraImportCSV(w io.Writer, issues []*api.Issue) error {
+	maxLabels, maxComponents := 0, 0
+	for _, issue := range issues {
+		maxLabels = max(maxLabels, len(issue.Fields.Labels))
+		maxComponents = max(maxComponents, len(issue.Fields.Components))
+	}
+
+	header := []string{
+		"Issue key", "Summary", "Issue Type", "Status", "Priority", "Resolution",
+		"Project key", "Assignee", "Reporter", "Created", "Updated", "Due Date", "Parent",
+	}
+	header = append(header, repeatColumn("Labels", maxLabels)...)
+	header = append(header, repeatColumn("Component/s", maxComponents)...)
+	header = append(header, "Description")
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		f := issue.Fields
+		row := []string{
+			issue.Key,
+			f.Summary,
+			"",
+			"",
+			"",
+			"",
+			"",
+			jiraCSVUser(f.Assignee),
+			jiraCSVUser(f.Reporter),
+			jiraCSVDate(f.Created),
+			jiraCSVDate(f.Updated),
+			jiraCSVDate(f.DueDate),
+			"",
+		}
+		if f.IssueType != nil {
+			row[2] = f.IssueType.Name
+		}
+		if f.Status != nil {
+			row[3] = f.Status.Name
+		}
+		if f.Priority != nil {
+			row[4] = f.Priority.Name
+		}
+		if f.Resolution != nil {
+			row[5] = f.Resolution.Name
+		}
+		if f.Project != nil {
+			row[6] = f.Project.Key
+		}
+		if f.Parent != nil {
+			row[12] = f.Parent.Key
+		}
+
+		labels := make([]string, maxLabels)
+		copy(labels, f.Labels)
+		row = append(row, labels...)
+
+		components := make([]string, maxComponents)
+		for i, c := range f.Components {
+			components[i] = c.Name
+		}
+		row = append(row, components...)
+
+		row = append(row, api.ADFToText(f.Description))
+
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// jiraCSVUser identifies a user for the importer, which matches users by
+// email address and falls back to the display name.
+func jiraCSVUser(u *api.User) string {
+	if u == nil {
+		return ""
+	}
+	if u.EmailAddress != "" {
+		return u.EmailAddress
+	}
+	return u.DisplayName
+}
+
+// jiraCSVDate converts a Jira timestamp or date to "2006-01-02 15:04:05"; set
+// the importer's date format to "yyyy-MM-dd HH:mm:ss" to match. Values that
+// can't be parsed are passed through.
+func jiraCSVDate(s string) string {
+	if s == "" {
+		return ""
+	}
+	if len(s) == len("2006-01-02") {
+		return s + " 00:00:00"
+	}
+	return formatTime(s)
+}
+
+// validateListOutput checks --output and --csv-flavor.
+func validateListOutput(output, csvFlavor string) error {
+	switch output {
+	case "", outputTable, outputJSON, outputCSV:
+	default:
+		return fmt.Errorf("invalid --output %q: must be table, json, or csv", output)
+	}
+	switch csvFlavor {
+	case "", csvFlavorPlain, csvFlavorJira:
+	default:
+		return fmt.Errorf("invalid --csv-flavor %q: must be plain or jira", csvFlavor)
+	}
+	if csvFlavor != "" && output != outputCSV {
+		return fmt.Errorf("--csv-flavor requires --output csv")
+	}
+	return nil
+}
+
+func repeatColumn(name string, n int) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = name
+	}
+	return out
+}
@@ -0,0 +1,93 @@
+package issue
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestWriteJiraImportCSV(t *testing.T) {
+	issues := []*api.Issue{
+		{
+			Key: "OLD-1",
+			Fields: api.IssueFields{
+				Summary:    "Login fails, sometimes",
+				IssueType:  &api.IssueType{Name: "Bug"},
+				Status:     &api.Status{Name: "To Do"},
+				Priority:   &api.Priority{Name: "High"},
+				Project:    &api.Project{Key: "OLD"},
+				Assignee:   &api.User{DisplayName: "Jane Doe", EmailAddress: "jane@example.com"},
+				Reporter:   &api.User{DisplayName: "John Roe"},
+				Created:    "2024-01-15T10:30:00.000+0000",
+				Updated:    "2024-01-16T08:00:00.000+0000",
+				DueDate:    "2024-02-01",
+				Labels:     []string{"auth", "customer", "p1"},
+				Components: []*api.Component{{Name: "Backend"}},
+			},
+		},
+		{
+			Key: "OLD-2",
+			Fields: api.IssueFields{
+				Summary:   "Add dark mode",
+				IssueType: &api.IssueType{Name: "Story"},
+				Parent:    &api.Issue{Key: "OLD-100"},
+				Labels:    []string{"ui"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := writeJiraImportCSV(&buf, issues); err != nil {
+		t.Fatalf("writeJiraImportCSV() error = %v", err)
+	}
+
+	want := strings.Join([]string{
+		"Issue key,Summary,Issue Type,Status,Priority,Resolution,Project key,Assignee,Reporter,Created,Updated,Due Date,Parent,Labels,Labels,Labels,Component/s,Description",
+		`OLD-1,"Login fails, sometimes",Bug,To Do,High,,OLD,jane@example.com,John Roe,2024-01-15 10:30:00,2024-01-16 08:00:00,2024-02-01 00:00:00,,auth,customer,p1,Backend,`,
+		"OLD-2,Add dark mode,Story,,,,,,,,,,OLD-100,ui,,,,",
+	}, "\n") + "\n"
+
+	if got := buf.String(); got != want {
+		t.Errorf("writeJiraImportCSV() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteIssueListCSV(t *testing.T) {
+	listOutput := &IssueListOutput{Issues: []*IssueListItem{
+		{Key: "PROJ-1", Type: "Task", Status: "Done", Summary: "Ship it"},
+	}}
+
+	var buf bytes.Buffer
+	if err := writeIssueListCSV(&buf, listOutput); err != nil {
+		t.Fatalf("writeIssueListCSV() error = %v", err)
+	}
+
+	want := "key,type,status,priority,assignee,due,created,updated,summary\nPROJ-1,Task,Done,,,,,,Ship it\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writeIssueListCSV() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestValidateListOutput(t *testing.T) {
+	tests := []struct {
+		output, flavor string
+		wantErr        bool
+	}{
+		{"", "", false},
+		{"csv", "", false},
+		{"csv", "jira", false},
+		{"json", "", false},
+		{"xml", "", true},
+		{"csv", "excel", true},
+		{"table", "jira", true},
+	}
+
+	for _, tt := range tests {
+		err := validateListOutput(tt.output, tt.flavor)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateListOutput(%q, %q) error = %v, wantErr %v", tt.output, tt.flavor, err, tt.wantErr)
+		}
+	}
+}
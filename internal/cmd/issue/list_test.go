@@ -0,0 +1,284 @@
+package issue
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/config"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func TestDiffIssueList(t *testing.T) {
+	issues := []*IssueListItem{
+		{Key: "PROJ-1", Updated: "2026-01-01 10:00:00"},
+		{Key: "PROJ-2", Updated: "2026-01-02 10:00:00"},
+		{Key: "PROJ-3", Updated: "2026-01-03 10:00:00"},
+	}
+
+	// First poll: nothing is highlighted.
+	if changed := diffIssueList(nil, issues); changed != nil {
+		t.Errorf("diffIssueList(nil) = %v, want nil", changed)
+	}
+
+	previous := map[string]string{
+		"PROJ-1": "2026-01-01 10:00:00", // unchanged
+		"PROJ-2": "2026-01-01 09:00:00", // updated since
+		// PROJ-3 is new
+	}
+
+	changed := diffIssueList(previous, issues)
+	if changed["PROJ-1"] {
+		t.Error("PROJ-1 should not be marked changed")
+	}
+	if !changed["PROJ-2"] {
+		t.Error("PROJ-2 should be marked changed (updated differs)")
+	}
+	if !changed["PROJ-3"] {
+		t.Error("PROJ-3 should be marked changed (new issue)")
+	}
+}
+
+func TestNewCmdListWatchFlags(t *testing.T) {
+	cmd := NewCmdList(nil)
+
+	if cmd.Flags().Lookup("watch") == nil {
+		t.Error("--watch flag should exist")
+	}
+	interval := cmd.Flags().Lookup("interval")
+	if interval == nil {
+		t.Fatal("--interval flag should exist")
+	}
+	if interval.DefValue != "30s" {
+		t.Errorf("--interval default = %q, want %q", interval.DefValue, "30s")
+	}
+}
+
+func TestListWatchWithoutTerminal(t *testing.T) {
+	t.Setenv(config.ConfigFileEnv, filepath.Join(t.TempDir(), "config.yaml"))
+
+	var errOut bytes.Buffer
+	ios := iostreams.Test()
+	ios.ErrOut = &errOut
+
+	cmd := NewCmdList(ios)
+	cmd.SetArgs([]string{"--project", "PROJ", "--watch"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	err := cmd.Execute()
+
+	if !strings.Contains(errOut.String(), "Warning: --watch needs an interactive terminal") {
+		t.Errorf("stderr = %q, want a --watch warning", errOut.String())
+	}
+	// Without a config the single listing fails to authenticate.
+	if err == nil || strings.Contains(err.Error(), "--watch") {
+		t.Errorf("Execute() error = %v, want the list's own error", err)
+	}
+}
+
+func TestWatchIssueListSurvivesFailedPolls(t *testing.T) {
+	var out, errOut bytes.Buffer
+	ios := iostreams.Test()
+	ios.Out = &out
+	ios.ErrOut = &errOut
+	opts := &ListOptions{IO: ios, Interval: time.Millisecond}
+
+	badJQL := &api.APIError{StatusCode: 400, Status: "400 Bad Request"}
+	results := []error{nil, &api.APIError{StatusCode: 503, Status: "503 Service Unavailable"}, errors.New("connection reset"), nil, badJQL}
+	polls := 0
+	fetch := func(ctx context.Context) (*IssueListOutput, error) {
+		err := results[polls]
+		polls++
+		if err != nil {
+			return nil, err
+		}
+		return &IssueListOutput{JQL: "project = PROJ", Issues: []*IssueListItem{{Key: "PROJ-1", Status: "Open", Type: "Task"}}}, nil
+	}
+
+	err := watchIssueList(context.Background(), opts, fetch)
+	if !errors.Is(err, badJQL) {
+		t.Errorf("watchIssueList() error = %v, want the 400", err)
+	}
+	if polls != len(results) {
+		t.Errorf("polls = %d, want %d", polls, len(results))
+	}
+	if got := strings.Count(errOut.String(), "refresh failed"); got != 2 {
+		t.Errorf("stderr = %q, want 2 refresh warnings", errOut.String())
+	}
+	if got := strings.Count(out.String(), "Last updated:"); got != 2 {
+		t.Errorf("drew the table %d times, want 2", got)
+	}
+}
+
+func TestWatchIssueListStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	opts := &ListOptions{IO: iostreams.Test(), Interval: time.Hour}
+
+	err := watchIssueList(ctx, opts, func(ctx context.Context) (*IssueListOutput, error) {
+		cancel()
+		return nil, ctx.Err()
+	})
+	if err != nil {
+		t.Errorf("watchIssueList() error = %v, want nil after Ctrl-C", err)
+	}
+}
+
+func TestBuildJQL(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *ListOptions
+		want string
+	}{
+		{
+			name: "default",
+			opts: &ListOptions{},
+			want: "assignee = currentUser() ORDER BY updated DESC",
+		},
+		{
+			name: "project with flagged and overdue",
+			opts: &ListOptions{Project: "PROJ", Flagged: true, Overdue: true, FlaggedField: "customfield_10021"},
+			want: `project = "PROJ" AND cf[10021] is not EMPTY AND duedate < now() AND resolution is EMPTY ORDER BY updated DESC`,
+		},
+		{
+			name: "overdue alone replaces the default assignee filter",
+			opts: &ListOptions{Overdue: true},
+			want: "duedate < now() AND resolution is EMPTY ORDER BY updated DESC",
+		},
+		{
+			name: "resolved assignee and reporter use account IDs",
+			opts: &ListOptions{
+				Project:           "PROJ",
+				Assignee:          "Jane Doe",
+				AssigneeAccountID: "5b10ac8d82e05b22cc7d4ef5",
+				Reporter:          "@me",
+			},
+			want: `project = "PROJ" AND assignee = "5b10ac8d82e05b22cc7d4ef5" AND reporter = currentUser() ORDER BY updated DESC`,
+		},
+		{
+			name: "current sprint without a board",
+			opts: &ListOptions{Project: "PROJ", CurrentSprint: true},
+			want: `project = "PROJ" AND sprint in openSprints() ORDER BY updated DESC`,
+		},
+		{
+			name: "current sprint resolved from a board",
+			opts: &ListOptions{CurrentSprint: true, BoardID: 42, SprintIDs: []int{137}},
+			want: "sprint in openSprints() AND sprint in (137) ORDER BY updated DESC",
+		},
+		{
+			name: "board with parallel active sprints",
+			opts: &ListOptions{CurrentSprint: true, BoardID: 42, SprintIDs: []int{137, 138}},
+			want: "sprint in openSprints() AND sprint in (137, 138) ORDER BY updated DESC",
+		},
+		{
+			name: "status category with project",
+			opts: &ListOptions{Project: "PROJ", Category: "done"},
+			want: `project = "PROJ" AND statusCategory = "done" ORDER BY updated DESC`,
+		},
+		{
+			name: "explicit JQL wins",
+			opts: &ListOptions{JQL: "status = Open", Overdue: true},
+			want: "status = Open",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildJQL(tt.opts); got != tt.want {
+				t.Errorf("buildJQL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchAllPagesReportsResumeToken(t *testing.T) {
+	var requested []string
+	search := func(_ context.Context, opts api.SearchOptions) (*api.SearchResult, error) {
+		requested = append(requested, opts.NextPageToken)
+		if opts.NextPageToken == "page-2" {
+			return nil, errors.New("connection reset by peer")
+		}
+		return &api.SearchResult{
+			Issues:        []*api.Issue{{Key: "PROJ-1"}, {Key: "PROJ-2"}},
+			NextPageToken: "page-2",
+		}, nil
+	}
+
+	pages := fetchAllPages(context.Background(), search, api.SearchOptions{JQL: "project = PROJ"}, func(int, bool) {})
+
+	if len(requested) != 2 {
+		t.Fatalf("requested pages %v, want 2 requests", requested)
+	}
+	if pages.err == nil {
+		t.Fatal("expected the second page's error")
+	}
+	if len(pages.issues) != 2 {
+		t.Errorf("kept %d issues, want the 2 from the first page", len(pages.issues))
+	}
+	if pages.resumeToken != "page-2" {
+		t.Errorf("resumeToken = %q, want page-2", pages.resumeToken)
+	}
+	if err := pages.resumeError(); !strings.Contains(err.Error(), "--next-token page-2") {
+		t.Errorf("resumeError() = %q, want it to mention --next-token page-2", err)
+	}
+	if err := pages.stopError(true); err != nil {
+		t.Errorf("stopError(true) = %v, want to continue after a fetched page", err)
+	}
+	if err := pages.stopError(false); err == nil {
+		t.Error("stopError(false) should return the error")
+	}
+}
+
+func TestFetchAllPagesFirstPageError(t *testing.T) {
+	search := func(context.Context, api.SearchOptions) (*api.SearchResult, error) {
+		return nil, errors.New("unauthorized")
+	}
+
+	pages := fetchAllPages(context.Background(), search, api.SearchOptions{}, func(int, bool) {})
+
+	if err := pages.resumeError(); strings.Contains(err.Error(), "--next-token") {
+		t.Errorf("resumeError() = %q, should not offer to resume before any page", err)
+	}
+	if err := pages.stopError(true); err == nil {
+		t.Error("stopError(true) should fail when no page was fetched, not return an empty list")
+	}
+}
+
+func TestNormalizeStatusCategory(t *testing.T) {
+	got, err := normalizeStatusCategory(" Done ")
+	if err != nil || got != "done" {
+		t.Errorf("normalizeStatusCategory(Done) = %q, %v, want done", got, err)
+	}
+	if _, err := normalizeStatusCategory("closed"); err == nil {
+		t.Error("normalizeStatusCategory(closed) should return an error")
+	}
+}
+
+func TestMatchUser(t *testing.T) {
+	jane := &api.User{AccountID: "1", DisplayName: "Jane Doe", EmailAddress: "jane@example.com"}
+	janet := &api.User{AccountID: "2", DisplayName: "Janet Doe", EmailAddress: "janet@example.com"}
+
+	if u, err := matchUser("jane", []*api.User{jane}); err != nil || u != jane {
+		t.Errorf("single result: got %v, %v", u, err)
+	}
+	if u, err := matchUser("JANE@example.com", []*api.User{janet, jane}); err != nil || u != jane {
+		t.Errorf("exact email: got %v, %v", u, err)
+	}
+	if _, err := matchUser("nobody", nil); err == nil {
+		t.Error("no results should be an error")
+	}
+
+	_, err := matchUser("Doe", []*api.User{jane, janet})
+	if err == nil {
+		t.Fatal("ambiguous query should be an error")
+	}
+	for _, want := range []string{"Jane Doe <jane@example.com>", "Janet Doe <janet@example.com>"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should list candidate %q", err, want)
+		}
+	}
+}
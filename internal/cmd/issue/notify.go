@@ -0,0 +1,22 @@
+package issue
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+// noNotifyFlagUsage is the help text shared by commands with --no-notify.
+const noNotifyFlagUsage = "Don't email watchers about this change (requires project admin permission)"
+
+// explainNoNotifyError adds a hint when Jira rejects --no-notify because
+// the user lacks project admin permission.
+func explainNoNotifyError(err error, noNotify bool) error {
+	var apiErr *api.APIError
+	if noNotify && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("%w\n\n--no-notify requires project admin permission; retry without it", err)
+	}
+	return err
+}
@@ -0,0 +1,110 @@
+package issue
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// pickLimit is how many issues the interactive picker offers.
+const pickLimit = 20
+
+// canPickIssue reports whether an issue can be chosen interactively.
+// Both stdin and stdout must be terminals so scripts never block on a prompt.
+func canPickIssue(ios *iostreams.IOStreams) bool {
+	return ios != nil && ios.IsStdinTTY && ios.IsStdoutTTY
+}
+
+// pickIssue runs the default issue list query (issues assigned to you, most
+// recently updated first) and asks the user to choose one.
+func pickIssue(ctx context.Context, ios *iostreams.IOStreams) (string, error) {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return "", err
+	}
+
+	jira := api.NewJiraService(client)
+
+	listOutput, err := fetchIssueList(ctx, jira, &ListOptions{IO: ios, Limit: pickLimit})
+	if err != nil {
+		return "", err
+	}
+	if len(listOutput.Issues) == 0 {
+		return "", fmt.Errorf("no issues assigned to you\n\nPass an issue key explicitly")
+	}
+
+	ios.StopPager()
+
+	type result struct {
+		key string
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		key, err := promptIssueChoice(ios.In, ios.Out, listOutput.Issues)
+		done <- result{key, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		fmt.Fprintln(ios.Out)
+		return "", ctx.Err()
+	case r := <-done:
+		return r.key, r.err
+	}
+}
+
+// promptIssueChoice prints a numbered list of issues and reads a selection.
+// The user can enter a number from the list or type an issue key or paste
+// an issue URL directly.
+func promptIssueChoice(in io.Reader, out io.Writer, issues []*IssueListItem) (string, error) {
+	headers := []string{"#", "KEY", "STATUS", "SUMMARY"}
+	rows := make([][]string, 0, len(issues))
+	for i, issue := range issues {
+		summary := issue.Summary
+		if len(summary) > 60 {
+			summary = summary[:57] + "..."
+		}
+		rows = append(rows, []string{strconv.Itoa(i + 1), issue.Key, issue.Status, summary})
+	}
+	output.SimpleTable(out, headers, rows)
+	fmt.Fprintln(out)
+
+	reader := bufio.NewReader(in)
+	for {
+		fmt.Fprintf(out, "Select an issue [1-%d] or enter a key (q to quit): ", len(issues))
+		answer, err := reader.ReadString('\n')
+		answer = strings.TrimSpace(answer)
+		if answer == "" && err != nil {
+			return "", fmt.Errorf("no issue selected")
+		}
+
+		switch {
+		case answer == "":
+			continue
+		case strings.EqualFold(answer, "q"):
+			return "", fmt.Errorf("no issue selected")
+		}
+
+		if n, convErr := strconv.Atoi(answer); convErr == nil {
+			if n >= 1 && n <= len(issues) {
+				return issues[n-1].Key, nil
+			}
+			fmt.Fprintf(out, "Enter a number between 1 and %d\n", len(issues))
+			continue
+		}
+
+		key, keyErr := api.NormalizeIssueKey(answer)
+		if keyErr == nil {
+			return key, nil
+		}
+		fmt.Fprintf(out, "Not a list number or issue key: %s\n", answer)
+	}
+}
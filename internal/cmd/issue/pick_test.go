@@ -0,0 +1,60 @@
+package issue
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func TestPromptIssueChoice(t *testing.T) {
+	issues := []*IssueListItem{
+		{Key: "PROJ-1", Status: "To Do", Summary: "First"},
+		{Key: "PROJ-2", Status: "In Progress", Summary: "Second"},
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"by number", "2\n", "PROJ-2", false},
+		{"retry after out of range", "5\n1\n", "PROJ-1", false},
+		{"typed key", "OTHER-9\n", "OTHER-9", false},
+		{"pasted URL", "https://example.atlassian.net/browse/OTHER-9\n", "OTHER-9", false},
+		{"retry after invalid key", "not-a-key\nOTHER-9\n", "OTHER-9", false},
+		{"quit", "q\n", "", true},
+		{"eof", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			got, err := promptIssueChoice(strings.NewReader(tt.input), out, issues)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("promptIssueChoice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("promptIssueChoice() = %q, want %q", got, tt.want)
+			}
+			if !strings.Contains(out.String(), "PROJ-2") {
+				t.Errorf("list output missing issues: %s", out.String())
+			}
+		})
+	}
+}
+
+func TestCanPickIssue(t *testing.T) {
+	ios := iostreams.Test()
+	if canPickIssue(ios) {
+		t.Error("canPickIssue() should be false without a terminal")
+	}
+
+	ios.IsStdinTTY = true
+	ios.IsStdoutTTY = true
+	if !canPickIssue(ios) {
+		t.Error("canPickIssue() should be true when stdin and stdout are terminals")
+	}
+}
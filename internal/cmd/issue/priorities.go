@@ -35,7 +35,7 @@ Use this to find the correct priority name when creating or editing issues.`,
   # Output as JSON
   atl issue priorities --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runPriorities(opts)
+			return runPriorities(cmd.Context(), opts)
 		},
 	}
 
@@ -57,13 +57,12 @@ type PrioritiesOutput struct {
 	Total      int               `json:"total"`
 }
 
-func runPriorities(opts *PrioritiesOptions) error {
+func runPriorities(ctx context.Context, opts *PrioritiesOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	priorities, err := jira.GetPriorities(ctx)
@@ -85,7 +84,7 @@ func runPriorities(opts *PrioritiesOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, prioritiesOutput)
+		return output.PrintJSON(opts.IO, prioritiesOutput)
 	}
 
 	if len(prioritiesOutput.Priorities) == 0 {
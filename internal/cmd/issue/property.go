@@ -0,0 +1,200 @@
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// PropertyOptions holds the options for the property command.
+type PropertyOptions struct {
+	IO       *iostreams.IOStreams
+	IssueKey string
+	List     bool
+	Get      string
+	Set      string
+	Delete   string
+	JSON     bool
+}
+
+// NewCmdProperty creates the property command.
+func NewCmdProperty(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &PropertyOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "property <issue-key>",
+		Short: "Get, set, or delete issue properties",
+		Long: `Manage entity properties on a Jira issue.
+
+Properties are JSON values that integrations and automation store on an issue
+under a key. They are not shown in the Jira UI.
+
+The value for --set is JSON given inline, read from a file with @file, or
+read from stdin with @-. It must parse as JSON before it is sent.`,
+		Example: `  # List property keys on an issue
+  atl issue property PROJ-123 --list
+
+  # Show a property value
+  atl issue property PROJ-123 --get deploy-info
+
+  # Set a property from inline JSON
+  atl issue property PROJ-123 --set 'deploy-info={"env":"prod","build":42}'
+
+  # Set a property from a file
+  atl issue property PROJ-123 --set deploy-info=@value.json
+
+  # Delete a property
+  atl issue property PROJ-123 --delete deploy-info`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+
+			modes := 0
+			for _, set := range []bool{opts.List, opts.Get != "", opts.Set != "", opts.Delete != ""} {
+				if set {
+					modes++
+				}
+			}
+			if modes != 1 {
+				return fmt.Errorf("specify exactly one of --list, --get, --set, or --delete")
+			}
+
+			switch {
+			case opts.List:
+				return runPropertyList(cmd.Context(), opts)
+			case opts.Get != "":
+				return runPropertyGet(cmd.Context(), opts)
+			case opts.Delete != "":
+				return runPropertyDelete(cmd.Context(), opts)
+			default:
+				return runPropertySet(cmd.Context(), opts)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.List, "list", "l", false, "List property keys")
+	cmd.Flags().StringVarP(&opts.Get, "get", "g", "", "Show the value of a property")
+	cmd.Flags().StringVarP(&opts.Set, "set", "s", "", "Set a property: name=JSON, name=@file, or name=@-")
+	cmd.Flags().StringVarP(&opts.Delete, "delete", "d", "", "Delete a property")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// PropertyOutput represents a property in output.
+type PropertyOutput struct {
+	IssueKey string          `json:"issue_key"`
+	Key      string          `json:"key"`
+	Value    json.RawMessage `json:"value,omitempty"`
+	Action   string          `json:"action,omitempty"`
+}
+
+// PropertyListOutput represents the property keys on an issue.
+type PropertyListOutput struct {
+	IssueKey string   `json:"issue_key"`
+	Keys     []string `json:"keys"`
+}
+
+func runPropertyList(ctx context.Context, opts *PropertyOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	keys, err := jira.GetIssuePropertyKeys(ctx, opts.IssueKey)
+	if err != nil {
+		return fmt.Errorf("failed to list properties: %w", err)
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, &PropertyListOutput{IssueKey: opts.IssueKey, Keys: keys})
+	}
+
+	if len(keys) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No properties on %s\n", opts.IssueKey)
+		return nil
+	}
+	for _, k := range keys {
+		fmt.Fprintln(opts.IO.Out, k)
+	}
+	return nil
+}
+
+func runPropertyGet(ctx context.Context, opts *PropertyOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	property, err := jira.GetIssueProperty(ctx, opts.IssueKey, opts.Get)
+	if err != nil {
+		return fmt.Errorf("failed to get property %s: %w", opts.Get, err)
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, &PropertyOutput{IssueKey: opts.IssueKey, Key: property.Key, Value: property.Value})
+	}
+
+	return output.PrintJSON(opts.IO, property.Value)
+}
+
+func runPropertySet(ctx context.Context, opts *PropertyOptions) error {
+	key, value, err := opts.IO.ReadJSONAssignment(opts.Set)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	if err := jira.SetIssueProperty(ctx, opts.IssueKey, key, value); err != nil {
+		return fmt.Errorf("failed to set property %s: %w", key, err)
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, &PropertyOutput{IssueKey: opts.IssueKey, Key: key, Value: value, Action: "set"})
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Set property %s on %s\n", key, opts.IssueKey)
+	return nil
+}
+
+func runPropertyDelete(ctx context.Context, opts *PropertyOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	if err := jira.DeleteIssueProperty(ctx, opts.IssueKey, opts.Delete); err != nil {
+		return fmt.Errorf("failed to delete property %s: %w", opts.Delete, err)
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, &PropertyOutput{IssueKey: opts.IssueKey, Key: opts.Delete, Action: "deleted"})
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Deleted property %s from %s\n", opts.Delete, opts.IssueKey)
+	return nil
+}
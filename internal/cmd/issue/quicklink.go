@@ -0,0 +1,127 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+var (
+	// duplicateLinkType is the standard Jira link type for duplicates.
+	duplicateLinkType = wellKnownLinkType{Name: "Duplicate", Outward: "duplicates", Inward: "duplicated by"}
+
+	// relatesLinkType is the standard Jira link type for related issues.
+	relatesLinkType = wellKnownLinkType{Name: "Relates", Outward: "relates to", Inward: "relates to"}
+)
+
+// QuickLinkOptions holds the options for the dup and relates commands.
+type QuickLinkOptions struct {
+	IO       *iostreams.IOStreams
+	IssueKey string
+	OtherKey string
+	JSON     bool
+}
+
+// NewCmdDup creates the dup command.
+func NewCmdDup(ios *iostreams.IOStreams) *cobra.Command {
+	return newCmdQuickLink(ios, duplicateLinkType, &cobra.Command{
+		Use:   "dup <issue-key> <original-key>",
+		Short: "Mark an issue as a duplicate of another",
+		Long: `Create a "duplicates" link from an issue to the original without knowing
+the link type name.
+
+The Duplicate-style link type is discovered from your Jira instance by
+matching its descriptions ("duplicates" / "is duplicated by"), so this
+works even when the type has been renamed.`,
+		Example: `  # PROJ-2 duplicates PROJ-1
+  atl issue dup PROJ-2 PROJ-1
+
+  # Output as JSON
+  atl issue dup PROJ-2 PROJ-1 --json`,
+	})
+}
+
+// NewCmdRelates creates the relates command.
+func NewCmdRelates(ios *iostreams.IOStreams) *cobra.Command {
+	return newCmdQuickLink(ios, relatesLinkType, &cobra.Command{
+		Use:   "relates <issue-key> <other-key>",
+		Short: "Link two related issues",
+		Long: `Create a "relates to" link between two issues without knowing the link
+type name.
+
+The Relates-style link type is discovered from your Jira instance by
+matching its descriptions ("relates to"), so this works even when the type
+has been renamed.`,
+		Example: `  # PROJ-1 relates to PROJ-5
+  atl issue relates PROJ-1 PROJ-5
+
+  # Issue URLs work too
+  atl issue relates PROJ-1 https://mycompany.atlassian.net/browse/PROJ-5`,
+	})
+}
+
+// newCmdQuickLink completes a command that links two issues with a
+// well-known link type.
+func newCmdQuickLink(ios *iostreams.IOStreams, linkType wellKnownLinkType, cmd *cobra.Command) *cobra.Command {
+	opts := &QuickLinkOptions{
+		IO: ios,
+	}
+
+	cmd.Args = cobra.ExactArgs(2)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		key, other, err := linkIssueKeys(args)
+		if err != nil {
+			return err
+		}
+		opts.IssueKey = key
+		opts.OtherKey = other
+		return runQuickLink(cmd.Context(), opts, linkType)
+	}
+
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+func runQuickLink(ctx context.Context, opts *QuickLinkOptions, want wellKnownLinkType) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	linkTypes, err := jira.GetIssueLinkTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get link types: %w", err)
+	}
+
+	linkType := findLinkType(linkTypes, want)
+	if linkType == nil {
+		return linkTypeNotFoundError(want, linkTypes)
+	}
+
+	// Same argument order as 'atl issue link': <issue> <outward> <other>
+	if err := jira.CreateIssueLink(ctx, opts.IssueKey, opts.OtherKey, linkType.Name); err != nil {
+		return fmt.Errorf("failed to create link: %w", err)
+	}
+
+	linkOutput := &LinkOutput{
+		InwardIssue:  opts.IssueKey,
+		OutwardIssue: opts.OtherKey,
+		LinkType:     linkType.Name,
+		Message:      fmt.Sprintf("%s %s %s", opts.IssueKey, linkType.Outward, opts.OtherKey),
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, linkOutput)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Linked: %s\n", linkOutput.Message)
+	return nil
+}
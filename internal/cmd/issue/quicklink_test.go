@@ -0,0 +1,57 @@
+package issue
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestFindWellKnownLinkType(t *testing.T) {
+	blocks := &api.IssueLinkType{ID: "1", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"}
+
+	tests := map[wellKnownLinkType]struct {
+		standard, renamed, nameOnly *api.IssueLinkType
+	}{
+		duplicateLinkType: {
+			standard: &api.IssueLinkType{ID: "2", Name: "Duplicate", Inward: "is duplicated by", Outward: "duplicates"},
+			renamed:  &api.IssueLinkType{ID: "3", Name: "Dupes", Inward: "is duplicated by", Outward: "duplicates"},
+			nameOnly: &api.IssueLinkType{ID: "4", Name: "duplicate", Inward: "is copied by", Outward: "copies"},
+		},
+		relatesLinkType: {
+			standard: &api.IssueLinkType{ID: "2", Name: "Relates", Inward: "relates to", Outward: "relates to"},
+			renamed:  &api.IssueLinkType{ID: "3", Name: "Related", Inward: "relates to", Outward: "Relates to"},
+			nameOnly: &api.IssueLinkType{ID: "4", Name: "Relates", Inward: "is associated with", Outward: "is associated with"},
+		},
+	}
+
+	for want, types := range tests {
+		cases := []struct {
+			name      string
+			linkTypes []*api.IssueLinkType
+			want      *api.IssueLinkType
+		}{
+			{"standard", []*api.IssueLinkType{blocks, types.standard}, types.standard},
+			{"renamed type", []*api.IssueLinkType{blocks, types.renamed}, types.renamed},
+			{"descriptions win over name", []*api.IssueLinkType{types.nameOnly, types.renamed}, types.renamed},
+			{"name fallback", []*api.IssueLinkType{blocks, types.nameOnly}, types.nameOnly},
+			{"none", []*api.IssueLinkType{blocks}, nil},
+		}
+		for _, tt := range cases {
+			t.Run(want.Name+"/"+tt.name, func(t *testing.T) {
+				if got := findLinkType(tt.linkTypes, want); got != tt.want {
+					t.Errorf("findLinkType(%s) = %v, want %v", want.Name, got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestLinkTypeNotFoundError(t *testing.T) {
+	linkTypes := []*api.IssueLinkType{{Name: "Blocks", Inward: "is blocked by", Outward: "blocks"}}
+
+	err := linkTypeNotFoundError(duplicateLinkType, linkTypes)
+	if !strings.Contains(err.Error(), "no Duplicate-style link type") || !strings.Contains(err.Error(), "Blocks (blocks / is blocked by)") {
+		t.Errorf("error = %q, want the missing type and the available types", err)
+	}
+}
@@ -0,0 +1,216 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// RankOptions holds the options for the rank command.
+type RankOptions struct {
+	IO        *iostreams.IOStreams
+	IssueKeys []string
+	Top       bool
+	Bottom    bool
+	Before    string
+	After     string
+	BoardID   int
+	JSON      bool
+}
+
+// NewCmdRank creates the rank command.
+func NewCmdRank(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &RankOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rank <issue-key> [issue-key...]",
+		Short: "Move issues in the backlog order",
+		Long: `Change the rank of issues, which orders boards and backlogs.
+
+Issues are moved to the top or bottom of a board (--board is required), or
+directly before or after another issue. Several issues are kept in the
+order given.
+
+The top and bottom are the first and last issues of the board. Ranking on
+a board without issues fails, as there is nothing to rank against.
+
+'atl issue rank' and 'atl board rank' are the same command; --board-id is
+accepted as another name for --board.`,
+		Example: `  # Move issues to the top of the backlog
+  atl issue rank PROJ-123 PROJ-124 --top --board 42
+
+  # Move an issue to the bottom
+  atl issue rank PROJ-123 --bottom --board 42
+
+  # Rank an issue directly before or after another
+  atl issue rank PROJ-123 --before PROJ-456
+  atl issue rank PROJ-123 --after PROJ-456
+
+  # Output as JSON
+  atl issue rank PROJ-123 --top --board 42 --json`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keys, err := normalizeIssueKeys(args)
+			if err != nil {
+				return err
+			}
+			opts.IssueKeys = keys
+			for _, target := range []*string{&opts.Before, &opts.After} {
+				if *target == "" {
+					continue
+				}
+				if *target, err = api.NormalizeIssueKey(*target); err != nil {
+					return err
+				}
+			}
+			if _, err := rankPosition(opts); err != nil {
+				return err
+			}
+			return runRank(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Top, "top", false, "Move issues to the top of the board")
+	cmd.Flags().BoolVar(&opts.Bottom, "bottom", false, "Move issues to the bottom of the board")
+	cmd.Flags().StringVar(&opts.Before, "before", "", "Rank issues directly before this issue")
+	cmd.Flags().StringVar(&opts.After, "after", "", "Rank issues directly after this issue")
+	cmd.Flags().IntVar(&opts.BoardID, "board", 0, "Board ID (required for --top and --bottom)")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+	cmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == "board-id" { // The flag name of 'atl board rank' before it shared this command
+			name = "board"
+		}
+		return pflag.NormalizedName(name)
+	})
+
+	return cmd
+}
+
+// RankOutput represents the result of ranking issues.
+type RankOutput struct {
+	Issues   []string `json:"issues"`
+	Position string   `json:"position"`
+	Target   string   `json:"target,omitempty"`
+	BoardID  int      `json:"board_id,omitempty"`
+	Success  bool     `json:"success"`
+}
+
+// rankPosition returns which of top, bottom, before, or after is requested,
+// checking that exactly one is given along with the board it needs.
+func rankPosition(opts *RankOptions) (string, error) {
+	var positions []string
+	if opts.Top {
+		positions = append(positions, "top")
+	}
+	if opts.Bottom {
+		positions = append(positions, "bottom")
+	}
+	if opts.Before != "" {
+		positions = append(positions, "before")
+	}
+	if opts.After != "" {
+		positions = append(positions, "after")
+	}
+
+	switch {
+	case len(positions) == 0:
+		return "", fmt.Errorf("one of --top, --bottom, --before, or --after is required")
+	case len(positions) > 1:
+		return "", fmt.Errorf("only one of --top, --bottom, --before, or --after can be specified")
+	}
+
+	position := positions[0]
+	switch position {
+	case "top", "bottom":
+		if opts.BoardID == 0 {
+			return "", fmt.Errorf("--board is required with --%s\n\nUse 'atl board list' to find the board ID", position)
+		}
+	default:
+		if opts.BoardID != 0 {
+			return "", fmt.Errorf("--board is only used with --top or --bottom")
+		}
+	}
+	return position, nil
+}
+
+// issueRanker is the part of the Jira service used to rank issues.
+type issueRanker interface {
+	RankIssuesBefore(ctx context.Context, issueKeys []string, rankBeforeIssue string) error
+	RankIssuesAfter(ctx context.Context, issueKeys []string, rankAfterIssue string) error
+	RankIssuesToTop(ctx context.Context, issueKeys []string, boardID int) error
+	RankIssuesToBottom(ctx context.Context, issueKeys []string, boardID int) error
+}
+
+func runRank(ctx context.Context, opts *RankOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	rankOutput, err := rankIssues(ctx, api.NewJiraService(client), opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, rankOutput)
+	}
+
+	subject := rankOutput.Issues[0]
+	if len(rankOutput.Issues) > 1 {
+		subject = fmt.Sprintf("%d issues", len(rankOutput.Issues))
+	}
+	if rankOutput.Target != "" {
+		fmt.Fprintf(opts.IO.Out, "Ranked %s %s %s\n", subject, rankOutput.Position, rankOutput.Target)
+	} else {
+		fmt.Fprintf(opts.IO.Out, "Ranked %s to the %s of board %d\n", subject, rankOutput.Position, rankOutput.BoardID)
+	}
+	if len(rankOutput.Issues) > 1 {
+		for _, key := range rankOutput.Issues {
+			fmt.Fprintf(opts.IO.Out, "  - %s\n", key)
+		}
+	}
+
+	return nil
+}
+
+// rankIssues ranks the issues at the position the options ask for.
+func rankIssues(ctx context.Context, r issueRanker, opts *RankOptions) (*RankOutput, error) {
+	position, err := rankPosition(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rankOutput := &RankOutput{Issues: opts.IssueKeys, Position: position, Success: true}
+	switch position {
+	case "top":
+		rankOutput.BoardID = opts.BoardID
+		err = r.RankIssuesToTop(ctx, opts.IssueKeys, opts.BoardID)
+	case "bottom":
+		rankOutput.BoardID = opts.BoardID
+		err = r.RankIssuesToBottom(ctx, opts.IssueKeys, opts.BoardID)
+	case "before":
+		rankOutput.Target = opts.Before
+		err = r.RankIssuesBefore(ctx, opts.IssueKeys, opts.Before)
+	case "after":
+		rankOutput.Target = opts.After
+		err = r.RankIssuesAfter(ctx, opts.IssueKeys, opts.After)
+	}
+
+	if errors.Is(err, api.ErrEmptyBoard) {
+		return nil, fmt.Errorf("board %d has no issues to rank against; nothing was moved\n\nCheck the board ID with 'atl board list'", opts.BoardID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to rank issues: %w", err)
+	}
+	return rankOutput, nil
+}
@@ -0,0 +1,136 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+// fakeRanker records the ranking call it receives.
+type fakeRanker struct {
+	call string
+	err  error
+}
+
+func (f *fakeRanker) RankIssuesBefore(_ context.Context, keys []string, target string) error {
+	f.call = fmt.Sprintf("before %s %v", target, keys)
+	return f.err
+}
+
+func (f *fakeRanker) RankIssuesAfter(_ context.Context, keys []string, target string) error {
+	f.call = fmt.Sprintf("after %s %v", target, keys)
+	return f.err
+}
+
+func (f *fakeRanker) RankIssuesToTop(_ context.Context, keys []string, boardID int) error {
+	f.call = fmt.Sprintf("top %d %v", boardID, keys)
+	return f.err
+}
+
+func (f *fakeRanker) RankIssuesToBottom(_ context.Context, keys []string, boardID int) error {
+	f.call = fmt.Sprintf("bottom %d %v", boardID, keys)
+	return f.err
+}
+
+func TestRankIssues(t *testing.T) {
+	keys := []string{"PROJ-1", "PROJ-2"}
+	tests := []struct {
+		name     string
+		opts     RankOptions
+		wantCall string
+		want     RankOutput
+	}{
+		{
+			name:     "top",
+			opts:     RankOptions{Top: true, BoardID: 42},
+			wantCall: "top 42 [PROJ-1 PROJ-2]",
+			want:     RankOutput{Position: "top", BoardID: 42},
+		},
+		{
+			name:     "bottom",
+			opts:     RankOptions{Bottom: true, BoardID: 42},
+			wantCall: "bottom 42 [PROJ-1 PROJ-2]",
+			want:     RankOutput{Position: "bottom", BoardID: 42},
+		},
+		{
+			name:     "before",
+			opts:     RankOptions{Before: "PROJ-9"},
+			wantCall: "before PROJ-9 [PROJ-1 PROJ-2]",
+			want:     RankOutput{Position: "before", Target: "PROJ-9"},
+		},
+		{
+			name:     "after",
+			opts:     RankOptions{After: "PROJ-9"},
+			wantCall: "after PROJ-9 [PROJ-1 PROJ-2]",
+			want:     RankOutput{Position: "after", Target: "PROJ-9"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranker := &fakeRanker{}
+			tt.opts.IssueKeys = keys
+
+			got, err := rankIssues(context.Background(), ranker, &tt.opts)
+			if err != nil {
+				t.Fatalf("rankIssues() error = %v", err)
+			}
+			if ranker.call != tt.wantCall {
+				t.Errorf("call = %q, want %q", ranker.call, tt.wantCall)
+			}
+			if got.Position != tt.want.Position || got.Target != tt.want.Target || got.BoardID != tt.want.BoardID || len(got.Issues) != 2 {
+				t.Errorf("rankIssues() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankIssues_EmptyBoard(t *testing.T) {
+	ranker := &fakeRanker{err: fmt.Errorf("ranking: %w", api.ErrEmptyBoard)}
+
+	_, err := rankIssues(context.Background(), ranker, &RankOptions{IssueKeys: []string{"PROJ-1"}, Bottom: true, BoardID: 42})
+	if err == nil || !strings.Contains(err.Error(), "board 42 has no issues") {
+		t.Errorf("rankIssues() error = %v, want the empty board message", err)
+	}
+}
+
+func TestRankPosition_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		opts RankOptions
+		want string
+	}{
+		{name: "none", opts: RankOptions{}, want: "is required"},
+		{name: "several", opts: RankOptions{Top: true, After: "PROJ-9", BoardID: 42}, want: "only one of"},
+		{name: "top without board", opts: RankOptions{Top: true}, want: "--board is required with --top"},
+		{name: "bottom without board", opts: RankOptions{Bottom: true}, want: "--board is required with --bottom"},
+		{name: "board with before", opts: RankOptions{Before: "PROJ-9", BoardID: 42}, want: "only used with"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranker := &fakeRanker{}
+			_, err := rankIssues(context.Background(), ranker, &tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("rankIssues() error = %v, want %q", err, tt.want)
+			}
+			if ranker.call != "" {
+				t.Errorf("ranked %q despite invalid options", ranker.call)
+			}
+		})
+	}
+}
+
+func TestNewCmdRank_BoardIDAlias(t *testing.T) {
+	cmd := NewCmdRank(iostreams.Test())
+	if err := cmd.ParseFlags([]string{"--board-id", "42"}); err != nil {
+		t.Fatalf("ParseFlags(--board-id) error = %v", err)
+	}
+	if got, _ := cmd.Flags().GetInt("board"); got != 42 {
+		t.Errorf("--board-id set board to %d, want 42", got)
+	}
+}
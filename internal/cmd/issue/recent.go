@@ -0,0 +1,99 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+const (
+	// recentJQL lists the issues you viewed most recently.
+	recentJQL = "issuekey in issueHistory() ORDER BY lastViewed DESC"
+	// recentFallbackJQL is used where issueHistory() is not supported.
+	recentFallbackJQL = "assignee = currentUser() ORDER BY updated DESC"
+)
+
+// RecentOptions holds the options for the recent command.
+type RecentOptions struct {
+	IO    *iostreams.IOStreams
+	Limit int
+	JSON  bool
+}
+
+// NewCmdRecent creates the recent command.
+func NewCmdRecent(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &RecentOptions{
+		IO:    ios,
+		Limit: 10,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "recent",
+		Short: "List issues you viewed recently",
+		Long: `List the issues you viewed most recently, newest first.
+
+Uses Jira's issueHistory() JQL function. If the site rejects it, falls back to
+your assigned issues ordered by last update.`,
+		Example: `  # Your 10 most recently viewed issues
+  atl issue recent
+
+  # More results, as JSON
+  atl issue recent --limit 25 --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Limit < 1 {
+				return fmt.Errorf("--limit must be at least 1")
+			}
+			return runRecent(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 10, "Maximum number of issues")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+func runRecent(ctx context.Context, opts *RecentOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	listOpts := &ListOptions{
+		IO:    opts.IO,
+		JQL:   recentJQL,
+		Limit: opts.Limit,
+		JSON:  opts.JSON,
+	}
+
+	listOutput, err := fetchIssueList(ctx, jira, listOpts)
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		listOpts.JQL = recentFallbackJQL
+		listOutput, err = fetchIssueList(ctx, jira, listOpts)
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, listOutput)
+	}
+
+	if listOpts.JQL == recentFallbackJQL {
+		fmt.Fprintln(opts.IO.ErrOut, "issueHistory() is not supported on this site; showing your recently updated issues instead.")
+	}
+
+	printIssueList(listOpts, listOutput, nil)
+	return nil
+}
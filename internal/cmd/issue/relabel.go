@@ -0,0 +1,253 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// RelabelOptions holds the options for the relabel command.
+type RelabelOptions struct {
+	IO          *iostreams.IOStreams
+	JQL         string
+	From        string
+	To          string
+	Concurrency int
+	DryRun      bool
+	NoNotify    bool
+	JSON        bool
+}
+
+// NewCmdRelabel creates the relabel command.
+func NewCmdRelabel(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &RelabelOptions{
+		IO:          ios,
+		Concurrency: 5,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "relabel",
+		Short: "Rename a label on every issue that has it",
+		Long: `Replace one label with another on all issues that have it.
+
+The search is limited to issues with the --from label, so --jql only narrows
+it further (e.g. to one project). Each issue gets the --to label added and
+the --from label removed in a single update; other labels are kept. Labels
+differing from --from only in case are removed too.
+
+Use --dry-run to see which issues would change without updating them.`,
+		Example: `  # Retire a misspelled label everywhere
+  atl issue relabel --from bugfix --to bug
+
+  # Only in one project, previewing first
+  atl issue relabel --jql "project = PROJ" --from bugfix --to bug --dry-run
+
+  # Without emailing watchers (project admins only)
+  atl issue relabel --jql "project = PROJ" --from frontend --to web --no-notify`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.From = strings.TrimSpace(opts.From)
+			opts.To = strings.TrimSpace(opts.To)
+			if opts.From == "" || opts.To == "" {
+				return fmt.Errorf("--from and --to are required\n\nExample: atl issue relabel --from bugfix --to bug")
+			}
+			if opts.From == opts.To {
+				return fmt.Errorf("--from and --to are the same label")
+			}
+			if strings.ContainsAny(opts.To, " \t") {
+				return fmt.Errorf("labels cannot contain spaces: %q", opts.To)
+			}
+			if opts.Concurrency < 1 {
+				return fmt.Errorf("--concurrency must be at least 1")
+			}
+			return runRelabel(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.JQL, "jql", "q", "", "JQL query narrowing the issues to update")
+	cmd.Flags().StringVar(&opts.From, "from", "", "Label to replace (required)")
+	cmd.Flags().StringVar(&opts.To, "to", "", "Label to replace it with (required)")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of issues updated in parallel")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show which issues would change without updating them")
+	cmd.Flags().BoolVar(&opts.NoNotify, "no-notify", false, noNotifyFlagUsage)
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// RelabelOutput represents the result of a label rename.
+type RelabelOutput struct {
+	JQL       string                  `json:"jql"` // The query actually run, including the label clause
+	From      string                  `json:"from"`
+	To        string                  `json:"to"`
+	DryRun    bool                    `json:"dry_run"`
+	Matched   int                     `json:"matched"`
+	Updated   int                     `json:"updated"`
+	Unchanged int                     `json:"unchanged"`
+	Failed    int                     `json:"failed"`
+	Issues    []*BulkLabelIssueOutput `json:"issues"`
+}
+
+func runRelabel(ctx context.Context, opts *RelabelOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	jql := relabelJQL(opts.JQL, opts.From)
+	issues, err := searchAllIssues(ctx, jira, jql, []string{"labels"})
+	if err != nil {
+		return err
+	}
+
+	relabelOutput := &RelabelOutput{
+		JQL:     jql,
+		From:    opts.From,
+		To:      opts.To,
+		DryRun:  opts.DryRun,
+		Matched: len(issues),
+		Issues:  make([]*BulkLabelIssueOutput, len(issues)),
+	}
+
+	notify := api.NotifyOptions{Notify: !opts.NoNotify}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, opts.Concurrency)
+
+	for i, issue := range issues {
+		add, remove := relabelChanges(issue.Fields.Labels, opts.From, opts.To)
+		labels, _ := applyLabelChanges(issue.Fields.Labels, add, remove)
+		result := &BulkLabelIssueOutput{
+			IssueKey: issue.Key,
+			Labels:   labels,
+			Changed:  len(remove) > 0,
+		}
+		relabelOutput.Issues[i] = result
+
+		if !result.Changed || opts.DryRun {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(key string, result *BulkLabelIssueOutput) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			req := &api.UpdateIssueRequest{
+				Update: map[string][]api.UpdateOp{"labels": labelUpdateOps(add, remove)},
+			}
+			if err := jira.UpdateIssueWithOptions(ctx, key, req, notify); err != nil {
+				result.Error = explainNoNotifyError(err, opts.NoNotify).Error()
+			}
+		}(issue.Key, result)
+	}
+	wg.Wait()
+
+	if msg := jira.LastRateLimit().Warning(); msg != "" {
+		fmt.Fprintf(opts.IO.ErrOut, "Warning: %s\n", msg)
+	}
+
+	for _, r := range relabelOutput.Issues {
+		switch {
+		case r.Error != "":
+			relabelOutput.Failed++
+		case r.Changed:
+			relabelOutput.Updated++
+		default:
+			relabelOutput.Unchanged++
+		}
+	}
+
+	if opts.JSON {
+		if err := output.PrintJSON(opts.IO, relabelOutput); err != nil {
+			return err
+		}
+	} else {
+		printRelabel(opts, relabelOutput)
+	}
+
+	if relabelOutput.Failed > 0 {
+		return fmt.Errorf("failed to update %d of %d issues", relabelOutput.Failed, relabelOutput.Matched)
+	}
+	return nil
+}
+
+func printRelabel(opts *RelabelOptions, relabelOutput *RelabelOutput) {
+	if relabelOutput.Matched == 0 {
+		fmt.Fprintf(opts.IO.Out, "No issues labeled %q found matching: %s\n", opts.From, relabelOutput.JQL)
+		return
+	}
+
+	for _, r := range relabelOutput.Issues {
+		switch {
+		case r.Error != "":
+			fmt.Fprintf(opts.IO.ErrOut, "Failed to update %s: %s\n", r.IssueKey, r.Error)
+		case r.Changed && opts.DryRun:
+			fmt.Fprintf(opts.IO.Out, "Would update %s: %s\n", r.IssueKey, strings.Join(r.Labels, ", "))
+		case r.Changed:
+			fmt.Fprintf(opts.IO.Out, "Updated %s: %s\n", r.IssueKey, strings.Join(r.Labels, ", "))
+		}
+	}
+
+	verb := "Relabeled"
+	if opts.DryRun {
+		verb = "Would relabel"
+	}
+	fmt.Fprintf(opts.IO.Out, "\n%s %d of %d issues from %q to %q (%d unchanged", verb, relabelOutput.Updated, relabelOutput.Matched, opts.From, opts.To, relabelOutput.Unchanged)
+	if relabelOutput.Failed > 0 {
+		fmt.Fprintf(opts.IO.Out, ", %d failed", relabelOutput.Failed)
+	}
+	fmt.Fprintln(opts.IO.Out, ")")
+}
+
+var orderByRegex = regexp.MustCompile(`(?i)\bORDER\s+BY\b`)
+
+// relabelJQL limits jql to issues with the from label, keeping any ORDER BY
+// clause at the end.
+func relabelJQL(jql, from string) string {
+	clause := fmt.Sprintf("labels = %q", from)
+
+	order := ""
+	if loc := orderByRegex.FindStringIndex(jql); loc != nil {
+		order = " " + strings.TrimSpace(jql[loc[0]:])
+		jql = jql[:loc[0]]
+	}
+	if jql = strings.TrimSpace(jql); jql == "" {
+		return clause + order
+	}
+	return fmt.Sprintf("(%s) AND %s%s", jql, clause, order)
+}
+
+// relabelChanges returns the labels to add and remove to replace from with
+// to on an issue with the given labels: to (unless already there) and every
+// label equal to from ignoring case, as JQL matched it that way. Both are
+// empty if the issue has no such label.
+func relabelChanges(labels []string, from, to string) (add, remove []string) {
+	hasTo := false
+	for _, label := range labels {
+		switch {
+		case label == to:
+			hasTo = true
+		case strings.EqualFold(label, from):
+			remove = append(remove, label)
+		}
+	}
+	if len(remove) == 0 {
+		return nil, nil
+	}
+	if !hasTo {
+		add = []string{to}
+	}
+	return add, remove
+}
@@ -0,0 +1,77 @@
+package issue
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestRelabelJQL(t *testing.T) {
+	tests := []struct {
+		jql  string
+		want string
+	}{
+		{"", `labels = "bugfix"`},
+		{"project = PROJ", `(project = PROJ) AND labels = "bugfix"`},
+		{"project = PROJ OR labels = x", `(project = PROJ OR labels = x) AND labels = "bugfix"`},
+		{"project = PROJ order by created DESC", `(project = PROJ) AND labels = "bugfix" order by created DESC`},
+		{"ORDER BY key", `labels = "bugfix" ORDER BY key`},
+	}
+
+	for _, tt := range tests {
+		if got := relabelJQL(tt.jql, "bugfix"); got != tt.want {
+			t.Errorf("relabelJQL(%q) = %q, want %q", tt.jql, got, tt.want)
+		}
+	}
+}
+
+func TestRelabelUpdateOps(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels []string
+		want   []api.UpdateOp
+	}{
+		{
+			name:   "replace",
+			labels: []string{"backend", "bugfix"},
+			want:   []api.UpdateOp{{Add: "bug"}, {Remove: "bugfix"}},
+		},
+		{
+			name:   "new label already present",
+			labels: []string{"bug", "bugfix"},
+			want:   []api.UpdateOp{{Remove: "bugfix"}},
+		},
+		{
+			name:   "case variants are removed",
+			labels: []string{"BugFix", "bugfix"},
+			want:   []api.UpdateOp{{Add: "bug"}, {Remove: "BugFix"}, {Remove: "bugfix"}},
+		},
+		{
+			name:   "old label missing",
+			labels: []string{"bug", "frontend"},
+			want:   []api.UpdateOp{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := labelUpdateOps(relabelChanges(tt.labels, "bugfix", "bug"))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ops = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelabelChangesCaseOnlyRename(t *testing.T) {
+	add, remove := relabelChanges([]string{"Bug"}, "Bug", "bug")
+	if !reflect.DeepEqual(add, []string{"bug"}) || !reflect.DeepEqual(remove, []string{"Bug"}) {
+		t.Errorf("relabelChanges() = %v, %v; want [bug], [Bug]", add, remove)
+	}
+
+	add, remove = relabelChanges([]string{"bug"}, "Bug", "bug")
+	if add != nil || remove != nil {
+		t.Errorf("relabelChanges() on an already renamed issue = %v, %v; want no change", add, remove)
+	}
+}
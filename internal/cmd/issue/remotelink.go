@@ -0,0 +1,360 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// RemoteLinkOptions holds the options for the remotelink command.
+type RemoteLinkOptions struct {
+	IO           *iostreams.IOStreams
+	IssueKey     string
+	Add          bool
+	List         bool
+	Update       bool
+	Delete       bool
+	ID           int
+	URL          string
+	Title        string
+	Summary      string
+	Relationship string
+	GlobalID     string
+	JSON         bool
+}
+
+// NewCmdRemoteLink creates the remotelink command.
+func NewCmdRemoteLink(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &RemoteLinkOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remotelink <issue-key>",
+		Short: "Add, list, update, or delete remote links on a Jira issue",
+		Long: `Manage remote links on a Jira issue.
+
+Remote links connect issues to external resources. Unlike 'atl issue weblink',
+this command can set the link relationship and a globalId. Adding a link with
+a globalId that already exists on the issue updates that link instead of
+creating a duplicate, which makes scripted upserts idempotent.`,
+		Example: `  # List remote links
+  atl issue remotelink PROJ-123 --list
+
+  # Add a link with a relationship
+  atl issue remotelink PROJ-123 --add --url "https://ci.example.com/build/42" --title "Build #42" --relationship "built by"
+
+  # Upsert a link by globalId (re-running updates instead of duplicating)
+  atl issue remotelink PROJ-123 --add --global-id "ci-build-42" --url "https://ci.example.com/build/42" --title "Build #42"
+
+  # Change the title of an existing link (other fields are kept)
+  atl issue remotelink PROJ-123 --update --id 10001 --title "Build #42 (green)"
+
+  # Delete a link
+  atl issue remotelink PROJ-123 --delete --id 10001`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+
+			modes := 0
+			for _, set := range []bool{opts.Add, opts.List, opts.Update, opts.Delete} {
+				if set {
+					modes++
+				}
+			}
+			if modes != 1 {
+				return fmt.Errorf("specify exactly one of --add, --list, --update, or --delete")
+			}
+
+			switch {
+			case opts.List:
+				return runRemoteLinkList(cmd.Context(), opts)
+			case opts.Delete:
+				if opts.ID == 0 {
+					return fmt.Errorf("--id is required with --delete\n\nUse --list to see link IDs")
+				}
+				return runRemoteLinkDelete(cmd.Context(), opts)
+			case opts.Update:
+				if opts.ID == 0 {
+					return fmt.Errorf("--id is required with --update\n\nUse --list to see link IDs")
+				}
+				return runRemoteLinkUpdate(cmd.Context(), opts)
+			default:
+				if opts.URL == "" || opts.Title == "" {
+					return fmt.Errorf("--url and --title are required with --add")
+				}
+				return runRemoteLinkAdd(cmd.Context(), opts)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Add, "add", false, "Add (or upsert by --global-id) a remote link")
+	cmd.Flags().BoolVarP(&opts.List, "list", "l", false, "List remote links on the issue")
+	cmd.Flags().BoolVar(&opts.Update, "update", false, "Update the remote link given by --id")
+	cmd.Flags().BoolVar(&opts.Delete, "delete", false, "Delete the remote link given by --id")
+	cmd.Flags().IntVar(&opts.ID, "id", 0, "Remote link ID (for --update and --delete)")
+	cmd.Flags().StringVarP(&opts.URL, "url", "u", "", "Link URL")
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Link title")
+	cmd.Flags().StringVarP(&opts.Summary, "summary", "s", "", "Link summary/description")
+	cmd.Flags().StringVar(&opts.Relationship, "relationship", "", "Relationship shown in Jira (e.g. \"causes\", \"mentioned in\")")
+	cmd.Flags().StringVar(&opts.GlobalID, "global-id", "", "Stable identifier used to upsert the link")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// RemoteLinkOutput represents a remote link in output.
+type RemoteLinkOutput struct {
+	ID           int    `json:"id"`
+	GlobalID     string `json:"global_id,omitempty"`
+	Relationship string `json:"relationship,omitempty"`
+	URL          string `json:"url"`
+	Title        string `json:"title"`
+	Summary      string `json:"summary,omitempty"`
+}
+
+// RemoteLinkListOutput represents the list output.
+type RemoteLinkListOutput struct {
+	IssueKey string              `json:"issue_key"`
+	Links    []*RemoteLinkOutput `json:"links"`
+	Total    int                 `json:"total"`
+}
+
+// RemoteLinkActionOutput represents the result of an add, update, or delete.
+type RemoteLinkActionOutput struct {
+	IssueKey string            `json:"issue_key"`
+	Action   string            `json:"action"`
+	Link     *RemoteLinkOutput `json:"link"`
+}
+
+func formatRemoteLink(link *api.RemoteLink) *RemoteLinkOutput {
+	out := &RemoteLinkOutput{
+		ID:           link.ID,
+		GlobalID:     link.GlobalID,
+		Relationship: link.Relationship,
+	}
+	if link.Object != nil {
+		out.URL = link.Object.URL
+		out.Title = link.Object.Title
+		out.Summary = link.Object.Summary
+	}
+	return out
+}
+
+func runRemoteLinkList(ctx context.Context, opts *RemoteLinkOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	links, err := jira.GetRemoteLinks(ctx, opts.IssueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get remote links: %w", err)
+	}
+
+	listOutput := &RemoteLinkListOutput{
+		IssueKey: opts.IssueKey,
+		Links:    make([]*RemoteLinkOutput, 0, len(links)),
+		Total:    len(links),
+	}
+	for _, link := range links {
+		listOutput.Links = append(listOutput.Links, formatRemoteLink(link))
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, listOutput)
+	}
+
+	if len(listOutput.Links) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No remote links on %s\n", opts.IssueKey)
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Remote links on %s (%d total):\n\n", opts.IssueKey, listOutput.Total)
+
+	headers := []string{"ID", "RELATIONSHIP", "TITLE", "URL", "GLOBAL ID"}
+	rows := make([][]string, 0, len(listOutput.Links))
+	for _, link := range listOutput.Links {
+		rows = append(rows, []string{
+			strconv.Itoa(link.ID),
+			valueOrDash(link.Relationship),
+			link.Title,
+			link.URL,
+			valueOrDash(link.GlobalID),
+		})
+	}
+	output.SimpleTable(opts.IO.Out, headers, rows)
+
+	return nil
+}
+
+func runRemoteLinkAdd(ctx context.Context, opts *RemoteLinkOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	link, err := jira.CreateRemoteLinkWithOptions(ctx, opts.IssueKey, &api.RemoteLinkOptions{
+		URL:          opts.URL,
+		Title:        opts.Title,
+		Summary:      opts.Summary,
+		Relationship: opts.Relationship,
+		GlobalID:     opts.GlobalID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add remote link: %w", err)
+	}
+
+	actionOutput := &RemoteLinkActionOutput{
+		IssueKey: opts.IssueKey,
+		Action:   "added",
+		Link: &RemoteLinkOutput{
+			ID:           link.ID,
+			GlobalID:     opts.GlobalID,
+			Relationship: opts.Relationship,
+			URL:          opts.URL,
+			Title:        opts.Title,
+			Summary:      opts.Summary,
+		},
+	}
+
+	return printRemoteLinkAction(opts, actionOutput)
+}
+
+func runRemoteLinkUpdate(ctx context.Context, opts *RemoteLinkOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	// PUT replaces the whole link, so start from the current values
+	existing, err := jira.GetRemoteLink(ctx, opts.IssueKey, opts.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get remote link %d: %w", opts.ID, err)
+	}
+
+	merged := mergeRemoteLink(existing, opts)
+	if err := jira.UpdateRemoteLinkWithOptions(ctx, opts.IssueKey, opts.ID, merged); err != nil {
+		return fmt.Errorf("failed to update remote link: %w", err)
+	}
+
+	return printRemoteLinkAction(opts, &RemoteLinkActionOutput{
+		IssueKey: opts.IssueKey,
+		Action:   "updated",
+		Link: &RemoteLinkOutput{
+			ID:           opts.ID,
+			GlobalID:     merged.GlobalID,
+			Relationship: merged.Relationship,
+			URL:          merged.URL,
+			Title:        merged.Title,
+			Summary:      merged.Summary,
+		},
+	})
+}
+
+// mergeRemoteLink overlays the flags that were given on an existing link.
+// The update replaces the whole link, so everything else, including the
+// application and icon that make Jira render e.g. a Confluence link, is
+// carried over unchanged.
+func mergeRemoteLink(existing *api.RemoteLink, opts *RemoteLinkOptions) *api.RemoteLinkOptions {
+	merged := &api.RemoteLinkOptions{
+		GlobalID:     existing.GlobalID,
+		Relationship: existing.Relationship,
+		Application:  existing.Application,
+	}
+	if existing.Object != nil {
+		merged.URL = existing.Object.URL
+		merged.Title = existing.Object.Title
+		merged.Summary = existing.Object.Summary
+		merged.Icon = existing.Object.Icon
+	}
+
+	if opts.URL != "" {
+		merged.URL = opts.URL
+	}
+	if opts.Title != "" {
+		merged.Title = opts.Title
+	}
+	if opts.Summary != "" {
+		merged.Summary = opts.Summary
+	}
+	if opts.Relationship != "" {
+		merged.Relationship = opts.Relationship
+	}
+	if opts.GlobalID != "" {
+		merged.GlobalID = opts.GlobalID
+	}
+	return merged
+}
+
+func runRemoteLinkDelete(ctx context.Context, opts *RemoteLinkOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	if err := jira.DeleteRemoteLink(ctx, opts.IssueKey, opts.ID); err != nil {
+		return fmt.Errorf("failed to delete remote link: %w", err)
+	}
+
+	return printRemoteLinkAction(opts, &RemoteLinkActionOutput{
+		IssueKey: opts.IssueKey,
+		Action:   "deleted",
+		Link:     &RemoteLinkOutput{ID: opts.ID},
+	})
+}
+
+func printRemoteLinkAction(opts *RemoteLinkOptions, actionOutput *RemoteLinkActionOutput) error {
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, actionOutput)
+	}
+
+	link := actionOutput.Link
+	switch actionOutput.Action {
+	case "deleted":
+		fmt.Fprintf(opts.IO.Out, "Deleted remote link %d from %s\n", link.ID, actionOutput.IssueKey)
+		return nil
+	case "updated":
+		fmt.Fprintf(opts.IO.Out, "Updated remote link %d on %s\n", link.ID, actionOutput.IssueKey)
+	default:
+		fmt.Fprintf(opts.IO.Out, "Added remote link to %s\n", actionOutput.IssueKey)
+		fmt.Fprintf(opts.IO.Out, "  Link ID: %d\n", link.ID)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "  Title: %s\n", link.Title)
+	fmt.Fprintf(opts.IO.Out, "  URL: %s\n", link.URL)
+	if link.Relationship != "" {
+		fmt.Fprintf(opts.IO.Out, "  Relationship: %s\n", link.Relationship)
+	}
+	if link.GlobalID != "" {
+		fmt.Fprintf(opts.IO.Out, "  Global ID: %s\n", link.GlobalID)
+	}
+
+	return nil
+}
+
+// valueOrDash returns "-" for empty table cells.
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
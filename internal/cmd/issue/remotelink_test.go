@@ -0,0 +1,35 @@
+package issue
+
+import (
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestMergeRemoteLinkKeepsApplicationAndIcon(t *testing.T) {
+	app := &api.RemoteLinkApp{Type: "com.atlassian.confluence", Name: "Confluence"}
+	icon := &api.RemoteLinkIcon{URL16x16: "https://example.atlassian.net/wiki/favicon.ico", Title: "Confluence"}
+	existing := &api.RemoteLink{
+		ID:           10001,
+		GlobalID:     "appId=abc&pageId=123",
+		Application:  app,
+		Relationship: "Wiki Page",
+		Object: &api.RemoteLinkObject{
+			URL:   "https://example.atlassian.net/wiki/pages/123",
+			Title: "Runbook",
+			Icon:  icon,
+		},
+	}
+
+	got := mergeRemoteLink(existing, &RemoteLinkOptions{ID: 10001, Title: "Runbook v2"})
+
+	if got.Title != "Runbook v2" {
+		t.Errorf("Title = %q, want the new title", got.Title)
+	}
+	if got.URL != existing.Object.URL || got.GlobalID != existing.GlobalID || got.Relationship != "Wiki Page" {
+		t.Errorf("merged = %+v, want the other fields of the existing link", got)
+	}
+	if got.Application != app || got.Icon != icon {
+		t.Errorf("Application = %+v, Icon = %+v; want the existing ones unchanged", got.Application, got.Icon)
+	}
+}
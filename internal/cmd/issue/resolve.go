@@ -0,0 +1,243 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+const doneCategory = "done"
+
+var (
+	// resolveTransitionNames are preferred, in order, when several
+	// transitions lead to a done status.
+	resolveTransitionNames = []string{"Resolve", "Resolve Issue", "Close", "Close Issue", "Done"}
+	// reopenTransitionNames are preferred, in order, when several
+	// transitions lead out of a done status.
+	reopenTransitionNames = []string{"Reopen", "Reopen Issue", "Reopened"}
+)
+
+// ResolveOptions holds the options for the resolve and reopen commands.
+type ResolveOptions struct {
+	IO         *iostreams.IOStreams
+	IssueKey   string
+	Reopen     bool
+	Resolution string
+	Comment    string
+	JSON       bool
+}
+
+// NewCmdResolve creates the resolve command.
+func NewCmdResolve(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &ResolveOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "resolve <issue-key>",
+		Short: "Move an issue to a done status",
+		Long: `Resolve an issue without knowing its workflow's transition names.
+
+Picks the available transition that lands in a status of the "done" category.
+When several do, transitions named Resolve, Close, or Done are preferred; if
+the choice is still ambiguous, the candidates are listed so you can use
+'atl issue transition' instead.
+
+--resolution is only sent when the transition screen has a Resolution field.`,
+		Example: `  # Resolve an issue
+  atl issue resolve PROJ-1234
+
+  # Resolve with a specific resolution and a comment
+  atl issue resolve PROJ-1234 --resolution "Won't Do" --comment "Out of scope"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			return runResolve(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Resolution, "resolution", "r", "Done", "Resolution to set, if the transition asks for one")
+	cmd.Flags().StringVarP(&opts.Comment, "comment", "c", "", "Add a comment with the transition")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// NewCmdReopen creates the reopen command.
+func NewCmdReopen(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &ResolveOptions{
+		IO:     ios,
+		Reopen: true,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "reopen <issue-key>",
+		Short: "Move a resolved issue back to an open status",
+		Long: `Reopen a resolved issue without knowing its workflow's transition names.
+
+Picks the available transition that lands in a status outside the "done"
+category. When several do, a transition named Reopen is preferred, then one
+leading to a "To Do" status; if the choice is still ambiguous, the candidates
+are listed so you can use 'atl issue transition' instead.`,
+		Example: `  # Reopen an issue
+  atl issue reopen PROJ-1234
+
+  # Reopen with a comment
+  atl issue reopen PROJ-1234 --comment "Still happening on 2.3.1"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			return runResolve(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Comment, "comment", "c", "", "Add a comment with the transition")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+func runResolve(ctx context.Context, opts *ResolveOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	issue, err := jira.GetIssue(ctx, opts.IssueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	fromStatus := ""
+	if issue.Fields.Status != nil {
+		fromStatus = issue.Fields.Status.Name
+		isDone := issue.Fields.Status.StatusCategory != nil && issue.Fields.Status.StatusCategory.Key == doneCategory
+		if isDone && !opts.Reopen {
+			return fmt.Errorf("%s is already resolved (status: %s)", opts.IssueKey, fromStatus)
+		}
+		if !isDone && opts.Reopen {
+			return fmt.Errorf("%s is not resolved (status: %s)", opts.IssueKey, fromStatus)
+		}
+	}
+
+	transitions, err := jira.GetTransitionsWithFields(ctx, opts.IssueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get transitions: %w", err)
+	}
+
+	transition, err := pickCategoryTransition(transitions, opts.Reopen)
+	if err != nil {
+		return fmt.Errorf("%s: %w", opts.IssueKey, err)
+	}
+
+	var fields map[string]interface{}
+	if !opts.Reopen && opts.Resolution != "" {
+		if _, ok := transition.Fields["resolution"]; ok {
+			fields = map[string]interface{}{
+				"resolution": map[string]string{"name": opts.Resolution},
+			}
+		}
+	}
+
+	err = jira.TransitionIssue(ctx, opts.IssueKey, transition.ID, fields)
+	if err != nil {
+		return fmt.Errorf("failed to transition issue: %w", err)
+	}
+
+	if opts.Comment != "" {
+		if _, err := jira.AddComment(ctx, opts.IssueKey, opts.Comment); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: transition successful but failed to add comment: %v\n", err)
+		}
+	}
+
+	toStatus := transition.Name
+	if transition.To != nil {
+		toStatus = transition.To.Name
+	}
+
+	transitionOutput := &TransitionOutput{
+		IssueKey:   opts.IssueKey,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		URL:        client.BrowseURL(opts.IssueKey),
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, transitionOutput)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Transitioned %s: %s -> %s\n", opts.IssueKey, fromStatus, toStatus)
+	fmt.Fprintf(opts.IO.Out, "URL: %s\n", transitionOutput.URL)
+
+	return nil
+}
+
+// pickCategoryTransition chooses the transition that resolves (reopen=false)
+// or reopens (reopen=true) an issue, based on the status category each
+// transition lands in. Ties are broken by preferred transition names and, for
+// reopening, by a single transition into the "To Do" category.
+func pickCategoryTransition(transitions []*api.Transition, reopen bool) (*api.Transition, error) {
+	var candidates []*api.Transition
+	for _, t := range transitions {
+		if t.To == nil || t.To.StatusCategory == nil {
+			continue
+		}
+		if (t.To.StatusCategory.Key == doneCategory) != reopen {
+			candidates = append(candidates, t)
+		}
+	}
+
+	action, preferred := "resolve", resolveTransitionNames
+	if reopen {
+		action, preferred = "reopen", reopenTransitionNames
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("no transition available to %s the issue; run 'atl issue transition --list' to see the workflow", action)
+	case 1:
+		return candidates[0], nil
+	}
+
+	for _, name := range preferred {
+		for _, t := range candidates {
+			if strings.EqualFold(t.Name, name) {
+				return t, nil
+			}
+		}
+	}
+
+	if reopen {
+		var toDo []*api.Transition
+		for _, t := range candidates {
+			if t.To.StatusCategory.Key == "new" {
+				toDo = append(toDo, t)
+			}
+		}
+		if len(toDo) == 1 {
+			return toDo[0], nil
+		}
+	}
+
+	var names []string
+	for _, t := range candidates {
+		names = append(names, fmt.Sprintf("%q (-> %s)", t.Name, t.To.Name))
+	}
+	return nil, fmt.Errorf("several transitions could %s the issue: %s; use 'atl issue transition' to pick one", action, strings.Join(names, ", "))
+}
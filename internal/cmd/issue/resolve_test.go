@@ -0,0 +1,107 @@
+package issue
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func transitionTo(id, name, status, category string) *api.Transition {
+	return &api.Transition{
+		ID:   id,
+		Name: name,
+		To: &api.Status{
+			Name:           status,
+			StatusCategory: &api.StatusCategory{Key: category},
+		},
+	}
+}
+
+func TestPickCategoryTransition(t *testing.T) {
+	tests := []struct {
+		name        string
+		transitions []*api.Transition
+		reopen      bool
+		wantID      string
+		wantErr     string
+	}{
+		{
+			name: "single done transition",
+			transitions: []*api.Transition{
+				transitionTo("11", "Start", "In Progress", "indeterminate"),
+				transitionTo("31", "Finish", "Closed", "done"),
+			},
+			wantID: "31",
+		},
+		{
+			name: "prefers Resolve over other done transitions",
+			transitions: []*api.Transition{
+				transitionTo("31", "Won't Fix", "Closed", "done"),
+				transitionTo("41", "resolve issue", "Resolved", "done"),
+			},
+			wantID: "41",
+		},
+		{
+			name: "ambiguous done transitions",
+			transitions: []*api.Transition{
+				transitionTo("31", "Ship", "Shipped", "done"),
+				transitionTo("41", "Abandon", "Abandoned", "done"),
+			},
+			wantErr: "several transitions could resolve",
+		},
+		{
+			name: "no done transition",
+			transitions: []*api.Transition{
+				transitionTo("11", "Start", "In Progress", "indeterminate"),
+			},
+			wantErr: "no transition available to resolve",
+		},
+		{
+			name: "prefers Reopen",
+			transitions: []*api.Transition{
+				transitionTo("11", "Back to work", "In Progress", "indeterminate"),
+				transitionTo("21", "Reopen", "Reopened", "new"),
+				transitionTo("22", "Backlog", "Backlog", "new"),
+			},
+			reopen: true,
+			wantID: "21",
+		},
+		{
+			name: "falls back to the single To Do transition",
+			transitions: []*api.Transition{
+				transitionTo("11", "Back to work", "In Progress", "indeterminate"),
+				transitionTo("21", "Back to backlog", "To Do", "new"),
+				transitionTo("31", "Close", "Closed", "done"),
+			},
+			reopen: true,
+			wantID: "21",
+		},
+		{
+			name: "ignores transitions without a status category",
+			transitions: []*api.Transition{
+				{ID: "5", Name: "Reopen"},
+				transitionTo("31", "Close", "Closed", "done"),
+			},
+			wantID: "31",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pickCategoryTransition(tt.transitions, tt.reopen)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != tt.wantID {
+				t.Errorf("picked transition %s, want %s", got.ID, tt.wantID)
+			}
+		})
+	}
+}
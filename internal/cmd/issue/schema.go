@@ -0,0 +1,28 @@
+package issue
+
+// OutputTypes maps issue subcommands to the type their --json output is
+// encoded from, for atl schema. Commands whose output shape depends on the
+// mode they run in (e.g. attachment, sprint) are not listed.
+var OutputTypes = map[string]any{
+	"view":        IssueOutput{},
+	"list":        IssueListOutput{},
+	"recent":      IssueListOutput{},
+	"summary":     SummaryOutput{},
+	"create":      CreateOutput{},
+	"subtask":     SubtaskOutput{},
+	"edit":        EditOutput{},
+	"bulk-label":  BulkLabelOutput{},
+	"relabel":     RelabelOutput{},
+	"transition":  TransitionOutput{},
+	"transitions": TransitionsOutput{},
+	"resolve":     TransitionOutput{},
+	"assign":      AssignOutput{},
+	"link-page":   LinkPageOutput{},
+	"rank":        RankOutput{},
+	"time":        TimeOutput{},
+	"fields":      FieldsOutput{},
+	"types":       TypesOutput{},
+	"priorities":  PrioritiesOutput{},
+	"labels":      LabelsOutput{},
+	"changelog":   []*ChangelogEntryOutput{},
+}
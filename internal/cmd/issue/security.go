@@ -0,0 +1,38 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+// resolveSecurityLevel looks up a project's security level by name or ID.
+func resolveSecurityLevel(ctx context.Context, jira *api.JiraService, projectKey, name string) (*api.SecurityLevel, error) {
+	levels, err := jira.GetSecurityLevels(ctx, projectKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get security levels: %w", err)
+	}
+	return findSecurityLevel(levels, projectKey, name)
+}
+
+// findSecurityLevel matches name against the levels' names (case-insensitive)
+// or IDs, and lists the available levels when nothing matches.
+func findSecurityLevel(levels []*api.SecurityLevel, projectKey, name string) (*api.SecurityLevel, error) {
+	for _, l := range levels {
+		if strings.EqualFold(l.Name, name) || l.ID == name {
+			return l, nil
+		}
+	}
+
+	if len(levels) == 0 {
+		return nil, fmt.Errorf("project %s has no security levels you can set", projectKey)
+	}
+
+	names := make([]string, 0, len(levels))
+	for _, l := range levels {
+		names = append(names, l.Name)
+	}
+	return nil, fmt.Errorf("security level %q not found in project %s. Available levels: %s", name, projectKey, strings.Join(names, ", "))
+}
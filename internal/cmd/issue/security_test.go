@@ -0,0 +1,47 @@
+package issue
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestFindSecurityLevel(t *testing.T) {
+	levels := []*api.SecurityLevel{
+		{ID: "10000", Name: "Internal"},
+		{ID: "10001", Name: "Security Team"},
+	}
+
+	tests := []struct {
+		name    string
+		levels  []*api.SecurityLevel
+		input   string
+		wantID  string
+		wantErr string
+	}{
+		{name: "exact name", levels: levels, input: "Internal", wantID: "10000"},
+		{name: "case-insensitive name", levels: levels, input: "security team", wantID: "10001"},
+		{name: "by ID", levels: levels, input: "10001", wantID: "10001"},
+		{name: "unknown lists available", levels: levels, input: "Public", wantErr: "Available levels: Internal, Security Team"},
+		{name: "no scheme", levels: nil, input: "Internal", wantErr: "has no security levels"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findSecurityLevel(tt.levels, "PROJ", tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != tt.wantID {
+				t.Errorf("ID = %s, want %s", got.ID, tt.wantID)
+			}
+		})
+	}
+}
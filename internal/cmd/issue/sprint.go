@@ -55,29 +55,33 @@ Use --list-boards to find board IDs, then --list-sprints to find sprint IDs.`,
   atl issue sprint PROJ-1 --backlog`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.ListBoards {
-				return runListBoards(opts)
+				return runListBoards(cmd.Context(), opts)
 			}
 			if opts.ListSprints {
 				if opts.BoardID == 0 {
 					return fmt.Errorf("--board is required when listing sprints")
 				}
-				return runListSprints(opts)
+				return runListSprints(cmd.Context(), opts)
 			}
 
 			if len(args) == 0 {
 				return fmt.Errorf("at least one issue key is required")
 			}
-			opts.IssueKeys = args
+			keys, err := normalizeIssueKeys(args)
+			if err != nil {
+				return err
+			}
+			opts.IssueKeys = keys
 
 			if opts.Backlog {
-				return runMoveToBacklog(opts)
+				return runMoveToBacklog(cmd.Context(), opts)
 			}
 
 			if opts.SprintID == 0 && opts.SprintName == "" {
 				return fmt.Errorf("either --sprint-id or --sprint is required")
 			}
 
-			return runMoveSprint(opts)
+			return runMoveSprint(cmd.Context(), opts)
 		},
 	}
 
@@ -131,13 +135,12 @@ type SprintMoveOutput struct {
 	Action   string   `json:"action"`
 }
 
-func runListBoards(opts *SprintOptions) error {
+func runListBoards(ctx context.Context, opts *SprintOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	boards, err := jira.GetBoards(ctx, opts.Project)
@@ -164,7 +167,7 @@ func runListBoards(opts *SprintOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, boardsOutput)
+		return output.PrintJSON(opts.IO, boardsOutput)
 	}
 
 	if boardsOutput.Total == 0 {
@@ -190,13 +193,12 @@ func runListBoards(opts *SprintOptions) error {
 	return nil
 }
 
-func runListSprints(opts *SprintOptions) error {
+func runListSprints(ctx context.Context, opts *SprintOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	// Get active and future sprints
@@ -222,7 +224,7 @@ func runListSprints(opts *SprintOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, sprintsOutput)
+		return output.PrintJSON(opts.IO, sprintsOutput)
 	}
 
 	if sprintsOutput.Total == 0 {
@@ -257,13 +259,12 @@ func runListSprints(opts *SprintOptions) error {
 	return nil
 }
 
-func runMoveSprint(opts *SprintOptions) error {
+func runMoveSprint(ctx context.Context, opts *SprintOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	sprintID := opts.SprintID
@@ -297,6 +298,11 @@ func runMoveSprint(opts *SprintOptions) error {
 		sprintName = found.Name
 	}
 
+	issues, err := lookupIssues(ctx, jira, opts.IssueKeys)
+	if err != nil {
+		return err
+	}
+
 	err = jira.MoveIssuesToSprint(ctx, sprintID, opts.IssueKeys)
 	if err != nil {
 		return fmt.Errorf("failed to move issues to sprint: %w", err)
@@ -310,7 +316,7 @@ func runMoveSprint(opts *SprintOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, moveOutput)
+		return output.PrintJSON(opts.IO, moveOutput)
 	}
 
 	if sprintName != "" {
@@ -318,18 +324,23 @@ func runMoveSprint(opts *SprintOptions) error {
 	} else {
 		fmt.Fprintf(opts.IO.Out, "Moved %d issue(s) to sprint %d\n", len(opts.IssueKeys), sprintID)
 	}
+	printSprintIssues(opts.IO, issues)
 	return nil
 }
 
-func runMoveToBacklog(opts *SprintOptions) error {
+func runMoveToBacklog(ctx context.Context, opts *SprintOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
+	issues, err := lookupIssues(ctx, jira, opts.IssueKeys)
+	if err != nil {
+		return err
+	}
+
 	err = jira.RemoveIssuesFromSprint(ctx, opts.IssueKeys)
 	if err != nil {
 		return fmt.Errorf("failed to move issues to backlog: %w", err)
@@ -341,9 +352,53 @@ func runMoveToBacklog(opts *SprintOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, moveOutput)
+		return output.PrintJSON(opts.IO, moveOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Moved %d issue(s) to backlog\n", len(opts.IssueKeys))
+	printSprintIssues(opts.IO, issues)
 	return nil
 }
+
+// lookupIssues fetches the issues for keys in one batch and fails naming
+// every key that matches no issue. Commands call it before changing several
+// issues at once, such as sprint moves (which the Agile API rejects as a
+// whole when one key is wrong) and links, so a wrong key is reported by name
+// before anything changes. Moved issues are found by their old key.
+func lookupIssues(ctx context.Context, jira *api.JiraService, keys []string) ([]*api.Issue, error) {
+	byKey, err := jira.GetIssuesByKey(ctx, keys, []string{"summary"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up issues: %w", err)
+	}
+	issues, missing := matchIssueKeys(keys, byKey)
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("issues not found: %s", strings.Join(missing, ", "))
+	}
+	return issues, nil
+}
+
+// matchIssueKeys returns the issues for keys (or IDs) in order, once each,
+// and the keys that no issue matches. byKey is keyed by the uppercased
+// requested key, as returned by GetIssuesByKey.
+func matchIssueKeys(keys []string, byKey map[string]*api.Issue) ([]*api.Issue, []string) {
+	var issues []*api.Issue
+	var missing []string
+	seen := make(map[*api.Issue]bool, len(keys))
+	for _, key := range keys {
+		issue := byKey[strings.ToUpper(key)]
+		switch {
+		case issue == nil:
+			missing = append(missing, key)
+		case !seen[issue]:
+			seen[issue] = true
+			issues = append(issues, issue)
+		}
+	}
+	return issues, missing
+}
+
+func printSprintIssues(ios *iostreams.IOStreams, issues []*api.Issue) {
+	for _, issue := range issues {
+		fmt.Fprintf(ios.Out, "  %s  %s\n", issue.Key, issue.Fields.Summary)
+	}
+}
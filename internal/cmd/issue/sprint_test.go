@@ -0,0 +1,24 @@
+package issue
+
+import (
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestMatchIssueKeys(t *testing.T) {
+	moved := &api.Issue{Key: "NEW-7", ID: "10007"}
+	byKey := map[string]*api.Issue{
+		"PROJ-1": {Key: "PROJ-1", ID: "10001"},
+		"OLD-1":  moved,
+		"10007":  moved,
+	}
+
+	issues, missing := matchIssueKeys([]string{"proj-1", "PROJ-2", "OLD-1", "10007"}, byKey)
+	if len(missing) != 1 || missing[0] != "PROJ-2" {
+		t.Errorf("missing = %v, want [PROJ-2]", missing)
+	}
+	if len(issues) != 2 || issues[0].Key != "PROJ-1" || issues[1].Key != "NEW-7" {
+		t.Errorf("issues = %v, want PROJ-1 and the moved NEW-7 once", issues)
+	}
+}
@@ -0,0 +1,128 @@
+package issue
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+const stdinFlagUsage = "Read issue keys from stdin, one per line"
+
+// readIssueKeys reads issue keys or URLs, one per line. Blank lines and lines
+// starting with # are skipped; duplicates are dropped.
+func readIssueKeys(r io.Reader) ([]string, error) {
+	var keys []string
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, err := api.NormalizeIssueKey(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read issue keys: %w", err)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no issue keys on stdin")
+	}
+	return keys, nil
+}
+
+// IssueBatchOutput represents the result of applying one action to issues
+// read with --stdin.
+type IssueBatchOutput struct {
+	Action    string              `json:"action"`
+	Total     int                 `json:"total"`
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+	Issues    []*IssueBatchResult `json:"issues"`
+}
+
+// IssueBatchResult represents the outcome for one issue.
+type IssueBatchResult struct {
+	IssueKey string `json:"issue_key"`
+	Error    string `json:"error,omitempty"`
+}
+
+// applyToIssues runs fn for every key, at most concurrency at a time. A
+// failure on one issue does not stop the rest. Results keep the key order.
+func applyToIssues(ctx context.Context, keys []string, concurrency int, action string, fn func(ctx context.Context, key string) error) *IssueBatchOutput {
+	batchOutput := &IssueBatchOutput{
+		Action: action,
+		Total:  len(keys),
+		Issues: make([]*IssueBatchResult, len(keys)),
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for i, key := range keys {
+		result := &IssueBatchResult{IssueKey: key}
+		batchOutput.Issues[i] = result
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(ctx, key); err != nil {
+				result.Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, r := range batchOutput.Issues {
+		if r.Error != "" {
+			batchOutput.Failed++
+		} else {
+			batchOutput.Succeeded++
+		}
+	}
+	return batchOutput
+}
+
+// finishIssueBatch prints the batch result and returns an error when any
+// issue failed. done describes a successful issue, e.g. "Flagged".
+func finishIssueBatch(ios *iostreams.IOStreams, batchOutput *IssueBatchOutput, done string, jsonOutput bool) error {
+	if jsonOutput {
+		if err := output.PrintJSON(ios, batchOutput); err != nil {
+			return err
+		}
+	} else {
+		for _, r := range batchOutput.Issues {
+			if r.Error != "" {
+				fmt.Fprintf(ios.ErrOut, "Failed: %s: %s\n", r.IssueKey, r.Error)
+			} else {
+				fmt.Fprintf(ios.Out, "%s %s\n", done, r.IssueKey)
+			}
+		}
+		fmt.Fprintf(ios.Out, "\n%d succeeded, %d failed\n", batchOutput.Succeeded, batchOutput.Failed)
+	}
+
+	if batchOutput.Failed > 0 {
+		return fmt.Errorf("failed on %d of %d issues", batchOutput.Failed, batchOutput.Total)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package issue
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func TestReadIssueKeys(t *testing.T) {
+	input := strings.NewReader(`PROJ-1
+
+# blocked this sprint
+  PROJ-2
+https://acme.atlassian.net/browse/PROJ-3?focusedCommentId=1
+PROJ-1
+`)
+	keys, err := readIssueKeys(input)
+	if err != nil {
+		t.Fatalf("readIssueKeys() error = %v", err)
+	}
+	if got := strings.Join(keys, ","); got != "PROJ-1,PROJ-2,PROJ-3" {
+		t.Errorf("readIssueKeys() = %s, want PROJ-1,PROJ-2,PROJ-3", got)
+	}
+
+	if _, err := readIssueKeys(strings.NewReader("PROJ-1\nnot a key\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("readIssueKeys() with a bad line error = %v, want a line 2 error", err)
+	}
+	if _, err := readIssueKeys(strings.NewReader("\n# nothing\n")); err == nil {
+		t.Error("readIssueKeys() with no keys should fail")
+	}
+}
+
+func TestApplyToIssuesFromStdin(t *testing.T) {
+	keys, err := readIssueKeys(strings.NewReader("PROJ-1\nPROJ-2\nPROJ-3\nPROJ-4\nPROJ-5\n"))
+	if err != nil {
+		t.Fatalf("readIssueKeys() error = %v", err)
+	}
+
+	var mu sync.Mutex
+	var applied []string
+	var running, maxRunning int32
+	fn := func(ctx context.Context, key string) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		mu.Lock()
+		applied = append(applied, key)
+		if n > maxRunning {
+			maxRunning = n
+		}
+		mu.Unlock()
+		if key == "PROJ-3" {
+			return errors.New("issue does not exist")
+		}
+		return nil
+	}
+
+	batchOutput := applyToIssues(context.Background(), keys, 2, "flagged", fn)
+
+	if len(applied) != 5 {
+		t.Errorf("applied to %d issues, want 5", len(applied))
+	}
+	if maxRunning > 2 {
+		t.Errorf("%d calls ran at once, want at most 2", maxRunning)
+	}
+	if batchOutput.Total != 5 || batchOutput.Succeeded != 4 || batchOutput.Failed != 1 {
+		t.Errorf("batch = total %d, succeeded %d, failed %d; want 5, 4, 1", batchOutput.Total, batchOutput.Succeeded, batchOutput.Failed)
+	}
+	if r := batchOutput.Issues[2]; r.IssueKey != "PROJ-3" || r.Error == "" {
+		t.Errorf("Issues[2] = %+v, want the PROJ-3 failure in key order", r)
+	}
+
+	outBuf, errBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	ios := &iostreams.IOStreams{Out: outBuf, ErrOut: errBuf}
+	if err := finishIssueBatch(ios, batchOutput, "Flagged", false); err == nil {
+		t.Error("finishIssueBatch() should report the failed issue")
+	}
+	if !strings.Contains(outBuf.String(), "Flagged PROJ-1") || !strings.Contains(outBuf.String(), "4 succeeded, 1 failed") {
+		t.Errorf("output = %q", outBuf.String())
+	}
+	if !strings.Contains(errBuf.String(), "Failed: PROJ-3: issue does not exist") {
+		t.Errorf("error output = %q", errBuf.String())
+	}
+}
@@ -0,0 +1,203 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// SubtaskOptions holds the options for the subtask command.
+type SubtaskOptions struct {
+	IO          *iostreams.IOStreams
+	ParentKey   string
+	Summaries   []string
+	Description string
+	Assignee    string
+	AssignMe    bool
+	Labels      []string
+	IssueType   string
+	JSON        bool
+}
+
+// NewCmdSubtask creates the subtask command.
+func NewCmdSubtask(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &SubtaskOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "subtask <parent-key>",
+		Short: "Create subtasks under an issue",
+		Long: `Create one or more subtasks under a parent issue.
+
+The project is taken from the parent key and the project's subtask issue
+type is found automatically (use --type to pick another one). Give
+--summary once per subtask; the other flags apply to all of them. A subtask
+that fails to be created does not stop the others.
+
+Without --assignee, subtasks get Jira's default assignee unless --assign-me
+or create.assign_self is set, as for 'atl issue create'.`,
+		Example: `  # Create a subtask
+  atl issue subtask PROJ-123 --summary "Write tests"
+
+  # Create several at once, assigned to yourself
+  atl issue subtask PROJ-123 --summary "Backend" --summary "Frontend" --summary "Docs" --assignee @me
+
+  # Output as JSON
+  atl issue subtask PROJ-123 --summary "Write tests" --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.ParentKey = strings.ToUpper(key)
+			for _, summary := range opts.Summaries {
+				if strings.TrimSpace(summary) == "" {
+					return fmt.Errorf("--summary cannot be empty")
+				}
+			}
+			if len(opts.Summaries) == 0 {
+				return fmt.Errorf("--summary is required\n\nExample: atl issue subtask %s --summary \"Write tests\"", opts.ParentKey)
+			}
+			if opts.AssignMe && opts.Assignee != "" {
+				return fmt.Errorf("--assign-me and --assignee cannot be used together")
+			}
+			opts.Assignee = createAssignee(opts.Assignee, resolveAssignSelf(cmd, opts.AssignMe))
+			return runSubtask(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&opts.Summaries, "summary", "s", nil, "Subtask summary; repeat to create several (required)")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description for each subtask")
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Assignee (use @me for yourself)")
+	cmd.Flags().BoolVar(&opts.AssignMe, "assign-me", false, "Assign the subtasks to yourself (default: create.assign_self; =false to keep Jira's default)")
+	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Labels to add")
+	cmd.Flags().StringVarP(&opts.IssueType, "type", "t", "", "Subtask issue type (default: the project's subtask type)")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// SubtaskOutput represents the result of creating subtasks.
+type SubtaskOutput struct {
+	Parent  string          `json:"parent"`
+	Project string          `json:"project"`
+	Created []*CreateOutput `json:"created"`
+	Failed  []*SubtaskError `json:"failed,omitempty"`
+}
+
+// SubtaskError describes a subtask that could not be created.
+type SubtaskError struct {
+	Summary string `json:"summary"`
+	Error   string `json:"error"`
+}
+
+func runSubtask(ctx context.Context, opts *SubtaskOptions) error {
+	project, err := projectFromIssueKey(opts.ParentKey)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	// Discovered once here rather than by buildCreateRequest for every summary.
+	issueType := opts.IssueType
+	if issueType == "" {
+		subtaskType, err := jira.GetSubtaskType(ctx, project)
+		if err != nil {
+			return fmt.Errorf("failed to discover subtask type: %w", err)
+		}
+		if subtaskType == nil {
+			return fmt.Errorf("no subtask type found for project %s\n\nUse 'atl issue types --project %s' to list available types", project, project)
+		}
+		issueType = subtaskType.Name
+	}
+
+	subtaskOutput := &SubtaskOutput{
+		Parent:  opts.ParentKey,
+		Project: project,
+		Created: []*CreateOutput{},
+	}
+
+	for _, summary := range opts.Summaries {
+		createOpts := &CreateOptions{
+			Project:     project,
+			IssueType:   issueType,
+			Parent:      opts.ParentKey,
+			Summary:     summary,
+			Description: opts.Description,
+			Assignee:    opts.Assignee,
+			Labels:      opts.Labels,
+		}
+
+		created, err := createSubtask(ctx, jira, client.BrowseBaseURL(), createOpts)
+		if err != nil {
+			subtaskOutput.Failed = append(subtaskOutput.Failed, &SubtaskError{Summary: summary, Error: err.Error()})
+			if !opts.JSON {
+				fmt.Fprintf(opts.IO.ErrOut, "Failed to create %q: %s\n", summary, err)
+			}
+			continue
+		}
+		subtaskOutput.Created = append(subtaskOutput.Created, created)
+		if !opts.JSON {
+			fmt.Fprintf(opts.IO.Out, "Created %s - %s\n", created.Key, created.Summary)
+		}
+	}
+
+	if opts.JSON {
+		if err := output.PrintJSON(opts.IO, subtaskOutput); err != nil {
+			return err
+		}
+	} else if len(opts.Summaries) > 1 {
+		fmt.Fprintf(opts.IO.Out, "\nCreated %d of %d subtasks under %s\n", len(subtaskOutput.Created), len(opts.Summaries), opts.ParentKey)
+	}
+
+	if len(subtaskOutput.Failed) > 0 {
+		return fmt.Errorf("failed to create %d of %d subtasks", len(subtaskOutput.Failed), len(opts.Summaries))
+	}
+	return nil
+}
+
+// createSubtask creates a single subtask through the regular create flow.
+func createSubtask(ctx context.Context, jira *api.JiraService, browseBase string, opts *CreateOptions) (*CreateOutput, error) {
+	req, err := buildCreateRequest(ctx, jira, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := jira.CreateIssue(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+
+	return &CreateOutput{
+		Key:     result.Key,
+		ID:      result.ID,
+		Summary: opts.Summary,
+		Type:    opts.IssueType,
+		Project: opts.Project,
+		URL:     api.IssueBrowseURL(browseBase, result.Key),
+	}, nil
+}
+
+// projectFromIssueKey returns the project key of an issue key, e.g. PROJ for
+// PROJ-123. Numeric issue IDs don't contain the project and are rejected.
+func projectFromIssueKey(key string) (string, error) {
+	i := strings.LastIndex(key, "-")
+	if i <= 0 {
+		return "", fmt.Errorf("cannot tell the project from %q; pass the parent's issue key (e.g. PROJ-123)", key)
+	}
+	return strings.ToUpper(key[:i]), nil
+}
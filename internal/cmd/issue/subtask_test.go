@@ -0,0 +1,27 @@
+package issue
+
+import "testing"
+
+func TestProjectFromIssueKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{key: "PROJ-123", want: "PROJ"},
+		{key: "proj-7", want: "PROJ"},
+		{key: "MY_PROJ2-1", want: "MY_PROJ2"},
+		{key: "10042", wantErr: true}, // numeric issue ID
+	}
+
+	for _, tt := range tests {
+		got, err := projectFromIssueKey(tt.key)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("projectFromIssueKey(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("projectFromIssueKey(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
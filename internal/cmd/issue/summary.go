@@ -0,0 +1,218 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// SummaryOptions holds the options for the summary command.
+type SummaryOptions struct {
+	IO      *iostreams.IOStreams
+	Project string
+	JQL     string
+	GroupBy string
+	Limit   int
+	JSON    bool
+}
+
+// NewCmdSummary creates the summary command.
+func NewCmdSummary(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &SummaryOptions{
+		IO:      ios,
+		GroupBy: "status",
+		Limit:   1000,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "summary",
+		Short: "Count issues by status, assignee, type, or priority",
+		Long: `Show a quick pulse of a project by counting matching issues per group.
+
+Issues are fetched page by page and grouped locally. Fetching stops after
+--limit issues so large projects aren't pulled by accident; the output notes
+when the counts are partial.`,
+		Example: `  # Issue counts by status
+  atl issue summary --project PROJ
+
+  # Open work per assignee
+  atl issue summary --jql "project = PROJ AND statusCategory != Done" --group-by assignee
+
+  # Output as JSON
+  atl issue summary --project PROJ --group-by type --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Project == "" && opts.JQL == "" {
+				return fmt.Errorf("--project or --jql is required")
+			}
+			switch opts.GroupBy {
+			case "status", "assignee", "type", "priority":
+			default:
+				return fmt.Errorf("invalid --group-by: %s (expected status, assignee, type, or priority)", opts.GroupBy)
+			}
+			if opts.Limit < 1 {
+				return fmt.Errorf("--limit must be at least 1")
+			}
+			return runSummary(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project key")
+	cmd.Flags().StringVarP(&opts.JQL, "jql", "q", "", "JQL query (overrides --project)")
+	cmd.Flags().StringVarP(&opts.GroupBy, "group-by", "g", "status", "Group by: status, assignee, type, or priority")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 1000, "Maximum number of issues to fetch")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// SummaryOutput represents the grouped issue counts.
+type SummaryOutput struct {
+	JQL       string           `json:"jql"`
+	GroupBy   string           `json:"group_by"`
+	Count     int              `json:"count"`
+	Truncated bool             `json:"truncated"`
+	Buckets   []*SummaryBucket `json:"buckets"`
+}
+
+// SummaryBucket is the number of issues in one group.
+type SummaryBucket struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func runSummary(ctx context.Context, opts *SummaryOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	jql := opts.JQL
+	if jql == "" {
+		jql = fmt.Sprintf("project = %q", opts.Project)
+	}
+
+	var issues []*api.Issue
+	truncated := false
+	searchOpts := api.SearchOptions{
+		JQL:    jql,
+		Fields: []string{"status", "assignee", "issuetype", "priority"},
+	}
+	for {
+		searchOpts.MaxResults = min(100, opts.Limit-len(issues))
+		result, err := jira.Search(ctx, searchOpts)
+		if err != nil {
+			return fmt.Errorf("failed to search issues: %w", err)
+		}
+		issues = append(issues, result.Issues...)
+
+		next, more := result.NextPage(searchOpts)
+		if !more {
+			break
+		}
+		if len(issues) >= opts.Limit {
+			truncated = true
+			break
+		}
+		searchOpts = next
+	}
+
+	summaryOutput := &SummaryOutput{
+		JQL:       jql,
+		GroupBy:   opts.GroupBy,
+		Count:     len(issues),
+		Truncated: truncated,
+		Buckets:   groupIssues(issues, opts.GroupBy),
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, summaryOutput)
+	}
+
+	if summaryOutput.Count == 0 {
+		fmt.Fprintln(opts.IO.Out, "No issues found.")
+		return nil
+	}
+
+	headers := []string{groupByHeader(opts.GroupBy), "COUNT"}
+	rows := make([][]string, 0, len(summaryOutput.Buckets))
+	for _, b := range summaryOutput.Buckets {
+		rows = append(rows, []string{b.Name, strconv.Itoa(b.Count)})
+	}
+	output.SimpleTable(opts.IO.Out, headers, rows)
+
+	fmt.Fprintln(opts.IO.Out, "")
+	fmt.Fprintf(opts.IO.Out, "Total: %d issues\n", summaryOutput.Count)
+	if truncated {
+		fmt.Fprintf(opts.IO.Out, "Stopped after %d issues; counts are partial. Raise --limit to include more.\n", opts.Limit)
+	}
+
+	return nil
+}
+
+// groupIssues counts issues per group, largest group first.
+func groupIssues(issues []*api.Issue, groupBy string) []*SummaryBucket {
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		counts[issueGroupName(issue, groupBy)]++
+	}
+
+	buckets := make([]*SummaryBucket, 0, len(counts))
+	for name, count := range counts {
+		buckets = append(buckets, &SummaryBucket{Name: name, Count: count})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].Count != buckets[j].Count {
+			return buckets[i].Count > buckets[j].Count
+		}
+		return buckets[i].Name < buckets[j].Name
+	})
+
+	return buckets
+}
+
+// issueGroupName returns the value of the group-by field for an issue.
+func issueGroupName(issue *api.Issue, groupBy string) string {
+	f := issue.Fields
+	switch groupBy {
+	case "assignee":
+		if f.Assignee != nil {
+			return f.Assignee.DisplayName
+		}
+		return "Unassigned"
+	case "type":
+		if f.IssueType != nil {
+			return f.IssueType.Name
+		}
+	case "priority":
+		if f.Priority != nil {
+			return f.Priority.Name
+		}
+	default:
+		if f.Status != nil {
+			return f.Status.Name
+		}
+	}
+	return "None"
+}
+
+func groupByHeader(groupBy string) string {
+	switch groupBy {
+	case "assignee":
+		return "ASSIGNEE"
+	case "type":
+		return "TYPE"
+	case "priority":
+		return "PRIORITY"
+	default:
+		return "STATUS"
+	}
+}
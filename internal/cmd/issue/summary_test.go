@@ -0,0 +1,48 @@
+package issue
+
+import (
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestGroupIssues(t *testing.T) {
+	issue := func(status, assignee string) *api.Issue {
+		i := &api.Issue{Fields: api.IssueFields{Status: &api.Status{Name: status}}}
+		if assignee != "" {
+			i.Fields.Assignee = &api.User{DisplayName: assignee}
+		}
+		return i
+	}
+
+	issues := []*api.Issue{
+		issue("To Do", "Ana"),
+		issue("Done", "Ana"),
+		issue("To Do", ""),
+		issue("In Progress", "Ben"),
+		issue("To Do", "Ben"),
+	}
+
+	tests := []struct {
+		groupBy string
+		want    []SummaryBucket
+	}{
+		{"status", []SummaryBucket{{"To Do", 3}, {"Done", 1}, {"In Progress", 1}}},
+		{"assignee", []SummaryBucket{{"Ana", 2}, {"Ben", 2}, {"Unassigned", 1}}},
+		{"type", []SummaryBucket{{"None", 5}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.groupBy, func(t *testing.T) {
+			got := groupIssues(issues, tt.groupBy)
+			if len(got) != len(tt.want) {
+				t.Fatalf("groupIssues() returned %d buckets, want %d", len(got), len(tt.want))
+			}
+			for i, b := range got {
+				if *b != tt.want[i] {
+					t.Errorf("bucket %d = %+v, want %+v", i, *b, tt.want[i])
+				}
+			}
+		})
+	}
+}
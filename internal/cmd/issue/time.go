@@ -0,0 +1,154 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// TimeOptions holds the options for the time command.
+type TimeOptions struct {
+	IO           *iostreams.IOStreams
+	IssueKey     string
+	SetOriginal  string
+	SetRemaining string
+	JSON         bool
+}
+
+// NewCmdTime creates the time command.
+func NewCmdTime(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &TimeOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "time <issue-key>",
+		Short: "View or set an issue's time tracking",
+		Long: `Show the original estimate, remaining estimate, and time spent on an
+issue, or set the estimates.
+
+Durations use Jira's format: a number followed by w (weeks), d (days),
+h (hours), or m (minutes), e.g. "2h", "1d 4h", or "1.5h". "0" clears the
+remaining estimate. Time spent changes only by logging work.`,
+		Example: `  # Show time tracking
+  atl issue time PROJ-123
+
+  # Set the original estimate
+  atl issue time PROJ-123 --set-original "3d"
+
+  # Set the remaining estimate
+  atl issue time PROJ-123 --set-remaining "1d 4h"
+
+  # Output as JSON
+  atl issue time PROJ-123 --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			if opts.SetOriginal, err = normalizeDuration(opts.SetOriginal); err != nil {
+				return fmt.Errorf("invalid --set-original: %w", err)
+			}
+			if opts.SetRemaining, err = normalizeDuration(opts.SetRemaining); err != nil {
+				return fmt.Errorf("invalid --set-remaining: %w", err)
+			}
+			return runTime(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.SetOriginal, "set-original", "", "Set the original estimate (e.g. 3d)")
+	cmd.Flags().StringVar(&opts.SetRemaining, "set-remaining", "", "Set the remaining estimate (e.g. \"1d 4h\")")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// TimeOutput represents an issue's time tracking.
+type TimeOutput struct {
+	IssueKey                 string `json:"issue_key"`
+	OriginalEstimate         string `json:"original_estimate,omitempty"`
+	RemainingEstimate        string `json:"remaining_estimate,omitempty"`
+	TimeSpent                string `json:"time_spent,omitempty"`
+	OriginalEstimateSeconds  int    `json:"original_estimate_seconds"`
+	RemainingEstimateSeconds int    `json:"remaining_estimate_seconds"`
+	TimeSpentSeconds         int    `json:"time_spent_seconds"`
+	Updated                  bool   `json:"updated,omitempty"`
+}
+
+func runTime(ctx context.Context, opts *TimeOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	updated := opts.SetOriginal != "" || opts.SetRemaining != ""
+	if updated {
+		if err := jira.SetTimeTracking(ctx, opts.IssueKey, opts.SetOriginal, opts.SetRemaining); err != nil {
+			return fmt.Errorf("failed to set time tracking: %w", err)
+		}
+	}
+
+	tt, err := jira.GetTimeTracking(ctx, opts.IssueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get time tracking: %w", err)
+	}
+
+	timeOutput := &TimeOutput{
+		IssueKey:                 opts.IssueKey,
+		OriginalEstimate:         tt.OriginalEstimate,
+		RemainingEstimate:        tt.RemainingEstimate,
+		TimeSpent:                tt.TimeSpent,
+		OriginalEstimateSeconds:  tt.OriginalEstimateSeconds,
+		RemainingEstimateSeconds: tt.RemainingEstimateSeconds,
+		TimeSpentSeconds:         tt.TimeSpentSeconds,
+		Updated:                  updated,
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, timeOutput)
+	}
+
+	if updated {
+		fmt.Fprintf(opts.IO.Out, "Updated time tracking of %s\n\n", opts.IssueKey)
+	}
+	fmt.Fprintf(opts.IO.Out, "Original estimate:  %s\n", orNone(timeOutput.OriginalEstimate))
+	fmt.Fprintf(opts.IO.Out, "Remaining estimate: %s\n", orNone(timeOutput.RemainingEstimate))
+	fmt.Fprintf(opts.IO.Out, "Time spent:         %s\n", orNone(timeOutput.TimeSpent))
+
+	return nil
+}
+
+// orNone returns s, or "none" if s is empty.
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+var durationRegex = regexp.MustCompile(`^(\d+(\.\d+)?[wdhm])( ?\d+(\.\d+)?[wdhm])*$`)
+
+// normalizeDuration checks that s is a Jira duration such as "1d 4h" or
+// "1.5h" and returns it lowercased with single spaces. "0" is accepted to
+// clear an estimate, and "" (not set) is returned as is.
+func normalizeDuration(s string) (string, error) {
+	d := strings.ToLower(strings.Join(strings.Fields(s), " "))
+	if d == "" || d == "0" {
+		return d, nil
+	}
+	if !durationRegex.MatchString(d) {
+		return "", fmt.Errorf("%q is not a duration; use e.g. 2h, \"1d 4h\", or 1.5h (units: w, d, h, m)", s)
+	}
+	return d, nil
+}
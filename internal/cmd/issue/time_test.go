@@ -0,0 +1,33 @@
+package issue
+
+import "testing"
+
+func TestNormalizeDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "", want: ""},
+		{in: "0", want: "0"},
+		{in: "2h", want: "2h"},
+		{in: "1d  4H", want: "1d 4h"},
+		{in: "1w2d", want: "1w2d"},
+		{in: "1.5h", want: "1.5h"},
+		{in: "90", wantErr: true},
+		{in: "2 hours", wantErr: true},
+		{in: "h", wantErr: true},
+		{in: "-1h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizeDuration(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("normalizeDuration(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeDuration(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -30,13 +30,19 @@ func NewCmdTransition(ios *iostreams.IOStreams) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "transition <issue-key> [status]",
+		Use:     "transition [issue-key] [status]",
 		Aliases: []string{"move", "tr"},
 		Short:   "Transition an issue to a new status",
-		Long:    `Move a Jira issue to a different status in its workflow.`,
+		Long: `Move a Jira issue to a different status in its workflow.
+
+When no issue key is given in an interactive terminal, you can pick one of
+your issues from a list; its available transitions are then shown.`,
 		Example: `  # List available transitions
   atl issue transition PROJ-1234 --list
 
+  # Pick one of your issues interactively
+  atl issue transition
+
   # Move issue to In Progress
   atl issue transition PROJ-1234 "In Progress"
 
@@ -48,13 +54,27 @@ func NewCmdTransition(ios *iostreams.IOStreams) *cobra.Command {
 
   # Output result as JSON
   atl issue transition PROJ-1234 Done --json`,
-		Args: cobra.RangeArgs(1, 2),
+		Args: cobra.MaximumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
+			if len(args) == 0 {
+				if opts.JSON || !canPickIssue(opts.IO) {
+					return fmt.Errorf("an issue key is required")
+				}
+				key, err := pickIssue(cmd.Context(), opts.IO)
+				if err != nil {
+					return err
+				}
+				args = []string{key}
+			}
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
 			if len(args) > 1 {
 				opts.Status = args[1]
 			}
-			return runTransition(opts)
+			return runTransition(cmd.Context(), opts)
 		},
 	}
 
@@ -87,13 +107,12 @@ type TransitionOutput struct {
 	URL        string `json:"url"`
 }
 
-func runTransition(opts *TransitionOptions) error {
+func runTransition(ctx context.Context, opts *TransitionOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	// Get available transitions
@@ -121,7 +140,7 @@ func runTransition(opts *TransitionOptions) error {
 		}
 
 		if opts.JSON {
-			return output.JSON(opts.IO.Out, listOutput)
+			return output.PrintJSON(opts.IO, listOutput)
 		}
 
 		if len(listOutput.Transitions) == 0 {
@@ -185,7 +204,8 @@ func runTransition(opts *TransitionOptions) error {
 	}
 
 	// Perform transition
-	if err := jira.TransitionIssue(ctx, opts.IssueKey, matchedTransition.ID, fields); err != nil {
+	err = jira.TransitionIssue(ctx, opts.IssueKey, matchedTransition.ID, fields)
+	if err != nil {
 		return fmt.Errorf("failed to transition issue: %w", err)
 	}
 
@@ -205,11 +225,11 @@ func runTransition(opts *TransitionOptions) error {
 		IssueKey:   opts.IssueKey,
 		FromStatus: fromStatus,
 		ToStatus:   toStatus,
-		URL:        fmt.Sprintf("https://%s/browse/%s", client.Hostname(), opts.IssueKey),
+		URL:        client.BrowseURL(opts.IssueKey),
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, transitionOutput)
+		return output.PrintJSON(opts.IO, transitionOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Transitioned %s: %s -> %s\n", opts.IssueKey, fromStatus, toStatus)
@@ -0,0 +1,170 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// TransitionsOptions holds the options for the transitions command.
+type TransitionsOptions struct {
+	IO       *iostreams.IOStreams
+	IssueKey string
+	JSON     bool
+}
+
+// NewCmdTransitions creates the transitions command.
+func NewCmdTransitions(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &TransitionsOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "transitions <issue-key>",
+		Short: "List the transitions available for an issue",
+		Long: `List the workflow transitions the issue can take right now, the status each
+one leads to, and the fields each one requires.
+
+Pass a transition name (or its target status) to 'atl issue transition'.
+Required fields are set there with --field, e.g. --field "Resolution=Fixed".`,
+		Example: `  # What can I move this issue to?
+  atl issue transitions PROJ-1234
+
+  # As JSON
+  atl issue transitions PROJ-1234 --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			return runTransitions(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// TransitionsOutput represents the transitions available for an issue.
+type TransitionsOutput struct {
+	IssueKey    string              `json:"issue_key"`
+	Transitions []*TransitionDetail `json:"transitions"`
+}
+
+// TransitionDetail represents a transition and the fields it requires.
+type TransitionDetail struct {
+	ID             string                 `json:"id"`
+	Name           string                 `json:"name"`
+	ToStatus       string                 `json:"to_status"`
+	ToCategory     string                 `json:"to_category,omitempty"`
+	RequiredFields []*TransitionFieldInfo `json:"required_fields"`
+}
+
+// TransitionFieldInfo represents a field required by a transition.
+type TransitionFieldInfo struct {
+	FieldID       string   `json:"field_id"`
+	Name          string   `json:"name"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+}
+
+func runTransitions(ctx context.Context, opts *TransitionsOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	transitions, err := jira.GetTransitionsWithFields(ctx, opts.IssueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get transitions: %w", err)
+	}
+
+	transitionsOutput := formatTransitions(opts.IssueKey, transitions)
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, transitionsOutput)
+	}
+
+	printTransitions(opts.IO.Out, transitionsOutput)
+	return nil
+}
+
+// formatTransitions converts transitions fetched with their fields into the
+// command output. Required fields are sorted by name.
+func formatTransitions(issueKey string, transitions []*api.Transition) *TransitionsOutput {
+	out := &TransitionsOutput{
+		IssueKey:    issueKey,
+		Transitions: make([]*TransitionDetail, 0, len(transitions)),
+	}
+
+	for _, t := range transitions {
+		detail := &TransitionDetail{
+			ID:             t.ID,
+			Name:           t.Name,
+			RequiredFields: []*TransitionFieldInfo{},
+		}
+		if t.To != nil {
+			detail.ToStatus = t.To.Name
+			if t.To.StatusCategory != nil {
+				detail.ToCategory = t.To.StatusCategory.Key
+			}
+		}
+
+		for id, fm := range t.Fields {
+			if fm == nil || !fm.Required {
+				continue
+			}
+			field := &TransitionFieldInfo{
+				FieldID: id,
+				Name:    fm.Name,
+			}
+			if field.Name == "" {
+				field.Name = id
+			}
+			for _, raw := range fm.AllowedValues {
+				if v := extractAllowedValue(raw); v != "" {
+					field.AllowedValues = append(field.AllowedValues, v)
+				}
+			}
+			detail.RequiredFields = append(detail.RequiredFields, field)
+		}
+		sort.Slice(detail.RequiredFields, func(i, j int) bool {
+			return detail.RequiredFields[i].Name < detail.RequiredFields[j].Name
+		})
+
+		out.Transitions = append(out.Transitions, detail)
+	}
+
+	return out
+}
+
+func printTransitions(w io.Writer, out *TransitionsOutput) {
+	if len(out.Transitions) == 0 {
+		fmt.Fprintf(w, "No transitions available for %s\n", out.IssueKey)
+		return
+	}
+
+	fmt.Fprintf(w, "Available transitions for %s:\n\n", out.IssueKey)
+	for _, t := range out.Transitions {
+		fmt.Fprintf(w, "  %s -> %s (ID: %s)\n", t.Name, t.ToStatus, t.ID)
+		for _, f := range t.RequiredFields {
+			fmt.Fprintf(w, "    Requires: %s [%s]", f.Name, f.FieldID)
+			if len(f.AllowedValues) > 0 {
+				fmt.Fprintf(w, ": %s", strings.Join(f.AllowedValues, ", "))
+			}
+			fmt.Fprintln(w)
+		}
+	}
+}
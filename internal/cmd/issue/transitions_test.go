@@ -0,0 +1,94 @@
+package issue
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+// transitionsResponse is a trimmed GET /issue/{key}/transitions response with
+// expand=transitions.fields.
+const transitionsResponse = `{
+  "transitions": [
+    {
+      "id": "21",
+      "name": "Start Progress",
+      "to": {"name": "In Progress", "statusCategory": {"key": "indeterminate"}},
+      "fields": {}
+    },
+    {
+      "id": "31",
+      "name": "Close",
+      "to": {"name": "Done", "statusCategory": {"key": "done"}},
+      "fields": {
+        "resolution": {
+          "required": true,
+          "name": "Resolution",
+          "key": "resolution",
+          "allowedValues": [{"id": "1", "name": "Fixed"}, {"id": "2", "name": "Won't Do"}]
+        },
+        "comment": {"required": false, "name": "Comment", "key": "comment"},
+        "customfield_10050": {"required": true, "name": "Fix Notes", "key": "customfield_10050"}
+      }
+    }
+  ]
+}`
+
+func TestFormatTransitions(t *testing.T) {
+	var resp struct {
+		Transitions []*api.Transition `json:"transitions"`
+	}
+	if err := json.Unmarshal([]byte(transitionsResponse), &resp); err != nil {
+		t.Fatalf("failed to parse mock response: %v", err)
+	}
+
+	out := formatTransitions("PROJ-1", resp.Transitions)
+
+	if len(out.Transitions) != 2 {
+		t.Fatalf("got %d transitions, want 2", len(out.Transitions))
+	}
+
+	start := out.Transitions[0]
+	if start.Name != "Start Progress" || start.ToStatus != "In Progress" || start.ToCategory != "indeterminate" {
+		t.Errorf("first transition = %+v", start)
+	}
+	if start.RequiredFields == nil || len(start.RequiredFields) != 0 {
+		t.Errorf("first transition RequiredFields = %v, want empty", start.RequiredFields)
+	}
+
+	closeT := out.Transitions[1]
+	if len(closeT.RequiredFields) != 2 {
+		t.Fatalf("Close RequiredFields = %d, want 2 (optional fields skipped)", len(closeT.RequiredFields))
+	}
+	if f := closeT.RequiredFields[0]; f.Name != "Fix Notes" || f.FieldID != "customfield_10050" || len(f.AllowedValues) != 0 {
+		t.Errorf("RequiredFields[0] = %+v", f)
+	}
+	if f := closeT.RequiredFields[1]; f.Name != "Resolution" || strings.Join(f.AllowedValues, ",") != "Fixed,Won't Do" {
+		t.Errorf("RequiredFields[1] = %+v", f)
+	}
+
+	var buf bytes.Buffer
+	printTransitions(&buf, out)
+	for _, want := range []string{
+		"Available transitions for PROJ-1:",
+		"Start Progress -> In Progress (ID: 21)",
+		"Close -> Done (ID: 31)",
+		"Requires: Resolution [resolution]: Fixed, Won't Do",
+		"Requires: Fix Notes [customfield_10050]\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output missing %q\nGot:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestPrintTransitionsEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	printTransitions(&buf, formatTransitions("PROJ-1", nil))
+	if got := buf.String(); got != "No transitions available for PROJ-1\n" {
+		t.Errorf("output = %q", got)
+	}
+}
@@ -40,7 +40,7 @@ the correct issue type name when creating subtasks.`,
 			if opts.Project == "" {
 				return fmt.Errorf("--project is required\n\nExample: atl issue types --project PROJ")
 			}
-			return runTypes(opts)
+			return runTypes(cmd.Context(), opts)
 		},
 	}
 
@@ -65,13 +65,12 @@ type TypesOutput struct {
 	Total   int           `json:"total"`
 }
 
-func runTypes(opts *TypesOptions) error {
+func runTypes(ctx context.Context, opts *TypesOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	types, err := jira.GetProjectIssueTypes(ctx, opts.Project)
@@ -95,7 +94,7 @@ func runTypes(opts *TypesOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, typesOutput)
+		return output.PrintJSON(opts.IO, typesOutput)
 	}
 
 	if len(typesOutput.Types) == 0 {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -18,10 +19,15 @@ import (
 
 // ViewOptions holds the options for the view command.
 type ViewOptions struct {
-	IO       *iostreams.IOStreams
-	IssueKey string
-	JSON     bool
-	Web      bool
+	IO             *iostreams.IOStreams
+	IssueKey       string
+	Fields         []string
+	ValidateFields bool
+	Expand         []string
+	JSON           bool
+	Markdown       bool
+	HTML           bool
+	Web            bool
 }
 
 // NewCmdView creates the view command.
@@ -31,48 +37,123 @@ func NewCmdView(ios *iostreams.IOStreams) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "view <issue-key>",
+		Use:   "view [issue-key]",
 		Short: "View a Jira issue",
-		Long:  `Display details of a Jira issue.`,
+		Long: `Display details of a Jira issue.
+
+When no issue key is given in an interactive terminal, you can pick one of
+your issues from a list.
+
+By default every field is fetched. Use --fields to fetch only the fields you
+need, which is faster for scripts on issues with many custom fields. Jira
+silently ignores unknown field IDs; add --validate-fields to check them
+first, which also accepts field names such as "Story Points". Use
+--expand to include the available transitions or the recent changelog
+without a second command.
+
+Use --markdown to render the whole issue, including all comments, as one
+Markdown document for piping into a renderer or committing to a repository.
+
+Use --html to get the description as the HTML Jira renders, for tools that
+display HTML. It replaces the plain text description in the text output and
+is added as description_html to the --json output. With --fields, the
+description is fetched even if it is not listed.`,
 		Example: `  # View an issue
   atl issue view PROJ-1234
 
+  # Pick one of your issues interactively
+  atl issue view
+
   # View an issue as JSON
   atl issue view PROJ-1234 --json
 
+  # Fetch only a few fields
+  atl issue view PROJ-1234 --fields summary,status,assignee --json
+
+  # Include available transitions and recent history
+  atl issue view PROJ-1234 --expand transitions,changelog
+
+  # Check the fields exist, using names for custom fields
+  atl issue view PROJ-1234 --fields summary,"Story Points" --validate-fields --json
+
+  # Save the issue and its comments as Markdown
+  atl issue view PROJ-1234 --markdown > PROJ-1234.md
+
+  # Get the rendered description HTML
+  atl issue view PROJ-1234 --json --html | jq -r .description_html
+
   # Open issue in browser
   atl issue view PROJ-1234 --web`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
-			return runView(opts)
+			for _, e := range opts.Expand {
+				if e != "transitions" && e != "changelog" {
+					return fmt.Errorf("invalid --expand value: %s (expected transitions or changelog)", e)
+				}
+			}
+			if opts.Markdown && opts.JSON {
+				return fmt.Errorf("--markdown and --json cannot be used together")
+			}
+			if opts.ValidateFields && len(opts.Fields) == 0 {
+				return fmt.Errorf("--validate-fields requires --fields")
+			}
+			if opts.Markdown && opts.HTML {
+				return fmt.Errorf("--markdown and --html cannot be used together")
+			}
+			if len(args) == 0 {
+				if opts.JSON || !canPickIssue(opts.IO) {
+					return fmt.Errorf("an issue key is required")
+				}
+				key, err := pickIssue(cmd.Context(), opts.IO)
+				if err != nil {
+					return err
+				}
+				args = []string{key}
+			}
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			return runView(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+	cmd.Flags().BoolVar(&opts.Markdown, "markdown", false, "Render the issue and its comments as a Markdown document")
+	cmd.Flags().BoolVar(&opts.HTML, "html", false, "Show the description as rendered HTML")
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open in web browser")
+	cmd.Flags().StringSliceVar(&opts.Fields, "fields", nil, "Only fetch these fields (comma-separated field IDs)")
+	cmd.Flags().BoolVar(&opts.ValidateFields, "validate-fields", false, "Check --fields against the Jira fields, accepting field names")
+	cmd.Flags().StringSliceVar(&opts.Expand, "expand", nil, "Also fetch: transitions, changelog (comma-separated)")
 
 	return cmd
 }
 
 // IssueOutput represents the output format for an issue (LLM-friendly).
 type IssueOutput struct {
-	Key            string                        `json:"key"`
-	ID             string                        `json:"id"`
-	Summary        string                        `json:"summary"`
-	Description    string                        `json:"description,omitempty"`
-	Status         string                        `json:"status"`
-	StatusCategory string                        `json:"status_category,omitempty"`
-	Priority       string                        `json:"priority,omitempty"`
-	Type           string                        `json:"type"`
-	Assignee       *UserOutput                   `json:"assignee,omitempty"`
-	Reporter       *UserOutput                   `json:"reporter,omitempty"`
-	Project        *ProjectOutput                `json:"project"`
-	Labels         []string                      `json:"labels,omitempty"`
-	Created        string                        `json:"created"`
-	Updated        string                        `json:"updated"`
-	URL            string                        `json:"url"`
-	CustomFields   map[string]*CustomFieldOutput `json:"custom_fields,omitempty"`
+	Key             string                        `json:"key"`
+	ID              string                        `json:"id"`
+	Summary         string                        `json:"summary"`
+	Description     string                        `json:"description,omitempty"`
+	DescriptionHTML string                        `json:"description_html,omitempty"`
+	Status          string                        `json:"status"`
+	StatusCategory  string                        `json:"status_category,omitempty"`
+	Priority        string                        `json:"priority,omitempty"`
+	SecurityLevel   string                        `json:"security_level,omitempty"`
+	Type            string                        `json:"type"`
+	Assignee        *UserOutput                   `json:"assignee,omitempty"`
+	Reporter        *UserOutput                   `json:"reporter,omitempty"`
+	Project         *ProjectOutput                `json:"project"`
+	Labels          []string                      `json:"labels,omitempty"`
+	FlagReason      string                        `json:"flag_reason,omitempty"`
+	Votes           int                           `json:"votes"`
+	Created         string                        `json:"created"`
+	Updated         string                        `json:"updated"`
+	URL             string                        `json:"url"`
+	CustomFields    map[string]*CustomFieldOutput `json:"custom_fields,omitempty"`
+	Transitions     []*TransitionItem             `json:"transitions,omitempty"`
+	Changelog       []*ChangelogEntryOutput       `json:"changelog,omitempty"`
 }
 
 // CustomFieldOutput represents a custom field in the output.
@@ -89,27 +170,105 @@ type UserOutput struct {
 	Email       string `json:"email,omitempty"`
 }
 
+// viewIssueFields are accepted by --fields without being Jira fields:
+// the issue's key and ID, which are always returned.
+var viewIssueFields = []string{"key", "id"}
+
+// resolveFieldIDs checks requested --fields entries against the Jira
+// fields, matching IDs and keys exactly and names case-insensitively, and
+// returns them as field IDs. A name shared by several fields is an error
+// listing their IDs. Jira's selectors such as "*all" and "-comment" are
+// passed through unchecked.
+func resolveFieldIDs(requested []string, fields []*api.Field) ([]string, error) {
+	ids := make([]string, 0, len(requested))
+	var unknown []string
+	for _, r := range requested {
+		r = strings.TrimSpace(r)
+		if strings.HasPrefix(r, "*") || strings.HasPrefix(r, "-") || slices.Contains(viewIssueFields, r) {
+			ids = append(ids, r)
+			continue
+		}
+		id := ""
+		for _, f := range fields {
+			if f.ID == r || f.Key == r {
+				id = f.ID
+				break
+			}
+		}
+		if id == "" {
+			f, err := api.FindFieldByName(fields, r)
+			if err != nil {
+				return nil, err
+			}
+			if f != nil {
+				id = f.ID
+			}
+		}
+		if id == "" {
+			unknown = append(unknown, r)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown fields: %s\n\nUse 'atl issue fields' to list the available fields", strings.Join(unknown, ", "))
+	}
+	return ids, nil
+}
+
+// viewFieldIDs returns the field IDs to fetch. --html shows the rendered
+// description, so it is added to a --fields list that leaves it out.
+func viewFieldIDs(ids []string, html bool) []string {
+	if !html || len(ids) == 0 || slices.Contains(ids, "description") {
+		return ids
+	}
+	return append(slices.Clone(ids), "description")
+}
+
+// viewIssueOutput formats the issue for view, with the rendered
+// description when html is set.
+func viewIssueOutput(issue *api.Issue, browseBase string, fieldNames map[string]string, html bool) *IssueOutput {
+	out := formatIssueOutput(issue, browseBase, fieldNames)
+	if html {
+		out.DescriptionHTML = issue.RenderedField("description")
+	}
+	return out
+}
+
 // ProjectOutput represents project information.
 type ProjectOutput struct {
 	Key  string `json:"key"`
 	Name string `json:"name"`
 }
 
-func runView(opts *ViewOptions) error {
+func runView(ctx context.Context, opts *ViewOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
 	if opts.Web {
-		url := fmt.Sprintf("https://%s/browse/%s", client.Hostname(), opts.IssueKey)
+		url := client.BrowseURL(opts.IssueKey)
 		return auth.OpenBrowser(url)
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
-	issue, err := jira.GetIssue(ctx, opts.IssueKey)
+	fieldIDs := opts.Fields
+	if opts.ValidateFields {
+		fields, err := jira.GetFields(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get fields: %w", err)
+		}
+		if fieldIDs, err = resolveFieldIDs(opts.Fields, fields); err != nil {
+			return err
+		}
+	}
+
+	issue, err := jira.GetIssueWithOptions(ctx, opts.IssueKey, api.GetIssueOptions{
+		Fields: viewFieldIDs(fieldIDs, opts.HTML),
+		Expand: opts.Expand,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to get issue: %w", err)
 	}
@@ -125,10 +284,31 @@ func runView(opts *ViewOptions) error {
 		}
 	}
 
-	issueOutput := formatIssueOutput(issue, client.Hostname(), fieldNames)
+	issueOutput := viewIssueOutput(issue, client.BrowseBaseURL(), fieldNames, opts.HTML)
+
+	// The reason of a flagged issue is in its latest flag comment.
+	var comments []*api.Comment
+	if isFlagged(issue, fieldNames) {
+		comments, err = jira.GetCommentsAll(ctx, opts.IssueKey)
+		if err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "Warning: failed to get comments for the flag reason: %v\n", err)
+		}
+		issueOutput.FlagReason = flagReason(comments)
+	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, issueOutput)
+		return output.PrintJSON(opts.IO, issueOutput)
+	}
+
+	if opts.Markdown {
+		if comments == nil {
+			comments, err = jira.GetCommentsAll(ctx, opts.IssueKey)
+			if err != nil {
+				return fmt.Errorf("failed to get comments: %w", err)
+			}
+		}
+		printIssueMarkdown(opts.IO.Out, issueOutput, toMarkdownComments(comments))
+		return nil
 	}
 
 	// Plain text output (LLM-friendly format)
@@ -137,12 +317,12 @@ func runView(opts *ViewOptions) error {
 	return nil
 }
 
-func formatIssueOutput(issue *api.Issue, hostname string, fieldNames map[string]string) *IssueOutput {
+func formatIssueOutput(issue *api.Issue, browseBase string, fieldNames map[string]string) *IssueOutput {
 	out := &IssueOutput{
 		Key:     issue.Key,
 		ID:      issue.ID,
 		Summary: issue.Fields.Summary,
-		URL:     fmt.Sprintf("https://%s/browse/%s", hostname, issue.Key),
+		URL:     api.IssueBrowseURL(browseBase, issue.Key),
 	}
 
 	if issue.Fields.Description != nil {
@@ -160,6 +340,10 @@ func formatIssueOutput(issue *api.Issue, hostname string, fieldNames map[string]
 		out.Priority = issue.Fields.Priority.Name
 	}
 
+	if issue.Fields.Security != nil {
+		out.SecurityLevel = issue.Fields.Security.Name
+	}
+
 	if issue.Fields.IssueType != nil {
 		out.Type = issue.Fields.IssueType.Name
 	}
@@ -188,6 +372,9 @@ func formatIssueOutput(issue *api.Issue, hostname string, fieldNames map[string]
 	}
 
 	out.Labels = issue.Fields.Labels
+	if issue.Fields.Votes != nil {
+		out.Votes = issue.Fields.Votes.Votes
+	}
 	out.Created = formatTime(issue.Fields.Created)
 	out.Updated = formatTime(issue.Fields.Updated)
 
@@ -211,17 +398,48 @@ func formatIssueOutput(issue *api.Issue, hostname string, fieldNames map[string]
 		}
 	}
 
+	for _, t := range issue.Transitions {
+		item := &TransitionItem{ID: t.ID, Name: t.Name}
+		if t.To != nil {
+			item.ToStatus = t.To.Name
+		}
+		out.Transitions = append(out.Transitions, item)
+	}
+
+	if issue.Changelog != nil {
+		for _, entry := range issue.Changelog.Histories {
+			out.Changelog = append(out.Changelog, formatChangelogEntryOutput(entry))
+		}
+	}
+
 	return out
 }
 
+// isFlagged reports whether the issue's Flagged field is set. fieldNames
+// maps custom field IDs to names.
+func isFlagged(issue *api.Issue, fieldNames map[string]string) bool {
+	for id, raw := range issue.Fields.Extra {
+		if strings.EqualFold(fieldNames[id], "Flagged") && api.FormatCustomFieldValue(raw) != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func printIssueDetails(ios *iostreams.IOStreams, issue *IssueOutput) {
 	fmt.Fprintf(ios.Out, "# %s: %s\n\n", issue.Key, issue.Summary)
 
 	fmt.Fprintf(ios.Out, "Type: %s\n", issue.Type)
 	fmt.Fprintf(ios.Out, "Status: %s\n", issue.Status)
+	if issue.FlagReason != "" {
+		fmt.Fprintf(ios.Out, "Flag reason: %s\n", issue.FlagReason)
+	}
 	if issue.Priority != "" {
 		fmt.Fprintf(ios.Out, "Priority: %s\n", issue.Priority)
 	}
+	if issue.SecurityLevel != "" {
+		fmt.Fprintf(ios.Out, "Security Level: %s\n", issue.SecurityLevel)
+	}
 
 	if issue.Project != nil {
 		fmt.Fprintf(ios.Out, "Project: %s (%s)\n", issue.Project.Name, issue.Project.Key)
@@ -241,6 +459,10 @@ func printIssueDetails(ios *iostreams.IOStreams, issue *IssueOutput) {
 		fmt.Fprintf(ios.Out, "Labels: %s\n", strings.Join(issue.Labels, ", "))
 	}
 
+	if issue.Votes > 0 {
+		fmt.Fprintf(ios.Out, "Votes: %d\n", issue.Votes)
+	}
+
 	fmt.Fprintf(ios.Out, "Created: %s\n", issue.Created)
 	fmt.Fprintf(ios.Out, "Updated: %s\n", issue.Updated)
 	fmt.Fprintf(ios.Out, "URL: %s\n", issue.URL)
@@ -263,12 +485,33 @@ func printIssueDetails(ios *iostreams.IOStreams, issue *IssueOutput) {
 		}
 	}
 
-	if issue.Description != "" {
+	if issue.DescriptionHTML != "" {
+		fmt.Fprintln(ios.Out, "")
+		fmt.Fprintln(ios.Out, "## Description")
+		fmt.Fprintln(ios.Out, "")
+		fmt.Fprintln(ios.Out, issue.DescriptionHTML)
+	} else if issue.Description != "" {
 		fmt.Fprintln(ios.Out, "")
 		fmt.Fprintln(ios.Out, "## Description")
 		fmt.Fprintln(ios.Out, "")
 		fmt.Fprintln(ios.Out, issue.Description)
 	}
+
+	if len(issue.Transitions) > 0 {
+		fmt.Fprintln(ios.Out, "")
+		fmt.Fprintln(ios.Out, "## Transitions")
+		fmt.Fprintln(ios.Out, "")
+		for _, t := range issue.Transitions {
+			fmt.Fprintf(ios.Out, "%s → %s (ID: %s)\n", t.Name, t.ToStatus, t.ID)
+		}
+	}
+
+	if len(issue.Changelog) > 0 {
+		fmt.Fprintln(ios.Out, "")
+		fmt.Fprintln(ios.Out, "## Changelog")
+		fmt.Fprintln(ios.Out, "")
+		printChangelog(ios, issue.Key, issue.Changelog)
+	}
 }
 
 func formatTime(timeStr string) string {
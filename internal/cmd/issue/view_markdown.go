@@ -0,0 +1,105 @@
+package issue
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+// markdownComment is a comment prepared for the --markdown document.
+type markdownComment struct {
+	Author  string
+	Created string
+	Body    string // Markdown, converted from ADF
+}
+
+// toMarkdownComments converts API comments for printIssueMarkdown.
+func toMarkdownComments(comments []*api.Comment) []*markdownComment {
+	out := make([]*markdownComment, 0, len(comments))
+	for _, c := range comments {
+		mc := &markdownComment{
+			Author:  "Unknown",
+			Created: formatTime(c.Created),
+			Body:    api.ADFToText(c.Body),
+		}
+		if c.Author != nil {
+			mc.Author = c.Author.DisplayName
+		}
+		out = append(out, mc)
+	}
+	return out
+}
+
+// printIssueMarkdown renders the issue as a single Markdown document: the
+// summary as a heading, a metadata table, the description, and one section
+// per comment.
+func printIssueMarkdown(w io.Writer, issue *IssueOutput, comments []*markdownComment) {
+	fmt.Fprintf(w, "# %s: %s\n\n", issue.Key, issue.Summary)
+
+	rows := [][2]string{
+		{"Type", issue.Type},
+		{"Status", issue.Status},
+		{"Priority", issue.Priority},
+		{"Security Level", issue.SecurityLevel},
+	}
+	if issue.Project != nil {
+		rows = append(rows, [2]string{"Project", fmt.Sprintf("%s (%s)", issue.Project.Name, issue.Project.Key)})
+	}
+	assignee := "Unassigned"
+	if issue.Assignee != nil {
+		assignee = issue.Assignee.DisplayName
+	}
+	rows = append(rows, [2]string{"Assignee", assignee})
+	if issue.Reporter != nil {
+		rows = append(rows, [2]string{"Reporter", issue.Reporter.DisplayName})
+	}
+	rows = append(rows,
+		[2]string{"Labels", strings.Join(issue.Labels, ", ")},
+		[2]string{"Created", issue.Created},
+		[2]string{"Updated", issue.Updated},
+		[2]string{"URL", issue.URL},
+	)
+
+	// Custom fields follow the standard ones, sorted for stable output.
+	names := make([]string, 0, len(issue.CustomFields))
+	for name := range issue.CustomFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		rows = append(rows, [2]string{name, issue.CustomFields[name].Value})
+	}
+
+	fmt.Fprintln(w, "| Field | Value |")
+	fmt.Fprintln(w, "| --- | --- |")
+	for _, row := range rows {
+		if row[1] == "" {
+			continue
+		}
+		fmt.Fprintf(w, "| %s | %s |\n", markdownCell(row[0]), markdownCell(row[1]))
+	}
+
+	if issue.Description != "" {
+		fmt.Fprintf(w, "\n## Description\n\n%s\n", issue.Description)
+	}
+
+	if len(comments) > 0 {
+		fmt.Fprintf(w, "\n## Comments\n")
+		for _, c := range comments {
+			fmt.Fprintf(w, "\n### %s (%s)\n\n", c.Author, c.Created)
+			if c.Body != "" {
+				fmt.Fprintln(w, c.Body)
+			}
+		}
+	}
+}
+
+// markdownCell escapes a value for use inside a Markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,55 @@
+package issue
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata")
+
+func TestPrintIssueMarkdown(t *testing.T) {
+	issue := &IssueOutput{
+		Key:         "TEST-123",
+		Summary:     "Checkout fails for saved cards",
+		Description: "Steps to reproduce:\n\n1. Save a card\n2. Check out",
+		Status:      "In Progress",
+		Priority:    "High",
+		Type:        "Bug",
+		Assignee:    &UserOutput{DisplayName: "John Doe"},
+		Reporter:    &UserOutput{DisplayName: "Jane Doe"},
+		Project:     &ProjectOutput{Key: "TEST", Name: "Test Project"},
+		Labels:      []string{"payments", "regression"},
+		Created:     "2024-01-15 10:00:00",
+		Updated:     "2024-01-16 14:30:00",
+		URL:         "https://example.atlassian.net/browse/TEST-123",
+		CustomFields: map[string]*CustomFieldOutput{
+			"Story Points": {ID: "customfield_10016", Value: "3"},
+			"Team":         {ID: "customfield_10001", Value: "Web | Mobile"},
+		},
+	}
+	comments := []*markdownComment{
+		{Author: "Jane Doe", Created: "2024-01-15 11:00:00", Body: "Seeing this on **staging** too."},
+		{Author: "John Doe", Created: "2024-01-16 09:15:00", Body: "Fix is in review."},
+	}
+
+	var buf bytes.Buffer
+	printIssueMarkdown(&buf, issue, comments)
+
+	golden := filepath.Join("testdata", "view_markdown.golden")
+	if *updateGolden {
+		if err := os.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("reading golden file (run with -update to create it): %v", err)
+	}
+	if got := buf.String(); got != string(want) {
+		t.Errorf("markdown output mismatch (run with -update to accept)\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -2,6 +2,9 @@ package issue
 
 import (
 	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/enthus-appdev/atl-cli/internal/api"
@@ -90,8 +93,8 @@ func TestFormatIssueOutput(t *testing.T) {
 		},
 	}
 
-	hostname := "example.atlassian.net"
-	output := formatIssueOutput(issue, hostname, nil)
+	browseBase := "https://example.atlassian.net"
+	output := formatIssueOutput(issue, browseBase, nil)
 
 	// Verify basic fields
 	if output.Key != "TEST-123" {
@@ -165,7 +168,7 @@ func TestFormatIssueOutputMinimal(t *testing.T) {
 		},
 	}
 
-	output := formatIssueOutput(issue, "example.atlassian.net", nil)
+	output := formatIssueOutput(issue, "https://example.atlassian.net", nil)
 
 	if output.Key != "TEST-1" {
 		t.Errorf("Key = %q, want %q", output.Key, "TEST-1")
@@ -201,6 +204,7 @@ func TestPrintIssueDetails(t *testing.T) {
 		Assignee:    &UserOutput{DisplayName: "John Doe"},
 		Reporter:    &UserOutput{DisplayName: "Jane Doe"},
 		Labels:      []string{"bug"},
+		Votes:       3,
 		Created:     "2024-01-15 10:00:00",
 		Updated:     "2024-01-16 14:30:00",
 		URL:         "https://example.atlassian.net/browse/TEST-123",
@@ -221,6 +225,7 @@ func TestPrintIssueDetails(t *testing.T) {
 		"Assignee: John Doe",
 		"Reporter: Jane Doe",
 		"Labels: bug",
+		"Votes: 3",
 		"URL: https://example.atlassian.net/browse/TEST-123",
 		"## Description",
 		"This is the description.",
@@ -233,6 +238,91 @@ func TestPrintIssueDetails(t *testing.T) {
 	}
 }
 
+// TestPrintIssueDetailsHTML tests that the rendered description replaces
+// the plain text one.
+func TestPrintIssueDetailsHTML(t *testing.T) {
+	outBuf := &bytes.Buffer{}
+	ios := &iostreams.IOStreams{
+		Out: outBuf,
+	}
+
+	var issue api.Issue
+	data := `{"key":"TEST-123","fields":{"summary":"Test Issue","description":{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Plain"}]}]}},"renderedFields":{"description":"<p>Plain</p>"}}`
+	if err := json.Unmarshal([]byte(data), &issue); err != nil {
+		t.Fatal(err)
+	}
+
+	if plain := viewIssueOutput(&issue, "https://example.atlassian.net", nil, false); plain.DescriptionHTML != "" {
+		t.Errorf("DescriptionHTML without --html = %q, want empty", plain.DescriptionHTML)
+	}
+
+	issueOutput := viewIssueOutput(&issue, "https://example.atlassian.net", nil, true)
+	if issueOutput.DescriptionHTML != "<p>Plain</p>" {
+		t.Fatalf("DescriptionHTML = %q, want the rendered description", issueOutput.DescriptionHTML)
+	}
+
+	printIssueDetails(ios, issueOutput)
+
+	output := outBuf.String()
+	if !contains(output, "## Description\n\n<p>Plain</p>\n") {
+		t.Errorf("Output missing the HTML description\nGot: %s", output)
+	}
+}
+
+func TestViewFieldIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []string
+		html bool
+		want []string
+	}{
+		{"all fields", nil, true, nil},
+		{"no html", []string{"summary"}, false, []string{"summary"}},
+		{"adds description", []string{"summary"}, true, []string{"summary", "description"}},
+		{"already listed", []string{"description", "summary"}, true, []string{"description", "summary"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := viewFieldIDs(tt.ids, tt.html); !slices.Equal(got, tt.want) {
+				t.Errorf("viewFieldIDs(%v, %v) = %v, want %v", tt.ids, tt.html, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFormatIssueOutputExpanded tests transitions and changelog from expands.
+func TestFormatIssueOutputExpanded(t *testing.T) {
+	issue := &api.Issue{
+		Key: "TEST-1",
+		Transitions: []*api.Transition{
+			{ID: "31", Name: "Start", To: &api.Status{Name: "In Progress"}},
+		},
+		Changelog: &api.IssueChangelog{
+			Histories: []*api.ChangelogEntry{{
+				Created: "2024-01-15T10:00:00.000+0000",
+				Author:  &api.User{DisplayName: "Ana"},
+				Items:   []*api.ChangelogItem{{Field: "status", FromString: "To Do", ToString: "In Progress"}},
+			}},
+		},
+	}
+
+	out := formatIssueOutput(issue, "https://example.atlassian.net", nil)
+	if len(out.Transitions) != 1 || out.Transitions[0].ToStatus != "In Progress" {
+		t.Errorf("Transitions = %+v, want one transition to In Progress", out.Transitions)
+	}
+	if len(out.Changelog) != 1 || out.Changelog[0].Author != "Ana" {
+		t.Errorf("Changelog = %+v, want one entry by Ana", out.Changelog)
+	}
+
+	outBuf := &bytes.Buffer{}
+	printIssueDetails(&iostreams.IOStreams{Out: outBuf}, out)
+	for _, expected := range []string{"## Transitions", "Start → In Progress (ID: 31)", "## Changelog", `status: "To Do" → "In Progress"`} {
+		if !contains(outBuf.String(), expected) {
+			t.Errorf("Output missing %q\nGot: %s", expected, outBuf.String())
+		}
+	}
+}
+
 // TestPrintIssueDetailsUnassigned tests output when issue is unassigned.
 func TestPrintIssueDetailsUnassigned(t *testing.T) {
 	outBuf := &bytes.Buffer{}
@@ -265,8 +355,8 @@ func TestNewCmdView(t *testing.T) {
 	if cmd == nil {
 		t.Fatal("NewCmdView() returned nil")
 	}
-	if cmd.Use != "view <issue-key>" {
-		t.Errorf("Use = %q, want %q", cmd.Use, "view <issue-key>")
+	if cmd.Use != "view [issue-key]" {
+		t.Errorf("Use = %q, want %q", cmd.Use, "view [issue-key]")
 	}
 	if cmd.Short == "" {
 		t.Error("Short description should not be empty")
@@ -281,6 +371,60 @@ func TestNewCmdView(t *testing.T) {
 	if webFlag == nil {
 		t.Error("--web flag should exist")
 	}
+
+	// Without a terminal, a missing key is an error rather than a prompt
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err == nil {
+		t.Error("view without an issue key should fail when not interactive")
+	}
+
+	cmd = NewCmdView(ios)
+	cmd.SetArgs([]string{"TEST-1", "--expand", "comments"})
+	if err := cmd.Execute(); err == nil {
+		t.Error("view with an unknown --expand value should fail")
+	}
+
+	cmd = NewCmdView(ios)
+	cmd.SetArgs([]string{"TEST-1", "--validate-fields"})
+	if err := cmd.Execute(); err == nil {
+		t.Error("--validate-fields without --fields should fail")
+	}
+}
+
+func TestResolveFieldIDs(t *testing.T) {
+	fields := []*api.Field{
+		{ID: "summary", Key: "summary", Name: "Summary"},
+		{ID: "status", Key: "status", Name: "Status"},
+		{ID: "customfield_10016", Key: "customfield_10016", Name: "Story Points", Custom: true},
+	}
+
+	got, err := resolveFieldIDs([]string{"key", "summary", "Story Points", "customfield_10016", "*navigable", "-comment"}, fields)
+	if err != nil {
+		t.Fatalf("resolveFieldIDs() error = %v", err)
+	}
+	want := "key,summary,customfield_10016,customfield_10016,*navigable,-comment"
+	if strings.Join(got, ",") != want {
+		t.Errorf("resolveFieldIDs() = %s, want %s", strings.Join(got, ","), want)
+	}
+
+	got, err = resolveFieldIDs([]string{"STATUS"}, fields)
+	if err != nil || len(got) != 1 || got[0] != "status" {
+		t.Errorf("names should match case-insensitively, got %v, %v", got, err)
+	}
+
+	_, err = resolveFieldIDs([]string{"summary", "sumary", "Points"}, fields)
+	if err == nil || !strings.Contains(err.Error(), "unknown fields: sumary, Points") {
+		t.Errorf("resolveFieldIDs() error = %v, want both unknown fields listed", err)
+	}
+
+	fields = append(fields, &api.Field{ID: "customfield_10020", Key: "customfield_10020", Name: "story points", Custom: true})
+	_, err = resolveFieldIDs([]string{"Story Points"}, fields)
+	if err == nil || !strings.Contains(err.Error(), "customfield_10016, customfield_10020") {
+		t.Errorf("resolveFieldIDs() error = %v, want the ambiguous name with both IDs", err)
+	}
+	if got, err := resolveFieldIDs([]string{"customfield_10020"}, fields); err != nil || got[0] != "customfield_10020" {
+		t.Errorf("an ID should still resolve a shared name, got %v, %v", got, err)
+	}
 }
 
 // TestViewOptions tests the ViewOptions struct.
@@ -316,3 +460,55 @@ func contains(s, substr string) bool {
 	}
 	return false
 }
+
+// TestFormatIssueOutputCustomFields tests that custom fields are keyed by
+// their display name and rendered as readable values.
+func TestFormatIssueOutputCustomFields(t *testing.T) {
+	issue := &api.Issue{
+		Key: "TEST-123",
+		Fields: api.IssueFields{
+			Summary: "Test Summary",
+			Extra: map[string]json.RawMessage{
+				"customfield_10016": json.RawMessage(`5`),
+				"customfield_10020": json.RawMessage(`{"id":"10100","value":"High Risk"}`),
+				"customfield_10021": json.RawMessage(`[{"id":1,"name":"Sprint 41","state":"closed"},{"id":2,"name":"Sprint 42","state":"active"}]`),
+				"customfield_10030": json.RawMessage(`null`),
+			},
+		},
+	}
+	fieldNames := map[string]string{
+		"customfield_10016": "Story Points",
+		"customfield_10020": "Risk",
+		"customfield_10021": "Sprint",
+		"customfield_10030": "Empty",
+	}
+
+	out := formatIssueOutput(issue, "https://example.atlassian.net", fieldNames)
+
+	want := map[string]string{
+		"Story Points": "5",
+		"Risk":         "High Risk",
+		"Sprint":       "Sprint 41, Sprint 42",
+	}
+	if len(out.CustomFields) != len(want) {
+		t.Errorf("CustomFields has %d entries, want %d", len(out.CustomFields), len(want))
+	}
+	for name, value := range want {
+		cf, ok := out.CustomFields[name]
+		if !ok {
+			t.Errorf("CustomFields missing %q", name)
+			continue
+		}
+		if cf.Value != value {
+			t.Errorf("CustomFields[%q].Value = %q, want %q", name, cf.Value, value)
+		}
+	}
+
+	outBuf := &bytes.Buffer{}
+	printIssueDetails(&iostreams.IOStreams{Out: outBuf}, out)
+	for _, expected := range []string{"## Custom Fields", "Risk: High Risk", "Story Points: 5"} {
+		if !contains(outBuf.String(), expected) {
+			t.Errorf("Output missing %q\nGot: %s", expected, outBuf.String())
+		}
+	}
+}
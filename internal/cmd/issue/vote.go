@@ -0,0 +1,192 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// VoteOptions holds the options for the vote command.
+type VoteOptions struct {
+	IO       *iostreams.IOStreams
+	IssueKey string
+	Remove   bool
+	Status   bool
+	JSON     bool
+
+	Stdin       bool
+	Concurrency int
+}
+
+// NewCmdVote creates the vote command.
+func NewCmdVote(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &VoteOptions{
+		IO:          ios,
+		Concurrency: 5,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "vote [issue-key]",
+		Short: "Vote for a Jira issue",
+		Long: `Vote for a Jira issue, remove your vote, or show the vote count.
+
+Jira does not allow voting on issues you reported, and voting can be
+disabled for the whole instance.
+
+With --stdin, issue keys are read one per line and a vote is added (or
+removed) on each; a failure on one issue does not stop the rest.`,
+		Example: `  # Vote for an issue
+  atl issue vote PROJ-123
+
+  # Remove your vote
+  atl issue vote PROJ-123 --remove
+
+  # Show vote count and whether you have voted
+  atl issue vote PROJ-123 --status
+
+  # Vote for every issue in a list
+  cat keys.txt | atl issue vote --stdin`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Stdin {
+				if len(args) > 0 {
+					return fmt.Errorf("--stdin cannot be used with an issue key argument")
+				}
+				if opts.Status {
+					return fmt.Errorf("--stdin cannot be used with --status")
+				}
+				if opts.Concurrency < 1 {
+					return fmt.Errorf("--concurrency must be at least 1")
+				}
+				return runVoteStdin(cmd.Context(), opts)
+			}
+			if len(args) == 0 {
+				return fmt.Errorf("an issue key is required (or use --stdin)")
+			}
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
+			if opts.Remove && opts.Status {
+				return fmt.Errorf("--remove and --status cannot be used together")
+			}
+			return runVote(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Remove, "remove", "r", false, "Remove your vote from the issue")
+	cmd.Flags().BoolVarP(&opts.Status, "status", "s", false, "Show the vote count (don't change)")
+	cmd.Flags().BoolVar(&opts.Stdin, "stdin", false, stdinFlagUsage)
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of issues changed in parallel with --stdin")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// VoteOutput represents the output of the vote command.
+type VoteOutput struct {
+	IssueKey string `json:"issue_key"`
+	Votes    int    `json:"votes"`
+	HasVoted bool   `json:"has_voted"`
+	Action   string `json:"action"`
+}
+
+func runVote(ctx context.Context, opts *VoteOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	action := "status"
+	switch {
+	case opts.Remove:
+		action = "removed"
+		if err := jira.RemoveVote(ctx, opts.IssueKey); err != nil {
+			return voteError("remove vote from", opts.IssueKey, err)
+		}
+	case !opts.Status:
+		action = "voted"
+		if err := jira.AddVote(ctx, opts.IssueKey); err != nil {
+			return voteError("vote for", opts.IssueKey, err)
+		}
+	}
+
+	votes, err := jira.GetVotes(ctx, opts.IssueKey)
+	if err != nil {
+		return fmt.Errorf("failed to get votes: %w", err)
+	}
+
+	voteOutput := &VoteOutput{
+		IssueKey: opts.IssueKey,
+		Votes:    votes.Votes,
+		HasVoted: votes.HasVoted,
+		Action:   action,
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, voteOutput)
+	}
+
+	switch action {
+	case "voted":
+		fmt.Fprintf(opts.IO.Out, "Voted for %s (%d votes)\n", opts.IssueKey, votes.Votes)
+	case "removed":
+		fmt.Fprintf(opts.IO.Out, "Removed vote from %s (%d votes)\n", opts.IssueKey, votes.Votes)
+	default:
+		fmt.Fprintf(opts.IO.Out, "%s has %d votes\n", opts.IssueKey, votes.Votes)
+		if votes.HasVoted {
+			fmt.Fprintln(opts.IO.Out, "You have voted for this issue")
+		} else {
+			fmt.Fprintln(opts.IO.Out, "You have not voted for this issue")
+		}
+	}
+
+	return nil
+}
+
+func runVoteStdin(ctx context.Context, opts *VoteOptions) error {
+	keys, err := readIssueKeys(opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	action, done, verb, apply := "voted", "Voted for", "vote for", jira.AddVote
+	if opts.Remove {
+		action, done, verb, apply = "removed", "Removed vote from", "remove vote from", jira.RemoveVote
+	}
+
+	batchOutput := applyToIssues(ctx, keys, opts.Concurrency, action, func(ctx context.Context, key string) error {
+		if err := apply(ctx, key); err != nil {
+			return voteError(verb, key, err)
+		}
+		return nil
+	})
+	return finishIssueBatch(opts.IO, batchOutput, done, opts.JSON)
+}
+
+// voteError explains the common reasons Jira rejects a vote change.
+func voteError(action, issueKey string, err error) error {
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusNotFound) {
+		return fmt.Errorf("cannot %s %s: %w\n\nYou can't vote on issues you reported, and voting may be disabled on this Jira instance", action, issueKey, err)
+	}
+	return fmt.Errorf("failed to %s %s: %w", action, issueKey, err)
+}
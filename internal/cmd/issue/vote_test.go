@@ -0,0 +1,34 @@
+package issue
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestVoteError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantHint bool
+	}{
+		{"own issue", &api.APIError{StatusCode: 404, Messages: []string{"You cannot vote for an issue you have reported."}}, true},
+		{"bad request", &api.APIError{StatusCode: 400}, true},
+		{"server error", &api.APIError{StatusCode: 500}, false},
+		{"network error", errors.New("connection refused"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := voteError("vote for", "PROJ-1", tt.err)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("voteError() should wrap the original error")
+			}
+			if got := strings.Contains(err.Error(), "can't vote on issues you reported"); got != tt.wantHint {
+				t.Errorf("voteError() hint = %v, want %v: %s", got, tt.wantHint, err)
+			}
+		})
+	}
+}
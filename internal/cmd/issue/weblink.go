@@ -52,14 +52,18 @@ pull requests, or related resources.`,
   atl issue weblink PROJ-123 --list --json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.IssueKey = args[0]
+			key, err := api.NormalizeIssueKey(args[0])
+			if err != nil {
+				return err
+			}
+			opts.IssueKey = key
 
 			// Validate flags
 			if opts.List {
-				return runWebLinkList(opts)
+				return runWebLinkList(cmd.Context(), opts)
 			}
 			if opts.Delete > 0 {
-				return runWebLinkDelete(opts)
+				return runWebLinkDelete(cmd.Context(), opts)
 			}
 			if opts.URL == "" {
 				return fmt.Errorf("--url is required to add a web link\n\nUse --list to view existing links or --delete to remove one")
@@ -67,7 +71,7 @@ pull requests, or related resources.`,
 			if opts.Title == "" {
 				return fmt.Errorf("--title is required to add a web link")
 			}
-			return runWebLinkAdd(opts)
+			return runWebLinkAdd(cmd.Context(), opts)
 		},
 	}
 
@@ -105,13 +109,12 @@ type WebLinkAddOutput struct {
 	Action   string `json:"action"`
 }
 
-func runWebLinkList(opts *WebLinkOptions) error {
+func runWebLinkList(ctx context.Context, opts *WebLinkOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	links, err := jira.GetRemoteLinks(ctx, opts.IssueKey)
@@ -138,7 +141,7 @@ func runWebLinkList(opts *WebLinkOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, listOutput)
+		return output.PrintJSON(opts.IO, listOutput)
 	}
 
 	if len(listOutput.Links) == 0 {
@@ -162,13 +165,12 @@ func runWebLinkList(opts *WebLinkOptions) error {
 	return nil
 }
 
-func runWebLinkAdd(opts *WebLinkOptions) error {
+func runWebLinkAdd(ctx context.Context, opts *WebLinkOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	link, err := jira.CreateRemoteLink(ctx, opts.IssueKey, opts.URL, opts.Title, opts.Summary)
@@ -185,7 +187,7 @@ func runWebLinkAdd(opts *WebLinkOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, addOutput)
+		return output.PrintJSON(opts.IO, addOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Added web link to %s\n", opts.IssueKey)
@@ -196,13 +198,12 @@ func runWebLinkAdd(opts *WebLinkOptions) error {
 	return nil
 }
 
-func runWebLinkDelete(opts *WebLinkOptions) error {
+func runWebLinkDelete(ctx context.Context, opts *WebLinkOptions) error {
 	client, err := api.NewClientFromConfig()
 	if err != nil {
 		return err
 	}
 
-	ctx := context.Background()
 	jira := api.NewJiraService(client)
 
 	err = jira.DeleteRemoteLink(ctx, opts.IssueKey, opts.Delete)
@@ -217,7 +218,7 @@ func runWebLinkDelete(opts *WebLinkOptions) error {
 	}
 
 	if opts.JSON {
-		return output.JSON(opts.IO.Out, deleteOutput)
+		return output.PrintJSON(opts.IO, deleteOutput)
 	}
 
 	fmt.Fprintf(opts.IO.Out, "Deleted web link %d from %s\n", opts.Delete, opts.IssueKey)
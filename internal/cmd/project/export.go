@@ -0,0 +1,461 @@
+package project
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	issueCmd "github.com/enthus-appdev/atl-cli/internal/cmd/issue"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// exportFields are the issue fields requested for an export: everything
+// except comments and worklogs, which the search only returns partially.
+// Comments are fetched per issue with --include-comments.
+var exportFields = []string{"*all", "-comment", "-worklog"}
+
+// ExportOptions holds the options for the export command.
+type ExportOptions struct {
+	IO              *iostreams.IOStreams
+	Project         string
+	Output          string
+	IncludeComments bool
+	Since           string
+	Concurrency     int
+	JSON            bool
+}
+
+// NewCmdExport creates the export command.
+func NewCmdExport(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &ExportOptions{
+		IO:          ios,
+		Concurrency: 5,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export <project-key>",
+		Short: "Export all issues of a project",
+		Long: `Export every issue of a project as newline-delimited JSON (NDJSON),
+one issue per line, for backups or offline analysis.
+
+Issues are fetched page by page and written as they arrive, so large
+projects are not held in memory. Each line holds the issue's standard
+fields, its custom fields (raw, keyed by field ID) and the metadata of its
+attachments. With --include-comments, every issue's comments are fetched
+as well, several issues at a time (see --concurrency).
+
+The output goes to stdout unless --output names a file. A file ending in
+.json gets a single JSON array instead of NDJSON.
+
+Use --since to export only issues updated on or after a date, e.g. for
+incremental backups. A summary is printed when the export is done (to
+stderr when the export itself goes to stdout).`,
+		Example: `  # Export a project to a file
+  atl project export PROJ --output proj.ndjson
+
+  # Include comments
+  atl project export PROJ --output proj.ndjson --include-comments
+
+  # Only issues updated in the last week, as a JSON array
+  atl project export PROJ --since -7d --output recent.json
+
+  # Stream to another tool
+  atl project export PROJ | jq -r '.key + " " + .status'`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Project = strings.ToUpper(args[0])
+			if opts.Concurrency < 1 {
+				return fmt.Errorf("--concurrency must be at least 1")
+			}
+			return runExport(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", "File to write the export to (default: stdout)")
+	cmd.Flags().BoolVar(&opts.IncludeComments, "include-comments", false, "Fetch and include each issue's comments")
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Only issues updated on or after this date (YYYY-MM-DD, -7d, ...)")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Number of issues whose comments are fetched in parallel")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output the export summary as JSON")
+
+	return cmd
+}
+
+// ExportOutput summarizes a finished export.
+type ExportOutput struct {
+	Project       string `json:"project"`
+	File          string `json:"file"`
+	Issues        int    `json:"issues"`
+	Comments      int    `json:"comments,omitempty"`
+	CommentErrors int    `json:"comment_errors,omitempty"`
+}
+
+// ExportIssue is a single issue in an export.
+type ExportIssue struct {
+	Key          string                     `json:"key"`
+	ID           string                     `json:"id"`
+	Project      string                     `json:"project"`
+	Summary      string                     `json:"summary"`
+	Description  string                     `json:"description,omitempty"`
+	Type         string                     `json:"type,omitempty"`
+	Status       string                     `json:"status,omitempty"`
+	Priority     string                     `json:"priority,omitempty"`
+	Resolution   string                     `json:"resolution,omitempty"`
+	Assignee     *ExportUser                `json:"assignee,omitempty"`
+	Reporter     *ExportUser                `json:"reporter,omitempty"`
+	Parent       string                     `json:"parent,omitempty"`
+	Labels       []string                   `json:"labels"`
+	Components   []string                   `json:"components"`
+	DueDate      string                     `json:"due_date,omitempty"`
+	Created      string                     `json:"created"`
+	Updated      string                     `json:"updated"`
+	URL          string                     `json:"url"`
+	CustomFields map[string]json.RawMessage `json:"custom_fields,omitempty"`
+	Attachments  []*ExportAttachment        `json:"attachments"`
+
+	// Set with --include-comments. CommentError holds the reason if the
+	// issue's comments could not be fetched.
+	Comments     []*ExportComment `json:"comments,omitempty"`
+	CommentError string           `json:"comment_error,omitempty"`
+}
+
+// ExportUser is a user referenced by an exported issue.
+type ExportUser struct {
+	AccountID   string `json:"account_id"`
+	DisplayName string `json:"display_name"`
+	Email       string `json:"email,omitempty"`
+}
+
+// ExportAttachment is the metadata of an attachment; the file itself is not
+// exported but can be downloaded from URL.
+type ExportAttachment struct {
+	ID       string      `json:"id"`
+	Filename string      `json:"filename"`
+	MimeType string      `json:"mime_type"`
+	Size     int64       `json:"size"`
+	Author   *ExportUser `json:"author,omitempty"`
+	Created  string      `json:"created"`
+	URL      string      `json:"url"`
+}
+
+// ExportComment is a comment of an exported issue.
+type ExportComment struct {
+	ID      string      `json:"id"`
+	Author  *ExportUser `json:"author,omitempty"`
+	Body    string      `json:"body"`
+	Created string      `json:"created"`
+	Updated string      `json:"updated,omitempty"`
+}
+
+func runExport(ctx context.Context, opts *ExportOptions) error {
+	jql := fmt.Sprintf("project = %q", opts.Project)
+	if opts.Since != "" {
+		since, err := issueCmd.ParseRelativeDate(opts.Since)
+		if err != nil {
+			return fmt.Errorf("invalid --since: %w", err)
+		}
+		jql += fmt.Sprintf(" AND updated >= %q", since.Format(time.DateOnly))
+	}
+	jql += " ORDER BY key ASC"
+
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+
+	// A file export is written to a temporary file next to it and renamed
+	// on success, so a failed run never leaves a partial or empty file.
+	out := opts.IO.Out
+	file := "-"
+	var tmp *os.File
+	if !isStdout(opts.Output) {
+		tmp, err = os.CreateTemp(filepath.Dir(opts.Output), "."+filepath.Base(opts.Output)+".*.tmp")
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer func() {
+			if tmp != nil {
+				tmp.Close()
+				os.Remove(tmp.Name())
+			}
+		}()
+		_ = tmp.Chmod(0o644)
+		out = tmp
+		file = opts.Output
+	}
+
+	buf := bufio.NewWriter(out)
+	writer := newExportWriter(buf, strings.EqualFold(filepath.Ext(opts.Output), ".json"))
+
+	exportOutput := &ExportOutput{
+		Project: opts.Project,
+		File:    file,
+	}
+
+	searchOpts := api.SearchOptions{
+		JQL:        jql,
+		MaxResults: 100,
+		Fields:     exportFields,
+	}
+	for {
+		result, err := jira.Search(ctx, searchOpts)
+		if err != nil {
+			return fmt.Errorf("failed to search issues: %w", err)
+		}
+
+		records := make([]*ExportIssue, len(result.Issues))
+		for i, issue := range result.Issues {
+			records[i] = exportIssue(issue, client.BrowseBaseURL())
+		}
+		if opts.IncludeComments {
+			addExportComments(ctx, jira.GetCommentsAll, records, opts.Concurrency)
+		}
+
+		for _, record := range records {
+			if err := writer.write(record); err != nil {
+				return fmt.Errorf("failed to write export: %w", err)
+			}
+			exportOutput.Issues++
+			exportOutput.Comments += len(record.Comments)
+			if record.CommentError != "" {
+				exportOutput.CommentErrors++
+				fmt.Fprintf(opts.IO.ErrOut, "Warning: failed to fetch comments of %s: %s\n", record.Key, record.CommentError)
+			}
+		}
+		// Flush once per page so consumers of stdout see issues as they arrive.
+		if err := buf.Flush(); err != nil {
+			return fmt.Errorf("failed to write export: %w", err)
+		}
+
+		next, more := result.NextPage(searchOpts)
+		if !more {
+			break
+		}
+		searchOpts = next
+	}
+
+	if err := writer.close(); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+	if err := buf.Flush(); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+	if tmp != nil {
+		if err := tmp.Close(); err != nil {
+			return fmt.Errorf("failed to write export: %w", err)
+		}
+		if err := os.Rename(tmp.Name(), opts.Output); err != nil {
+			os.Remove(tmp.Name())
+			return fmt.Errorf("failed to write export: %w", err)
+		}
+		tmp = nil
+	}
+
+	if msg := jira.LastRateLimit().Warning(); msg != "" {
+		fmt.Fprintf(opts.IO.ErrOut, "Warning: %s\n", msg)
+	}
+
+	// The summary goes to stderr when stdout carries the export.
+	summary := opts.IO.Out
+	if file == "-" {
+		summary = opts.IO.ErrOut
+	}
+	if opts.JSON {
+		return output.JSONIndent(summary, exportOutput, opts.IO.JSONIndent())
+	}
+
+	fmt.Fprintf(summary, "Exported %d issues from %s", exportOutput.Issues, opts.Project)
+	if opts.IncludeComments {
+		fmt.Fprintf(summary, " with %d comments", exportOutput.Comments)
+	}
+	if file != "-" {
+		fmt.Fprintf(summary, " to %s", file)
+	}
+	fmt.Fprintln(summary)
+
+	return nil
+}
+
+// isStdout reports whether the --output value means stdout.
+func isStdout(path string) bool {
+	return path == "" || path == "-"
+}
+
+// exportWriter writes exported issues as NDJSON, or as a JSON array whose
+// elements are still written one at a time.
+type exportWriter struct {
+	w     io.Writer
+	array bool
+	count int
+}
+
+func newExportWriter(w io.Writer, array bool) *exportWriter {
+	return &exportWriter{w: w, array: array}
+}
+
+// write writes one issue on its own line.
+func (e *exportWriter) write(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	prefix := ""
+	if e.array {
+		prefix = ",\n"
+		if e.count == 0 {
+			prefix = "[\n"
+		}
+	}
+	e.count++
+
+	_, err = fmt.Fprintf(e.w, "%s%s", prefix, data)
+	if err == nil && !e.array {
+		_, err = io.WriteString(e.w, "\n")
+	}
+	return err
+}
+
+// close terminates the JSON array. It is a no-op for NDJSON.
+func (e *exportWriter) close() error {
+	if !e.array {
+		return nil
+	}
+	end := "\n]\n"
+	if e.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
+
+// exportIssue converts a searched issue to its export record.
+func exportIssue(issue *api.Issue, browseBase string) *ExportIssue {
+	f := issue.Fields
+	record := &ExportIssue{
+		Key:         issue.Key,
+		ID:          issue.ID,
+		Summary:     f.Summary,
+		Assignee:    exportUser(f.Assignee),
+		Reporter:    exportUser(f.Reporter),
+		Labels:      f.Labels,
+		Components:  []string{},
+		DueDate:     f.DueDate,
+		Created:     f.Created,
+		Updated:     f.Updated,
+		URL:         api.IssueBrowseURL(browseBase, issue.Key),
+		Attachments: []*ExportAttachment{},
+	}
+	if record.Labels == nil {
+		record.Labels = []string{}
+	}
+
+	if f.Project != nil {
+		record.Project = f.Project.Key
+	}
+	if f.Description != nil {
+		record.Description = api.ADFToText(f.Description)
+	}
+	if f.IssueType != nil {
+		record.Type = f.IssueType.Name
+	}
+	if f.Status != nil {
+		record.Status = f.Status.Name
+	}
+	if f.Priority != nil {
+		record.Priority = f.Priority.Name
+	}
+	if f.Resolution != nil {
+		record.Resolution = f.Resolution.Name
+	}
+	if f.Parent != nil {
+		record.Parent = f.Parent.Key
+	}
+	for _, c := range f.Components {
+		record.Components = append(record.Components, c.Name)
+	}
+
+	for id, raw := range f.Extra {
+		if string(raw) == "null" {
+			continue
+		}
+		if record.CustomFields == nil {
+			record.CustomFields = make(map[string]json.RawMessage)
+		}
+		record.CustomFields[id] = raw
+	}
+
+	for _, a := range f.Attachment {
+		record.Attachments = append(record.Attachments, &ExportAttachment{
+			ID:       a.ID,
+			Filename: a.Filename,
+			MimeType: a.MimeType,
+			Size:     a.Size,
+			Author:   exportUser(a.Author),
+			Created:  a.Created,
+			URL:      a.Content,
+		})
+	}
+
+	return record
+}
+
+// addExportComments fetches the comments of each record, at most
+// concurrency at a time. A failed fetch is recorded on the issue rather than
+// aborting the export.
+func addExportComments(ctx context.Context, getComments func(context.Context, string) ([]*api.Comment, error), records []*ExportIssue, concurrency int) {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for _, record := range records {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			comments, err := getComments(ctx, record.Key)
+			if err != nil {
+				record.CommentError = err.Error()
+				return
+			}
+			record.Comments = make([]*ExportComment, 0, len(comments))
+			for _, c := range comments {
+				comment := &ExportComment{
+					ID:      c.ID,
+					Author:  exportUser(c.Author),
+					Created: c.Created,
+					Updated: c.Updated,
+				}
+				if c.Body != nil {
+					comment.Body = api.ADFToText(c.Body)
+				}
+				record.Comments = append(record.Comments, comment)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func exportUser(u *api.User) *ExportUser {
+	if u == nil {
+		return nil
+	}
+	return &ExportUser{
+		AccountID:   u.AccountID,
+		DisplayName: u.DisplayName,
+		Email:       u.EmailAddress,
+	}
+}
@@ -0,0 +1,145 @@
+package project
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+const searchedIssue = `{
+	"id": "10042",
+	"key": "PROJ-7",
+	"fields": {
+		"summary": "Export me",
+		"description": {"type": "doc", "version": 1, "content": [{"type": "paragraph", "content": [{"type": "text", "text": "Details"}]}]},
+		"status": {"name": "In Progress"},
+		"issuetype": {"name": "Bug"},
+		"project": {"key": "PROJ", "name": "Project"},
+		"assignee": {"accountId": "abc", "displayName": "Jane Doe"},
+		"labels": ["backend"],
+		"components": [{"id": "1", "name": "API"}],
+		"created": "2026-01-02T10:00:00.000+0000",
+		"updated": "2026-01-03T11:00:00.000+0000",
+		"attachment": [{"id": "900", "filename": "log.txt", "mimeType": "text/plain", "size": 12, "created": "2026-01-02T10:05:00.000+0000", "content": "https://example.atlassian.net/rest/api/3/attachment/content/900"}],
+		"customfield_10016": 5,
+		"customfield_10020": null
+	}
+}`
+
+func TestExportNDJSONLine(t *testing.T) {
+	var issue api.Issue
+	if err := json.Unmarshal([]byte(searchedIssue), &issue); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	w := newExportWriter(&buf, false)
+	if err := w.write(exportIssue(&issue, "https://example.atlassian.net")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected one line, got %d: %q", len(lines), buf.String())
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+		t.Fatalf("line is not JSON: %v", err)
+	}
+
+	want := map[string]any{
+		"key":         "PROJ-7",
+		"id":          "10042",
+		"project":     "PROJ",
+		"summary":     "Export me",
+		"description": "Details",
+		"type":        "Bug",
+		"status":      "In Progress",
+		"created":     "2026-01-02T10:00:00.000+0000",
+		"url":         "https://example.atlassian.net/browse/PROJ-7",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %v, want %v", k, got[k], v)
+		}
+	}
+	if assignee, _ := got["assignee"].(map[string]any); assignee["account_id"] != "abc" {
+		t.Errorf("assignee = %v, want account_id abc", got["assignee"])
+	}
+	if components, _ := got["components"].([]any); len(components) != 1 || components[0] != "API" {
+		t.Errorf("components = %v, want [API]", got["components"])
+	}
+	customFields, _ := got["custom_fields"].(map[string]any)
+	if len(customFields) != 1 || customFields["customfield_10016"] != float64(5) {
+		t.Errorf("custom_fields = %v, want only customfield_10016", got["custom_fields"])
+	}
+	attachments, _ := got["attachments"].([]any)
+	if len(attachments) != 1 {
+		t.Fatalf("attachments = %v, want one", got["attachments"])
+	}
+	attachment := attachments[0].(map[string]any)
+	if attachment["filename"] != "log.txt" || attachment["mime_type"] != "text/plain" || attachment["size"] != float64(12) {
+		t.Errorf("attachment = %v", attachment)
+	}
+	if _, ok := got["comments"]; ok {
+		t.Error("comments should be omitted without --include-comments")
+	}
+}
+
+func TestExportJSONArray(t *testing.T) {
+	var buf bytes.Buffer
+	w := newExportWriter(&buf, true)
+	for _, key := range []string{"PROJ-1", "PROJ-2"} {
+		if err := w.write(&ExportIssue{Key: key}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []*ExportIssue
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not a JSON array: %v\n%s", err, buf.String())
+	}
+	if len(got) != 2 || got[1].Key != "PROJ-2" {
+		t.Errorf("got %+v", got)
+	}
+
+	buf.Reset()
+	empty := newExportWriter(&buf, true)
+	if err := empty.close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "[]\n" {
+		t.Errorf("empty export = %q, want []", buf.String())
+	}
+}
+
+func TestAddExportComments(t *testing.T) {
+	records := []*ExportIssue{{Key: "PROJ-1"}, {Key: "PROJ-2"}}
+	getComments := func(_ context.Context, key string) ([]*api.Comment, error) {
+		if key == "PROJ-2" {
+			return nil, errors.New("forbidden")
+		}
+		return []*api.Comment{{ID: "1", Body: api.TextToADF("Looks good"), Created: "2026-01-02T10:00:00.000+0000"}}, nil
+	}
+
+	addExportComments(context.Background(), getComments, records, 2)
+
+	if len(records[0].Comments) != 1 || records[0].Comments[0].Body != "Looks good" {
+		t.Errorf("PROJ-1 comments = %+v", records[0].Comments)
+	}
+	if records[1].CommentError != "forbidden" || records[1].Comments != nil {
+		t.Errorf("PROJ-2 = %+v, want comment error", records[1])
+	}
+}
@@ -0,0 +1,20 @@
+package project
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+// NewCmdProject creates the project command group.
+func NewCmdProject(ios *iostreams.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project",
+		Short: "Work with Jira projects",
+		Long:  `Commands that operate on a Jira project as a whole.`,
+	}
+
+	cmd.AddCommand(NewCmdExport(ios))
+
+	return cmd
+}
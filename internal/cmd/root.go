@@ -1,16 +1,31 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"syscall"
 
 	"github.com/spf13/cobra"
 
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	apiCmd "github.com/enthus-appdev/atl-cli/internal/cmd/api"
 	authCmd "github.com/enthus-appdev/atl-cli/internal/cmd/auth"
 	boardCmd "github.com/enthus-appdev/atl-cli/internal/cmd/board"
 	configCmd "github.com/enthus-appdev/atl-cli/internal/cmd/config"
 	confluenceCmd "github.com/enthus-appdev/atl-cli/internal/cmd/confluence"
+	doctorCmd "github.com/enthus-appdev/atl-cli/internal/cmd/doctor"
 	issueCmd "github.com/enthus-appdev/atl-cli/internal/cmd/issue"
+	projectCmd "github.com/enthus-appdev/atl-cli/internal/cmd/project"
+	schemaCmd "github.com/enthus-appdev/atl-cli/internal/cmd/schema"
+	searchCmd "github.com/enthus-appdev/atl-cli/internal/cmd/search"
+	utilCmd "github.com/enthus-appdev/atl-cli/internal/cmd/util"
+	"github.com/enthus-appdev/atl-cli/internal/config"
 	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
 )
 
 // BuildInfo contains version and build information.
@@ -20,14 +35,28 @@ type BuildInfo struct {
 	Date    string
 }
 
-// Execute runs the root command and returns an exit code.
+// Execute runs the root command and returns an exit code (see ExitCode).
 func Execute(ios *iostreams.IOStreams, buildInfo BuildInfo) int {
+	// Cancel in-flight requests on Ctrl-C. Once canceled, the default signal
+	// behavior is restored so a second Ctrl-C exits immediately (e.g. while
+	// waiting on a prompt that doesn't watch the context).
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
 	rootCmd := NewRootCmd(ios, buildInfo)
-	if err := rootCmd.Execute(); err != nil {
+	err := rootCmd.ExecuteContext(ctx)
+	ios.StopPager()
+	if closeErr := api.CloseLogFile(); closeErr != nil {
+		fmt.Fprintf(ios.ErrOut, "Warning: %s\n", closeErr)
+	}
+	if err != nil {
 		fmt.Fprintf(ios.ErrOut, "Error: %s\n", err)
-		return 1
 	}
-	return 0
+	return ExitCode(err)
 }
 
 // NewRootCmd creates the root command for the CLI.
@@ -44,7 +73,13 @@ It provides commands for:
 Get started by running 'atl auth login' to authenticate with your Atlassian account.
 
 Environment variables:
-  ATL_DEBUG=1    Enable debug logging (shows API requests/responses)`,
+  ATL_CONFIG=<path>    Use this config file instead of ~/.config/atlassian/config.yaml
+  ATL_DEBUG=1          Enable debug logging (shows API requests/responses)
+  ATL_LOG_FILE=<path>  Write API request/response logs to a file (secrets redacted)
+  ATL_OUTPUT=<format>  Default output format: table, json, or csv (overrides the
+                       default_output_format config key; --json and --output win)
+  ATL_PAGER=<command>  Pager for long terminal output (default: $PAGER, then less -R;
+                       set to an empty string or "cat" to disable)`,
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Version:       buildInfo.Version,
@@ -54,6 +89,47 @@ Environment variables:
 	cmd.SetVersionTemplate(fmt.Sprintf("atl version %s\ncommit: %s\nbuilt: %s\n",
 		buildInfo.Version, buildInfo.Commit, buildInfo.Date))
 
+	var configFile string
+	var logFile string
+	var noColor bool
+	var noPager bool
+	var compactJSON bool
+	var jsonIndent int
+	cmd.PersistentFlags().StringVar(&configFile, "config", "", "Path to the config file (same as ATL_CONFIG)")
+	cmd.PersistentFlags().StringVar(&logFile, "log-file", "", "Write API request/response logs to a file (same as ATL_LOG_FILE)")
+	cmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored output (same as NO_COLOR)")
+	cmd.PersistentFlags().BoolVar(&noPager, "no-pager", false, "Do not pipe long output through a pager")
+	cmd.PersistentFlags().BoolVar(&compactJSON, "compact", false, "Write JSON output on a single line (same as --indent 0)")
+	cmd.PersistentFlags().IntVar(&jsonIndent, "indent", output.DefaultJSONIndent, "Spaces to indent JSON output with, 0-8 (json_indent config key)")
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		// Config and log paths are read from the environment, so the flags
+		// simply override ATL_CONFIG and ATL_LOG_FILE for this process.
+		if configFile != "" {
+			if err := os.Setenv(config.ConfigFileEnv, configFile); err != nil {
+				return err
+			}
+		}
+		if logFile != "" {
+			if err := os.Setenv(api.LogFileEnv, logFile); err != nil {
+				return err
+			}
+		}
+		// Opened once here so every client in the run shares one handle;
+		// Execute closes it.
+		if err := api.OpenLogFile(); err != nil {
+			return err
+		}
+		applyColorConfig(ios, noColor)
+		if err := applyJSONIndent(ios, cmd, compactJSON, jsonIndent); err != nil {
+			return err
+		}
+		applyDefaultOutputFormat(ios, cmd)
+		if !noPager && !jsonRequested(cmd) {
+			startPager(ios)
+		}
+		return nil
+	}
+
 	// Set I/O streams
 	cmd.SetIn(ios.In)
 	cmd.SetOut(ios.Out)
@@ -63,25 +139,185 @@ Environment variables:
 	cmd.AddCommand(authCmd.NewCmdAuth(ios))
 	cmd.AddCommand(issueCmd.NewCmdIssue(ios))
 	cmd.AddCommand(boardCmd.NewCmdBoard(ios))
+	cmd.AddCommand(projectCmd.NewCmdProject(ios))
 	cmd.AddCommand(confluenceCmd.NewCmdConfluence(ios))
+	cmd.AddCommand(searchCmd.NewCmdSearch(ios))
 	cmd.AddCommand(configCmd.NewCmdConfig(ios))
+	cmd.AddCommand(apiCmd.NewCmdAPI(ios))
+	cmd.AddCommand(doctorCmd.NewCmdDoctor(ios))
+	cmd.AddCommand(schemaCmd.NewCmdSchema(ios))
+	cmd.AddCommand(utilCmd.NewCmdUtil(ios))
 	cmd.AddCommand(newVersionCmd(ios, buildInfo))
 	cmd.AddCommand(newCompletionCmd(ios))
 
 	return cmd
 }
 
+// jsonRequested reports whether the command was asked for machine-readable
+// output (--json, or --output json/csv), which is never paged.
+func jsonRequested(cmd *cobra.Command) bool {
+	if f := cmd.Flags().Lookup("json"); f != nil && f.Value.String() == "true" {
+		return true
+	}
+	f := cmd.Flags().Lookup("output")
+	return output.IsFormatFlag(f) && (f.Value.String() == "json" || f.Value.String() == "csv")
+}
+
+// applyColorConfig applies the color config key. --no-color and NO_COLOR
+// always win; "always" enables color even when stdout is not a terminal.
+func applyColorConfig(ios *iostreams.IOStreams, noColor bool) {
+	if noColor || os.Getenv("NO_COLOR") != "" {
+		ios.SetColorEnabled(false)
+		return
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return
+	}
+	switch cfg.Color {
+	case "always":
+		ios.SetColorEnabled(true)
+	case "never":
+		ios.SetColorEnabled(false)
+	}
+}
+
+// applyJSONIndent sets the indentation of JSON output. --compact and
+// --indent win over the json_indent config key; an invalid config value is
+// reported and ignored.
+func applyJSONIndent(ios *iostreams.IOStreams, cmd *cobra.Command, compact bool, indent int) error {
+	indentChanged := cmd.Flags().Changed("indent")
+	switch {
+	case compact && indentChanged && indent != 0:
+		return fmt.Errorf("--compact cannot be used with --indent %d", indent)
+	case compact:
+		ios.SetJSONIndent(0)
+		return nil
+	case indentChanged:
+		if err := output.CheckJSONIndent(indent); err != nil {
+			return fmt.Errorf("invalid --indent %d: must be between 0 and %d", indent, output.MaxJSONIndent)
+		}
+		ios.SetJSONIndent(indent)
+		return nil
+	}
+
+	spaces := output.DefaultJSONIndent
+	if cfg, err := config.Load(); err == nil && cfg.JSONIndent != nil {
+		spaces = *cfg.JSONIndent
+	}
+	if err := output.CheckJSONIndent(spaces); err != nil {
+		fmt.Fprintf(ios.ErrOut, "Warning: ignoring invalid json_indent %d: must be between 0 and %d\n", spaces, output.MaxJSONIndent)
+		spaces = output.DefaultJSONIndent
+	}
+	ios.SetJSONIndent(spaces)
+	return nil
+}
+
+// outputFormatEnv overrides the default_output_format config key.
+const outputFormatEnv = "ATL_OUTPUT"
+
+// applyDefaultOutputFormat applies ATL_OUTPUT, or else the
+// default_output_format config key, to a command that did not get --json or
+// --output on the command line. json sets --json (or --output json); csv
+// only applies to commands with an --output format flag and is otherwise
+// table. The default is applied whether or not stdout is a terminal, but
+// never to commands whose --json also skips a confirmation prompt.
+func applyDefaultOutputFormat(ios *iostreams.IOStreams, cmd *cobra.Command) {
+	if output.Confirms(cmd) {
+		return
+	}
+
+	format, ok := os.LookupEnv(outputFormatEnv)
+	source := outputFormatEnv
+	if !ok {
+		cfg, err := config.Load()
+		if err != nil {
+			return
+		}
+		format, source = cfg.DefaultOutputFormat, "default_output_format"
+	}
+	format = strings.ToLower(strings.TrimSpace(format))
+	if !config.IsOutputFormat(format) {
+		fmt.Fprintf(ios.ErrOut, "Warning: ignoring invalid %s %q: must be table, json, or csv\n", source, format)
+		return
+	}
+	if format == "" || format == "table" || format == "text" {
+		return
+	}
+
+	jsonFlag := cmd.Flags().Lookup("json")
+	formatFlag := cmd.Flags().Lookup("output")
+	if !output.IsFormatFlag(formatFlag) {
+		formatFlag = nil // e.g. an output directory
+	}
+	if (jsonFlag != nil && jsonFlag.Changed) || (formatFlag != nil && formatFlag.Changed) {
+		return
+	}
+
+	switch {
+	case formatFlag != nil:
+		_ = formatFlag.Value.Set(format)
+	case format == "json" && jsonFlag != nil:
+		_ = jsonFlag.Value.Set("true")
+	}
+}
+
+// startPager pipes output through the pager. ATL_PAGER takes precedence over
+// the pager config key, which takes precedence over PAGER. A pager that fails
+// to start is reported but never fails the command.
+func startPager(ios *iostreams.IOStreams) {
+	if _, ok := os.LookupEnv("ATL_PAGER"); !ok {
+		if cfg, err := config.Load(); err == nil && cfg.Pager != "" {
+			ios.SetPager(cfg.Pager)
+		}
+	}
+	if err := ios.StartPager(); err != nil {
+		fmt.Fprintf(ios.ErrOut, "Warning: %v\n", err)
+	}
+}
+
+// VersionOutput represents the output for the version command.
+type VersionOutput struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	Date      string `json:"date"`
+	GoVersion string `json:"go_version"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+}
+
 // newVersionCmd creates the version command.
 func newVersionCmd(ios *iostreams.IOStreams, buildInfo BuildInfo) *cobra.Command {
-	return &cobra.Command{
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Print version information",
-		Run: func(cmd *cobra.Command, args []string) {
+		Example: `  atl version
+
+  # Machine-readable, e.g. to assert the installed version in CI
+  atl version --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if jsonOutput {
+				return output.PrintJSON(ios, &VersionOutput{
+					Version:   buildInfo.Version,
+					Commit:    buildInfo.Commit,
+					Date:      buildInfo.Date,
+					GoVersion: runtime.Version(),
+					OS:        runtime.GOOS,
+					Arch:      runtime.GOARCH,
+				})
+			}
 			fmt.Fprintf(ios.Out, "atl version %s\n", buildInfo.Version)
 			fmt.Fprintf(ios.Out, "commit: %s\n", buildInfo.Commit)
 			fmt.Fprintf(ios.Out, "built: %s\n", buildInfo.Date)
+			return nil
 		},
 	}
+
+	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "Output as JSON")
+
+	return cmd
 }
 
 // newCompletionCmd creates the completion command for shell autocompletion.
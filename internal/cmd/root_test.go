@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/config"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+func TestVersionJSON(t *testing.T) {
+	var out bytes.Buffer
+	ios := iostreams.Test()
+	ios.Out = &out
+
+	cmd := NewRootCmd(ios, BuildInfo{Version: "1.2.3", Commit: "abc123", Date: "2026-01-02"})
+	cmd.SetArgs([]string{"version", "--json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("version --json: %v", err)
+	}
+
+	var got VersionOutput
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", out.String(), err)
+	}
+	want := VersionOutput{
+		Version:   "1.2.3",
+		Commit:    "abc123",
+		Date:      "2026-01-02",
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+	}
+	if got != want {
+		t.Errorf("version --json = %+v, want %+v", got, want)
+	}
+}
+
+func TestDefaultOutputFormatPrecedence(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configFile, []byte("version: 1\ndefault_output_format: json\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(config.ConfigFileEnv, configFile)
+
+	tests := []struct {
+		name     string
+		env      string // ATL_OUTPUT; unset if empty
+		args     []string
+		wantJSON bool
+	}{
+		{name: "config default", args: []string{"version"}, wantJSON: true},
+		{name: "env overrides config", env: "table", args: []string{"version"}, wantJSON: false},
+		{name: "flag overrides config", args: []string{"version", "--json=false"}, wantJSON: false},
+		{name: "flag overrides env", env: "table", args: []string{"version", "--json"}, wantJSON: true},
+		{name: "csv without an output flag is table", env: "csv", args: []string{"version"}, wantJSON: false},
+		{name: "invalid env is ignored", env: "yaml", args: []string{"version"}, wantJSON: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.env != "" {
+				t.Setenv(outputFormatEnv, tt.env)
+			}
+
+			var out bytes.Buffer
+			ios := iostreams.Test()
+			ios.Out = &out
+			// A terminal must not turn an explicit JSON default back into text.
+			ios.IsStdoutTTY = true
+
+			cmd := NewRootCmd(ios, BuildInfo{Version: "1.2.3"})
+			cmd.SetArgs(tt.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Execute(%v) error = %v", tt.args, err)
+			}
+
+			if gotJSON := json.Valid(out.Bytes()); gotJSON != tt.wantJSON {
+				t.Errorf("Execute(%v) output = %q, want JSON: %v", tt.args, out.String(), tt.wantJSON)
+			}
+		})
+	}
+}
+
+// TestDefaultOutputFormatAnnotations tests that the default output format is
+// not applied to commands whose --json skips a prompt, and only applies to
+// --output flags marked as format flags.
+func TestDefaultOutputFormatAnnotations(t *testing.T) {
+	t.Setenv(outputFormatEnv, "json")
+	ios := iostreams.Test()
+
+	newCmd := func(outputUsage string, mark bool) *cobra.Command {
+		cmd := &cobra.Command{Use: "test"}
+		cmd.Flags().Bool("json", false, "Output as JSON")
+		cmd.Flags().String("output", "", outputUsage)
+		if mark {
+			output.MarkFormatFlag(cmd.Flags(), "output")
+		}
+		return cmd
+	}
+
+	confirming := newCmd("Output format", false)
+	output.MarkConfirms(confirming)
+	applyDefaultOutputFormat(ios, confirming)
+	if got := confirming.Flags().Lookup("json").Value.String(); got != "false" {
+		t.Errorf("confirming command --json = %s, want false", got)
+	}
+
+	dir := newCmd("Output format directory", false)
+	applyDefaultOutputFormat(ios, dir)
+	if dir.Flags().Lookup("output").Value.String() != "" || dir.Flags().Lookup("json").Value.String() != "true" {
+		t.Error("an unmarked --output flag should be left alone and --json set")
+	}
+
+	format := newCmd("Format", true)
+	applyDefaultOutputFormat(ios, format)
+	if got := format.Flags().Lookup("output").Value.String(); got != "json" {
+		t.Errorf("marked --output = %q, want json", got)
+	}
+}
+
+func TestJSONIndentFlags(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configFile, []byte("version: 1\njson_indent: 4\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(config.ConfigFileEnv, configFile)
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantPrefix string
+		wantErr    bool
+	}{
+		{name: "config indent", args: []string{"version", "--json"}, wantPrefix: "{\n    \"version\""},
+		{name: "indent flag overrides config", args: []string{"version", "--json", "--indent", "1"}, wantPrefix: "{\n \"version\""},
+		{name: "compact", args: []string{"version", "--json", "--compact"}, wantPrefix: "{\"version\":\"1.2.3\","},
+		{name: "compact with indent 0", args: []string{"version", "--json", "--compact", "--indent", "0"}, wantPrefix: "{\"version\""},
+		{name: "compact with indent", args: []string{"version", "--json", "--compact", "--indent", "2"}, wantErr: true},
+		{name: "indent out of range", args: []string{"version", "--json", "--indent", "9"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			ios := iostreams.Test()
+			ios.Out = &out
+
+			cmd := NewRootCmd(ios, BuildInfo{Version: "1.2.3"})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute(%v) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+			if !tt.wantErr && !strings.HasPrefix(out.String(), tt.wantPrefix) {
+				t.Errorf("Execute(%v) output = %q, want prefix %q", tt.args, out.String(), tt.wantPrefix)
+			}
+		})
+	}
+
+	// Commands with their own JSON output, like util md2adf, follow --compact.
+	var adf bytes.Buffer
+	adfIOS := iostreams.Test()
+	adfIOS.In = strings.NewReader("**bold**")
+	adfIOS.Out = &adf
+	adfCmd := NewRootCmd(adfIOS, BuildInfo{Version: "1.2.3"})
+	adfCmd.SetArgs([]string{"util", "md2adf", "--compact"})
+	if err := adfCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(adf.String(), "\n") != 1 {
+		t.Errorf("md2adf --compact output = %q, want a single line", adf.String())
+	}
+
+	// Later commands in the same process get the default back.
+	t.Setenv(config.ConfigFileEnv, filepath.Join(t.TempDir(), "missing.yaml"))
+	var out bytes.Buffer
+	ios := iostreams.Test()
+	ios.Out = &out
+	cmd := NewRootCmd(ios, BuildInfo{Version: "1.2.3"})
+	cmd.SetArgs([]string{"version", "--json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(out.String(), "{\n  \"version\"") {
+		t.Errorf("default output = %q, want 2-space indentation", out.String())
+	}
+}
@@ -0,0 +1,242 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	issueCmd "github.com/enthus-appdev/atl-cli/internal/cmd/issue"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// outputTypes maps a command group's path to the --json output types of its
+// subcommands, keyed by subcommand name.
+var outputTypes = map[string]map[string]any{
+	"atl issue": issueCmd.OutputTypes,
+}
+
+// NewCmdSchema creates the schema command.
+func NewCmdSchema(ios *iostreams.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema [<command>...]",
+		Short: "Describe a command's flags and JSON output",
+		Long: `Print a JSON description of a command for tools that generate atl
+invocations: its usage, each flag with its type, default, whether it is
+required and whether it can be repeated, and the shape of its --json output
+as a JSON Schema.
+
+Output shapes are available for the issue commands; other commands list
+their flags only. Without arguments, every command is described.`,
+		Example: `  # Flags and output of issue create
+  atl schema issue create
+
+  # Required flags only
+  atl schema issue create | jq '.flags[] | select(.required) | .name'
+
+  # Every command
+  atl schema`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root := cmd.Root()
+			if len(args) == 0 {
+				var schemas []*CommandSchema
+				walkCommands(root, func(c *cobra.Command) {
+					schemas = append(schemas, describeCommand(c))
+				})
+				return output.PrintJSON(ios, schemas)
+			}
+
+			target, rest, err := root.Find(args)
+			if err != nil || len(rest) > 0 || target == root {
+				return fmt.Errorf("unknown command %q\n\nRun 'atl --help' to list commands", strings.Join(args, " "))
+			}
+			return output.PrintJSON(ios, describeCommand(target))
+		},
+	}
+
+	return cmd
+}
+
+// CommandSchema describes a command's inputs and output.
+type CommandSchema struct {
+	Command     string        `json:"command"`
+	Usage       string        `json:"usage"`
+	Description string        `json:"description"`
+	Flags       []*FlagSchema `json:"flags"`
+	Output      *TypeSchema   `json:"output,omitempty"` // Shape of the --json output, when known
+}
+
+// FlagSchema describes a single flag.
+type FlagSchema struct {
+	Name        string `json:"name"`
+	Shorthand   string `json:"shorthand,omitempty"`
+	Type        string `json:"type"` // pflag type: string, bool, int, duration, stringSlice, stringArray, ...
+	Default     string `json:"default,omitempty"`
+	Required    bool   `json:"required"`
+	Repeatable  bool   `json:"repeatable"`
+	Description string `json:"description"`
+}
+
+// TypeSchema is the subset of JSON Schema used to describe output shapes.
+// An empty schema accepts any value.
+type TypeSchema struct {
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Properties           map[string]*TypeSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *TypeSchema            `json:"items,omitempty"`
+	AdditionalProperties *TypeSchema            `json:"additionalProperties,omitempty"`
+}
+
+// walkCommands calls fn for every runnable, visible command below root.
+func walkCommands(root *cobra.Command, fn func(*cobra.Command)) {
+	for _, c := range root.Commands() {
+		if c.Hidden || c.Name() == "help" {
+			continue
+		}
+		if c.Runnable() {
+			fn(c)
+		}
+		walkCommands(c, fn)
+	}
+}
+
+// describeCommand builds the schema of a command from its flag set and, if
+// registered, its output type.
+func describeCommand(cmd *cobra.Command) *CommandSchema {
+	s := &CommandSchema{
+		Command:     cmd.CommandPath(),
+		Usage:       cmd.UseLine(),
+		Description: cmd.Short,
+		Flags:       []*FlagSchema{},
+	}
+
+	cmd.NonInheritedFlags().VisitAll(func(f *pflag.Flag) {
+		if f.Hidden || f.Name == "help" {
+			return
+		}
+		s.Flags = append(s.Flags, describeFlag(f))
+	})
+
+	if cmd.HasParent() {
+		if v, ok := outputTypes[cmd.Parent().CommandPath()][cmd.Name()]; ok {
+			s.Output = typeSchema(reflect.TypeOf(v), map[reflect.Type]bool{})
+		}
+	}
+
+	return s
+}
+
+// describeFlag describes a flag. Required flags are the ones whose usage
+// ends in "(required)", the convention used throughout atl; they are
+// checked by the commands themselves rather than by cobra.
+func describeFlag(f *pflag.Flag) *FlagSchema {
+	typ := f.Value.Type()
+	def := f.DefValue
+	if def == "[]" || (typ == "bool" && def == "false") {
+		def = ""
+	}
+	return &FlagSchema{
+		Name:        f.Name,
+		Shorthand:   f.Shorthand,
+		Type:        typ,
+		Default:     def,
+		Required:    strings.Contains(f.Usage, "(required)"),
+		Repeatable:  strings.HasSuffix(typ, "Slice") || strings.HasSuffix(typ, "Array"),
+		Description: f.Usage,
+	}
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// typeSchema describes t as it is encoded by encoding/json. seen guards
+// against recursive types (e.g. issue trees), which are described as plain
+// objects at the point they recur.
+func typeSchema(t reflect.Type, seen map[reflect.Type]bool) *TypeSchema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == rawMessageType:
+		return &TypeSchema{}
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return &TypeSchema{Type: "string", Format: "byte"} // Base64, as encoding/json writes []byte
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &TypeSchema{Type: "string"}
+	case reflect.Bool:
+		return &TypeSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &TypeSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &TypeSchema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &TypeSchema{Type: "array", Items: typeSchema(t.Elem(), seen)}
+	case reflect.Map:
+		return &TypeSchema{Type: "object", AdditionalProperties: typeSchema(t.Elem(), seen)}
+	case reflect.Struct:
+		if t == timeType {
+			return &TypeSchema{Type: "string", Format: "date-time"}
+		}
+		if seen[t] {
+			return &TypeSchema{Type: "object"}
+		}
+		seen[t] = true
+		defer delete(seen, t)
+
+		s := &TypeSchema{Type: "object", Properties: map[string]*TypeSchema{}}
+		addStructFields(s, t, seen)
+		return s
+	default:
+		// interface{} and anything else encoding/json accepts as-is.
+		return &TypeSchema{}
+	}
+}
+
+// addStructFields adds the exported, JSON-encoded fields of t to s,
+// flattening embedded structs as encoding/json does. Fields without
+// omitempty are always present and listed as required.
+func addStructFields(s *TypeSchema, t reflect.Type, seen map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				addStructFields(s, embedded, seen)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		s.Properties[name] = typeSchema(field.Type, seen)
+		if !strings.Contains(opts, "omitempty") {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
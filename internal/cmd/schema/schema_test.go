@@ -0,0 +1,114 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	issueCmd "github.com/enthus-appdev/atl-cli/internal/cmd/issue"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func runSchema(t *testing.T, args ...string) []byte {
+	t.Helper()
+	var out bytes.Buffer
+	ios := iostreams.Test()
+	ios.Out = &out
+
+	root := &cobra.Command{Use: "atl", SilenceUsage: true, SilenceErrors: true}
+	root.AddCommand(issueCmd.NewCmdIssue(ios))
+	root.AddCommand(NewCmdSchema(ios))
+	root.SetArgs(append([]string{"schema"}, args...))
+	if err := root.Execute(); err != nil {
+		t.Fatalf("schema %v: %v", args, err)
+	}
+	return out.Bytes()
+}
+
+func TestSchemaIssueCreate(t *testing.T) {
+	var got CommandSchema
+	if err := json.Unmarshal(runSchema(t, "issue", "create"), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	if got.Command != "atl issue create" {
+		t.Errorf("command = %q, want %q", got.Command, "atl issue create")
+	}
+
+	flags := make(map[string]*FlagSchema)
+	for _, f := range got.Flags {
+		flags[f.Name] = f
+	}
+	for _, name := range []string{"project", "summary"} {
+		f := flags[name]
+		if f == nil {
+			t.Fatalf("flag --%s missing", name)
+		}
+		if !f.Required || f.Type != "string" {
+			t.Errorf("--%s = %+v, want a required string flag", name, f)
+		}
+	}
+	if f := flags["description"]; f == nil || f.Required {
+		t.Errorf("--description = %+v, want an optional flag", f)
+	}
+	if f := flags["label"]; f == nil || !f.Repeatable || f.Shorthand != "l" {
+		t.Errorf("--label = %+v, want a repeatable flag with shorthand l", f)
+	}
+
+	if got.Output == nil || got.Output.Type != "object" {
+		t.Fatalf("output = %+v, want an object schema", got.Output)
+	}
+	if key := got.Output.Properties["key"]; key == nil || key.Type != "string" {
+		t.Errorf("output.key = %+v, want string", key)
+	}
+}
+
+func TestSchemaUnknownCommand(t *testing.T) {
+	ios := iostreams.Test()
+	root := &cobra.Command{Use: "atl", SilenceUsage: true, SilenceErrors: true}
+	root.AddCommand(NewCmdSchema(ios))
+	root.SetArgs([]string{"schema", "nope"})
+	if err := root.Execute(); err == nil {
+		t.Error("schema nope succeeded, want error")
+	}
+}
+
+func TestTypeSchema(t *testing.T) {
+	type node struct {
+		Name     string            `json:"name"`
+		Count    int               `json:"count,omitempty"`
+		Tags     []string          `json:"tags"`
+		Extra    map[string]any    `json:"extra,omitempty"`
+		Raw      json.RawMessage   `json:"raw,omitempty"`
+		Children []*node           `json:"children,omitempty"`
+		Skipped  string            `json:"-"`
+		Meta     map[string]string `json:"meta,omitempty"`
+	}
+
+	got := typeSchema(reflect.TypeOf(&node{}), map[reflect.Type]bool{})
+
+	if got.Type != "object" || !reflect.DeepEqual(got.Required, []string{"name", "tags"}) {
+		t.Errorf("schema = %+v, want object requiring name and tags", got)
+	}
+	if _, ok := got.Properties["Skipped"]; ok {
+		t.Error(`field tagged "-" was included`)
+	}
+	if p := got.Properties["count"]; p.Type != "integer" {
+		t.Errorf("count = %+v, want integer", p)
+	}
+	if p := got.Properties["tags"]; p.Type != "array" || p.Items.Type != "string" {
+		t.Errorf("tags = %+v, want array of string", p)
+	}
+	if p := got.Properties["raw"]; p.Type != "" {
+		t.Errorf("raw = %+v, want any", p)
+	}
+	if p := got.Properties["meta"]; p.Type != "object" || p.AdditionalProperties.Type != "string" {
+		t.Errorf("meta = %+v, want map of string", p)
+	}
+	if p := got.Properties["children"]; p.Items.Type != "object" || p.Items.Properties != nil {
+		t.Errorf("children = %+v, want array of plain objects for the recursive type", p)
+	}
+}
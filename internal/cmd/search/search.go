@@ -0,0 +1,228 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+const (
+	sourceJira       = "jira"
+	sourceConfluence = "confluence"
+)
+
+// SearchOptions holds the options for the search command.
+type SearchOptions struct {
+	IO    *iostreams.IOStreams
+	Query string
+	Limit int
+	JSON  bool
+}
+
+// NewCmdSearch creates the search command.
+func NewCmdSearch(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &SearchOptions{
+		IO:    ios,
+		Limit: 10,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search Jira issues and Confluence content at once",
+		Long: `Run a full-text search against Jira and Confluence in parallel.
+
+Jira is searched with JQL (text ~ "<query>") and Confluence with CQL
+(text ~ "<query>"). Results are listed per source; --limit applies to each
+source separately.
+
+If one product fails (for example, missing permissions), its error is
+reported and the results from the other are still shown. The command only
+fails when both searches fail.`,
+		Example: `  # Find anything about payment bugs
+  atl search "payment bug"
+
+  # Up to 25 results from each product
+  atl search "onboarding" --limit 25
+
+  # Output as JSON (each result has a "source" field)
+  atl search "release notes" --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Query = args[0]
+			if opts.Query == "" {
+				return fmt.Errorf("search query cannot be empty")
+			}
+			if opts.Limit < 1 {
+				return fmt.Errorf("--limit must be at least 1")
+			}
+			return runSearch(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 10, "Maximum number of results per source")
+	cmd.Flags().BoolVarP(&opts.JSON, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// SearchResultOutput represents a single result from either product.
+type SearchResultOutput struct {
+	Source    string `json:"source"`              // "jira" or "confluence"
+	ID        string `json:"id"`                  // Issue key or content ID
+	Title     string `json:"title"`               // Issue summary or page title
+	Type      string `json:"type,omitempty"`      // Issue type or content type
+	Status    string `json:"status,omitempty"`    // Issue status or content status
+	Container string `json:"container,omitempty"` // Project key or space key
+}
+
+// SearchErrorOutput records a source that failed.
+type SearchErrorOutput struct {
+	Source string `json:"source"`
+	Error  string `json:"error"`
+}
+
+// SearchOutput represents the combined output of the search command.
+type SearchOutput struct {
+	Query   string                `json:"query"`
+	Results []*SearchResultOutput `json:"results"`
+	Errors  []*SearchErrorOutput  `json:"errors,omitempty"`
+}
+
+func runSearch(ctx context.Context, opts *SearchOptions) error {
+	client, err := api.NewClientFromConfig()
+	if err != nil {
+		return err
+	}
+
+	jira := api.NewJiraService(client)
+	confluence := api.NewConfluenceService(client)
+
+	var (
+		wg         sync.WaitGroup
+		issues     *api.SearchResult
+		issuesErr  error
+		content    *api.ConfluenceSearchResponse
+		contentErr error
+	)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		issues, issuesErr = jira.Search(ctx, api.SearchOptions{
+			JQL:        jiraQuery(opts.Query),
+			MaxResults: opts.Limit,
+			Fields:     []string{"summary", "status", "issuetype", "project"},
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		content, contentErr = confluence.SearchWithCQL(ctx, confluenceQuery(opts.Query), opts.Limit, "")
+	}()
+	wg.Wait()
+
+	searchOutput := buildOutput(opts.Query, issues, issuesErr, content, contentErr)
+
+	if issuesErr != nil && contentErr != nil {
+		return fmt.Errorf("search failed in both products:\n  jira: %v\n  confluence: %v", issuesErr, contentErr)
+	}
+
+	if opts.JSON {
+		return output.PrintJSON(opts.IO, searchOutput)
+	}
+
+	for _, e := range searchOutput.Errors {
+		fmt.Fprintf(opts.IO.ErrOut, "Warning: %s search failed: %s\n", e.Source, e.Error)
+	}
+
+	if len(searchOutput.Results) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No results found matching '%s'\n", opts.Query)
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.Out, "Found %d results:\n\n", len(searchOutput.Results))
+
+	headers := []string{"SOURCE", "ID", "TYPE", "STATUS", "PROJECT/SPACE", "TITLE"}
+	rows := make([][]string, 0, len(searchOutput.Results))
+
+	for _, r := range searchOutput.Results {
+		title := r.Title
+		if len(title) > 60 {
+			title = title[:57] + "..."
+		}
+		rows = append(rows, []string{
+			r.Source,
+			r.ID,
+			r.Type,
+			r.Status,
+			r.Container,
+			title,
+		})
+	}
+
+	output.SimpleTable(opts.IO.Out, headers, rows)
+	return nil
+}
+
+// jiraQuery builds the JQL full-text query for the search term.
+func jiraQuery(query string) string {
+	return fmt.Sprintf("text ~ %q ORDER BY updated DESC", query)
+}
+
+// confluenceQuery builds the CQL full-text query for the search term.
+func confluenceQuery(query string) string {
+	return fmt.Sprintf("text ~ %q", query)
+}
+
+// buildOutput merges the per-product results into a single list, Jira
+// first, and records any source that failed.
+func buildOutput(query string, issues *api.SearchResult, issuesErr error, content *api.ConfluenceSearchResponse, contentErr error) *SearchOutput {
+	out := &SearchOutput{
+		Query:   query,
+		Results: []*SearchResultOutput{},
+	}
+
+	if issuesErr != nil {
+		out.Errors = append(out.Errors, &SearchErrorOutput{Source: sourceJira, Error: issuesErr.Error()})
+	} else if issues != nil {
+		for _, issue := range issues.Issues {
+			r := &SearchResultOutput{
+				Source: sourceJira,
+				ID:     issue.Key,
+				Title:  issue.Fields.Summary,
+			}
+			if issue.Fields.IssueType != nil {
+				r.Type = issue.Fields.IssueType.Name
+			}
+			if issue.Fields.Status != nil {
+				r.Status = issue.Fields.Status.Name
+			}
+			if issue.Fields.Project != nil {
+				r.Container = issue.Fields.Project.Key
+			}
+			out.Results = append(out.Results, r)
+		}
+	}
+
+	if contentErr != nil {
+		out.Errors = append(out.Errors, &SearchErrorOutput{Source: sourceConfluence, Error: contentErr.Error()})
+	} else if content != nil {
+		for _, c := range content.Results {
+			out.Results = append(out.Results, &SearchResultOutput{
+				Source:    sourceConfluence,
+				ID:        c.ID,
+				Title:     c.Title,
+				Type:      c.Type,
+				Status:    c.Status,
+				Container: c.SpaceKey,
+			})
+		}
+	}
+
+	return out
+}
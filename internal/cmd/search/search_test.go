@@ -0,0 +1,54 @@
+package search
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+)
+
+func TestQueries(t *testing.T) {
+	if got, want := jiraQuery(`say "hi"`), `text ~ "say \"hi\"" ORDER BY updated DESC`; got != want {
+		t.Errorf("jiraQuery() = %q, want %q", got, want)
+	}
+	if got, want := confluenceQuery("payment bug"), `text ~ "payment bug"`; got != want {
+		t.Errorf("confluenceQuery() = %q, want %q", got, want)
+	}
+}
+
+func TestBuildOutput(t *testing.T) {
+	issues := &api.SearchResult{Issues: []*api.Issue{{
+		Key: "PROJ-1",
+		Fields: api.IssueFields{
+			Summary: "Payment fails",
+			Status:  &api.Status{Name: "Open"},
+			Project: &api.Project{Key: "PROJ"},
+		},
+	}}}
+	content := &api.ConfluenceSearchResponse{Results: []*api.ConfluenceSearchResult{
+		{ID: "123", Title: "Payments", Type: "page", SpaceKey: "DOCS"},
+	}}
+
+	t.Run("both succeed", func(t *testing.T) {
+		out := buildOutput("payment", issues, nil, content, nil)
+		if len(out.Results) != 2 || len(out.Errors) != 0 {
+			t.Fatalf("got %d results, %d errors; want 2, 0", len(out.Results), len(out.Errors))
+		}
+		if r := out.Results[0]; r.Source != sourceJira || r.ID != "PROJ-1" || r.Container != "PROJ" {
+			t.Errorf("first result = %+v", r)
+		}
+		if r := out.Results[1]; r.Source != sourceConfluence || r.ID != "123" || r.Container != "DOCS" {
+			t.Errorf("second result = %+v", r)
+		}
+	})
+
+	t.Run("one source fails", func(t *testing.T) {
+		out := buildOutput("payment", nil, errors.New("forbidden"), content, nil)
+		if len(out.Results) != 1 || out.Results[0].Source != sourceConfluence {
+			t.Errorf("results = %+v, want only the confluence result", out.Results)
+		}
+		if len(out.Errors) != 1 || out.Errors[0].Source != sourceJira || out.Errors[0].Error != "forbidden" {
+			t.Errorf("errors = %+v, want jira: forbidden", out.Errors)
+		}
+	})
+}
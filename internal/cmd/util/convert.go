@@ -0,0 +1,147 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+	"github.com/enthus-appdev/atl-cli/internal/output"
+)
+
+// Md2adfOptions holds the options for the md2adf command.
+type Md2adfOptions struct {
+	IO     *iostreams.IOStreams
+	File   string
+	Format string
+}
+
+// NewCmdMd2adf creates the md2adf command.
+func NewCmdMd2adf(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &Md2adfOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "md2adf",
+		Short: "Convert Markdown to ADF",
+		Long: `Convert Markdown to Atlassian Document Format (ADF) JSON, exactly as atl
+does for descriptions and comments.
+
+Reads stdin unless --file is given. With --format wiki, the input is read
+as Jira wiki markup instead.`,
+		Example: `  # Preview how a description will be sent
+  echo "**Bold** and a [link](https://example.com)" | atl util md2adf
+
+  # Convert a file
+  atl util md2adf --file notes.md
+
+  # Wiki markup, compact output for another tool
+  atl util md2adf --format wiki --compact < notes.txt`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := api.CheckBodyFormat(opts.Format); err != nil {
+				return err
+			}
+			return runMd2adf(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "Read the input from a file instead of stdin")
+	cmd.Flags().StringVar(&opts.Format, "format", api.FormatMarkdown, "Input format: markdown or wiki (Jira wiki markup)")
+
+	return cmd
+}
+
+func runMd2adf(opts *Md2adfOptions) error {
+	input, err := readInput(opts.IO, opts.File)
+	if err != nil {
+		return err
+	}
+
+	doc, err := api.BodyToADF(string(input), opts.Format)
+	if err != nil {
+		return err
+	}
+
+	return output.PrintJSON(opts.IO, doc)
+}
+
+// Adf2mdOptions holds the options for the adf2md command.
+type Adf2mdOptions struct {
+	IO   *iostreams.IOStreams
+	File string
+}
+
+// NewCmdAdf2md creates the adf2md command.
+func NewCmdAdf2md(ios *iostreams.IOStreams) *cobra.Command {
+	opts := &Adf2mdOptions{
+		IO: ios,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "adf2md",
+		Short: "Convert ADF to Markdown",
+		Long: `Convert an Atlassian Document Format (ADF) JSON document to Markdown, as
+atl shows descriptions and comments.
+
+Reads stdin unless --file is given. The input must be an ADF document
+(type "doc").`,
+		Example: `  # Show an issue's raw description as Markdown
+  atl api GET /rest/api/3/issue/PROJ-123 | jq .fields.description | atl util adf2md
+
+  # Round trip
+  echo "# Title" | atl util md2adf | atl util adf2md`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAdf2md(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "Read the input from a file instead of stdin")
+
+	return cmd
+}
+
+func runAdf2md(opts *Adf2mdOptions) error {
+	input, err := readInput(opts.IO, opts.File)
+	if err != nil {
+		return err
+	}
+
+	var doc api.ADF
+	if err := json.Unmarshal(input, &doc); err != nil {
+		return fmt.Errorf("invalid ADF: %w", err)
+	}
+	if doc.Type != "doc" {
+		return fmt.Errorf("invalid ADF: root node is %q, want \"doc\"", doc.Type)
+	}
+
+	text := api.ADFToText(&doc)
+	if text == "" {
+		return nil
+	}
+	_, err = fmt.Fprintln(opts.IO.Out, text)
+	return err
+}
+
+// readInput reads a file, or stdin when no file (or "-") is given.
+func readInput(ios *iostreams.IOStreams, file string) ([]byte, error) {
+	if file == "" || file == "-" {
+		data, err := io.ReadAll(ios.In)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+		return data, nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return data, nil
+}
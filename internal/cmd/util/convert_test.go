@@ -0,0 +1,61 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/api"
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+func TestMd2adfFromStdin(t *testing.T) {
+	ios := iostreams.Test()
+	ios.In = strings.NewReader("# Title\n\nSome **bold** text\n\n- one\n- two\n")
+	var out bytes.Buffer
+	ios.Out = &out
+
+	cmd := NewCmdMd2adf(ios)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("md2adf error = %v", err)
+	}
+
+	var doc api.ADF
+	if err := json.Unmarshal(out.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out.String())
+	}
+	if doc.Type != "doc" {
+		t.Errorf("type = %q, want doc", doc.Type)
+	}
+	if err := api.ValidateADF(&doc); err != nil {
+		t.Errorf("ValidateADF() error = %v", err)
+	}
+	if len(doc.Content) != 3 || doc.Content[0].Type != "heading" || doc.Content[2].Type != "bulletList" {
+		t.Errorf("content = %+v, want heading, paragraph, bulletList", doc.Content)
+	}
+}
+
+func TestAdf2mdRoundTrip(t *testing.T) {
+	ios := iostreams.Test()
+	ios.In = strings.NewReader(`{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"bold","marks":[{"type":"strong"}]}]}]}`)
+	var out bytes.Buffer
+	ios.Out = &out
+
+	if err := runAdf2md(&Adf2mdOptions{IO: ios}); err != nil {
+		t.Fatalf("adf2md error = %v", err)
+	}
+	if got := strings.TrimSpace(out.String()); got != "**bold**" {
+		t.Errorf("adf2md = %q, want **bold**", got)
+	}
+}
+
+func TestAdf2mdRejectsNonDoc(t *testing.T) {
+	ios := iostreams.Test()
+	ios.In = strings.NewReader(`{"type":"paragraph"}`)
+
+	if err := runAdf2md(&Adf2mdOptions{IO: ios}); err == nil {
+		t.Error("adf2md should reject a root node that is not a doc")
+	}
+}
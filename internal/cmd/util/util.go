@@ -0,0 +1,21 @@
+package util
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
+)
+
+// NewCmdUtil creates the util command group.
+func NewCmdUtil(ios *iostreams.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "util",
+		Short: "Offline helpers",
+		Long:  `Helpers that work without an Atlassian connection, such as converting between Markdown and ADF.`,
+	}
+
+	cmd.AddCommand(NewCmdMd2adf(ios))
+	cmd.AddCommand(NewCmdAdf2md(ios))
+
+	return cmd
+}
@@ -1,8 +1,9 @@
 // Package config provides configuration management for the Atlassian CLI.
 //
 // Configuration is stored in YAML format at ~/.config/atlassian/config.yaml
-// (following XDG Base Directory Specification). The location can be overridden
-// using the ATLASSIAN_CONFIG_DIR environment variable.
+// (following XDG Base Directory Specification). The directory can be overridden
+// using the ATLASSIAN_CONFIG_DIR environment variable, and the file itself with
+// ATL_CONFIG (or the --config flag, which sets it).
 //
 // The configuration includes:
 //   - OAuth credentials for authentication
@@ -13,8 +14,10 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -31,15 +34,30 @@ type Config struct {
 	DefaultOutputFormat string                 `yaml:"default_output_format,omitempty"`
 	Editor              string                 `yaml:"editor,omitempty"`
 	Pager               string                 `yaml:"pager,omitempty"`
+	Color               string                 `yaml:"color,omitempty"`
+	JSONIndent          *int                   `yaml:"json_indent,omitempty"` // Spaces --json output indents with; 0 is compact, unset is 2
 	OAuth               *OAuthConfig           `yaml:"oauth,omitempty"`
+	Create              *CreateConfig          `yaml:"create,omitempty"`
 }
 
 // OAuthConfig holds OAuth 2.0 application credentials.
 // These are obtained by creating an OAuth app at https://developer.atlassian.com/console/myapps/
 // and are used to authenticate users via the OAuth 2.0 authorization code flow.
 type OAuthConfig struct {
-	ClientID     string `yaml:"client_id"`     // OAuth app client ID
-	ClientSecret string `yaml:"client_secret"` // OAuth app client secret
+	ClientID     string `yaml:"client_id"`               // OAuth app client ID
+	ClientSecret string `yaml:"client_secret"`           // OAuth app client secret
+	CallbackPort int    `yaml:"callback_port,omitempty"` // Port of the login callback server; 0 means the default
+}
+
+// CreateConfig holds defaults for 'atl issue create'.
+type CreateConfig struct {
+	AssignSelf bool `yaml:"assign_self,omitempty"` // Assign new issues to yourself when no assignee is given
+}
+
+// AssignSelfOnCreate reports whether new issues are assigned to the current
+// user when no assignee is given.
+func (c *Config) AssignSelfOnCreate() bool {
+	return c.Create != nil && c.Create.AssignSelf
 }
 
 // HostConfig represents configuration for a specific Atlassian cloud instance.
@@ -51,8 +69,13 @@ type HostConfig struct {
 	Protocol       string `yaml:"protocol,omitempty"`        // Protocol to use (defaults to "https")
 	OAuthAppID     string `yaml:"oauth_app_id,omitempty"`    // OAuth app ID used for this host
 	DefaultProject string `yaml:"default_project,omitempty"` // Default Jira project key for commands
+	BrowseBaseURL  string `yaml:"browse_base_url,omitempty"` // Base of user-facing issue URLs; defaults to https://<hostname>
 }
 
+// ConfigFileEnv is the environment variable that overrides the config file
+// path, e.g. for a separate profile or an ephemeral config in CI.
+const ConfigFileEnv = "ATL_CONFIG"
+
 var (
 	configDir  string
 	configOnce sync.Once
@@ -81,8 +104,12 @@ func ConfigDir() string {
 	return configDir
 }
 
-// ConfigFile returns the path to the main configuration file.
+// ConfigFile returns the path to the main configuration file: ATL_CONFIG if
+// set, otherwise config.yaml in ConfigDir.
 func ConfigFile() string {
+	if path := os.Getenv(ConfigFileEnv); path != "" {
+		return path
+	}
 	return filepath.Join(ConfigDir(), "config.yaml")
 }
 
@@ -111,7 +138,7 @@ func Load() (*Config, error) {
 
 // Save writes the configuration to disk.
 func (c *Config) Save() error {
-	dir := ConfigDir()
+	dir := filepath.Dir(ConfigFile())
 	if err := os.MkdirAll(dir, 0o700); err != nil {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
@@ -214,6 +241,94 @@ func (c *Config) AliasForHost(hostname string) string {
 	return ""
 }
 
+// IsOutputFormat reports whether format is a valid default_output_format
+// value. "text" is the older name for table; empty means table.
+func IsOutputFormat(format string) bool {
+	switch format {
+	case "", "table", "text", "json", "csv":
+		return true
+	}
+	return false
+}
+
+// parseCallbackPort parses an OAuth callback port, which must be a valid
+// TCP port number.
+func parseCallbackPort(value string) (int, error) {
+	port, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid callback port %q: must be a number between 1 and 65535", value)
+	}
+	return port, nil
+}
+
+// parseJSONIndent parses a JSON indentation, a number of spaces from 0
+// (compact) to 8.
+func parseJSONIndent(value string) (int, error) {
+	spaces, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || spaces < 0 || spaces > 8 {
+		return 0, fmt.Errorf("invalid json_indent %q: must be a number between 0 (compact) and 8", value)
+	}
+	return spaces, nil
+}
+
+// parseBrowseBaseURL parses a browse base URL, an http(s) URL such as
+// https://jira.example.com or https://example.com/jira, and returns it
+// without a trailing slash.
+func parseBrowseBaseURL(value string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("invalid browse_base_url %q: must be an http(s) URL such as https://jira.example.com", value)
+	}
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// Setting describes a key that can be read with Get and written with Set.
+type Setting struct {
+	Key         string
+	Description string
+	Secret      bool // masked when displayed
+}
+
+// Settings lists the keys accepted by Get and Set, in display order.
+var Settings = []Setting{
+	{Key: "current_host", Description: "The current active Atlassian host"},
+	{Key: "default_project", Description: "Default Jira project key for the current host"},
+	{Key: "browse_base_url", Description: "Base of issue URLs shown for the current host (default https://<hostname>)"},
+	{Key: "default_output_format", Description: "Default output format: table, json, or csv (ATL_OUTPUT overrides)"},
+	{Key: "editor", Description: "Editor to use for editing content"},
+	{Key: "pager", Description: "Pager to use for long output"},
+	{Key: "color", Description: "Colored output: auto, always, or never"},
+	{Key: "json_indent", Description: "Spaces JSON output is indented with; 0 for compact (default 2)"},
+	{Key: "create.assign_self", Description: "Assign issues created without --assignee (issue create, --from-file rows, issue subtask) to yourself: true or false (default false)"},
+	{Key: "oauth.client_id", Description: "OAuth app client ID"},
+	{Key: "oauth.client_secret", Description: "OAuth app client secret", Secret: true},
+	{Key: "oauth.callback_port", Description: "Port of the login callback URL (default 8085)"},
+}
+
+// LookupSetting returns the setting for key, or an error listing the valid
+// keys if there is none.
+func LookupSetting(key string) (Setting, error) {
+	keys := make([]string, 0, len(Settings))
+	for _, s := range Settings {
+		if s.Key == key {
+			return s, nil
+		}
+		keys = append(keys, s.Key)
+	}
+	return Setting{}, fmt.Errorf("unknown configuration key: %s\n\nValid keys: %s", key, strings.Join(keys, ", "))
+}
+
+// MaskSecret hides all but the last four characters of a secret value.
+func MaskSecret(value string) string {
+	if value == "" {
+		return ""
+	}
+	if len(value) <= 8 {
+		return strings.Repeat("*", len(value))
+	}
+	return strings.Repeat("*", len(value)-4) + value[len(value)-4:]
+}
+
 // Get returns a configuration value by key.
 func (c *Config) Get(key string) string {
 	switch key {
@@ -225,6 +340,43 @@ func (c *Config) Get(key string) string {
 		return c.Editor
 	case "pager":
 		return c.Pager
+	case "color":
+		return c.Color
+	case "json_indent":
+		if c.JSONIndent != nil {
+			return strconv.Itoa(*c.JSONIndent)
+		}
+		return ""
+	case "default_project":
+		if host := c.CurrentHostConfig(); host != nil {
+			return host.DefaultProject
+		}
+		return ""
+	case "browse_base_url":
+		if host := c.CurrentHostConfig(); host != nil {
+			return host.BrowseBaseURL
+		}
+		return ""
+	case "create.assign_self":
+		if c.AssignSelfOnCreate() {
+			return "true"
+		}
+		return ""
+	case "oauth.client_id":
+		if c.OAuth != nil {
+			return c.OAuth.ClientID
+		}
+		return ""
+	case "oauth.client_secret":
+		if c.OAuth != nil {
+			return c.OAuth.ClientSecret
+		}
+		return ""
+	case "oauth.callback_port":
+		if c.OAuth != nil && c.OAuth.CallbackPort != 0 {
+			return strconv.Itoa(c.OAuth.CallbackPort)
+		}
+		return ""
 	default:
 		return ""
 	}
@@ -236,13 +388,88 @@ func (c *Config) Set(key, value string) error {
 	case "current_host":
 		c.CurrentHost = c.ResolveHost(value)
 	case "default_output_format":
+		if !IsOutputFormat(value) {
+			return fmt.Errorf("invalid default_output_format %q: must be table, json, or csv", value)
+		}
 		c.DefaultOutputFormat = value
 	case "editor":
 		c.Editor = value
 	case "pager":
 		c.Pager = value
+	case "color":
+		if value != "" && value != "auto" && value != "always" && value != "never" {
+			return fmt.Errorf("invalid color %q: must be auto, always, or never", value)
+		}
+		c.Color = value
+	case "json_indent":
+		if value == "" {
+			c.JSONIndent = nil
+			return nil
+		}
+		spaces, err := parseJSONIndent(value)
+		if err != nil {
+			return err
+		}
+		c.JSONIndent = &spaces
+	case "default_project":
+		host := c.CurrentHostConfig()
+		if host == nil {
+			return fmt.Errorf("default_project is set per host and no current host is configured\n\nRun 'atl auth login' or 'atl config use-context' first")
+		}
+		host.DefaultProject = strings.ToUpper(value)
+	case "browse_base_url":
+		host := c.CurrentHostConfig()
+		if host == nil {
+			return fmt.Errorf("browse_base_url is set per host and no current host is configured\n\nRun 'atl auth login' or 'atl config use-context' first")
+		}
+		if value == "" {
+			host.BrowseBaseURL = ""
+			return nil
+		}
+		base, err := parseBrowseBaseURL(value)
+		if err != nil {
+			return err
+		}
+		host.BrowseBaseURL = base
+	case "create.assign_self":
+		assignSelf := false
+		if value != "" {
+			var err error
+			assignSelf, err = strconv.ParseBool(strings.TrimSpace(value))
+			if err != nil {
+				return fmt.Errorf("invalid create.assign_self %q: must be true or false", value)
+			}
+		}
+		if c.Create == nil {
+			c.Create = &CreateConfig{}
+		}
+		c.Create.AssignSelf = assignSelf
+	case "oauth.client_id":
+		if c.OAuth == nil {
+			c.OAuth = &OAuthConfig{}
+		}
+		c.OAuth.ClientID = value
+	case "oauth.client_secret":
+		if c.OAuth == nil {
+			c.OAuth = &OAuthConfig{}
+		}
+		c.OAuth.ClientSecret = value
+	case "oauth.callback_port":
+		port := 0
+		if value != "" {
+			var err error
+			port, err = parseCallbackPort(value)
+			if err != nil {
+				return err
+			}
+		}
+		if c.OAuth == nil {
+			c.OAuth = &OAuthConfig{}
+		}
+		c.OAuth.CallbackPort = port
 	default:
-		return fmt.Errorf("unknown configuration key: %s", key)
+		_, err := LookupSetting(key)
+		return err
 	}
 	return nil
 }
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -61,6 +62,8 @@ func TestConfigGetSet(t *testing.T) {
 		{"default_output_format", "json"},
 		{"editor", "vim"},
 		{"pager", "less"},
+		{"oauth.callback_port", "9000"},
+		{"json_indent", "0"},
 	}
 
 	for _, tt := range tests {
@@ -80,6 +83,111 @@ func TestConfigGetSet(t *testing.T) {
 	}
 }
 
+// TestConfigSetCallbackPort tests that invalid callback ports are rejected
+// and an empty value restores the default.
+func TestConfigSetCallbackPort(t *testing.T) {
+	cfg := &Config{}
+
+	for _, value := range []string{"0", "65536", "http"} {
+		if err := cfg.Set("oauth.callback_port", value); err == nil {
+			t.Errorf("Set(oauth.callback_port, %q) should return an error", value)
+		}
+	}
+
+	if err := cfg.Set("oauth.callback_port", "9000"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Set("oauth.callback_port", ""); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.OAuth.CallbackPort != 0 {
+		t.Errorf("CallbackPort = %d, want 0 after unsetting", cfg.OAuth.CallbackPort)
+	}
+}
+
+// TestConfigSetJSONIndent tests that out of range indents are rejected and
+// an empty value restores the default.
+func TestConfigSetJSONIndent(t *testing.T) {
+	cfg := &Config{}
+
+	for _, value := range []string{"-1", "9", "tab"} {
+		if err := cfg.Set("json_indent", value); err == nil {
+			t.Errorf("Set(json_indent, %q) should return an error", value)
+		}
+	}
+
+	if err := cfg.Set("json_indent", "4"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.JSONIndent == nil || *cfg.JSONIndent != 4 {
+		t.Errorf("JSONIndent = %v, want 4", cfg.JSONIndent)
+	}
+	if err := cfg.Set("json_indent", ""); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.JSONIndent != nil {
+		t.Errorf("JSONIndent = %d, want unset", *cfg.JSONIndent)
+	}
+}
+
+// TestConfigSetBrowseBaseURL tests that the browse base URL is set on the
+// current host, validated, and stored without a trailing slash.
+func TestConfigSetBrowseBaseURL(t *testing.T) {
+	cfg := &Config{CurrentHost: "example.atlassian.net"}
+	cfg.SetHost("example.atlassian.net", &HostConfig{Hostname: "example.atlassian.net"})
+
+	for _, value := range []string{"jira.example.com", "ftp://jira.example.com", "https://", "https://jira.example.com/?a=b"} {
+		if err := cfg.Set("browse_base_url", value); err == nil {
+			t.Errorf("Set(browse_base_url, %q) should return an error", value)
+		}
+	}
+
+	if err := cfg.Set("browse_base_url", "https://example.com/jira/"); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.CurrentHostConfig().BrowseBaseURL; got != "https://example.com/jira" {
+		t.Errorf("BrowseBaseURL = %q, want https://example.com/jira", got)
+	}
+	if got := cfg.Get("browse_base_url"); got != "https://example.com/jira" {
+		t.Errorf("Get(browse_base_url) = %q", got)
+	}
+	if err := cfg.Set("browse_base_url", ""); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Get("browse_base_url"); got != "" {
+		t.Errorf("Get(browse_base_url) = %q, want unset", got)
+	}
+
+	if err := (&Config{}).Set("browse_base_url", "https://jira.example.com"); err == nil {
+		t.Error("Set(browse_base_url) without a current host should return an error")
+	}
+}
+
+// TestConfigSetAssignSelf tests that create.assign_self takes booleans and
+// is off by default.
+func TestConfigSetAssignSelf(t *testing.T) {
+	cfg := &Config{}
+	if cfg.AssignSelfOnCreate() {
+		t.Error("AssignSelfOnCreate() should be false by default")
+	}
+
+	if err := cfg.Set("create.assign_self", "yes"); err == nil {
+		t.Error("Set(create.assign_self, yes) should return an error")
+	}
+	if err := cfg.Set("create.assign_self", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.AssignSelfOnCreate() || cfg.Get("create.assign_self") != "true" {
+		t.Errorf("create.assign_self = %q, want true", cfg.Get("create.assign_self"))
+	}
+	if err := cfg.Set("create.assign_self", ""); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.AssignSelfOnCreate() {
+		t.Error("AssignSelfOnCreate() should be false after unsetting")
+	}
+}
+
 // TestConfigSetUnknownKey tests that Set returns an error for unknown keys.
 func TestConfigSetUnknownKey(t *testing.T) {
 	cfg := &Config{}
@@ -267,6 +375,45 @@ func TestSaveAndLoad(t *testing.T) {
 	}
 }
 
+// TestConfigFileOverride tests that ATL_CONFIG points Load and Save at
+// another file.
+func TestConfigFileOverride(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles", "ci.yaml")
+	t.Setenv(ConfigFileEnv, path)
+
+	if got := ConfigFile(); got != path {
+		t.Fatalf("ConfigFile() = %q, want %q", got, path)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() of missing override file error = %v", err)
+	}
+	if cfg.CurrentHost != "" {
+		t.Errorf("Load() of missing file CurrentHost = %q, want empty", cfg.CurrentHost)
+	}
+
+	cfg.CurrentHost = "ci.atlassian.net"
+	cfg.SetHost("ci.atlassian.net", &HostConfig{Hostname: "ci.atlassian.net", CloudID: "cloud-ci"})
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("Save() did not write the override file: %v", err)
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.CurrentHost != "ci.atlassian.net" {
+		t.Errorf("CurrentHost = %q, want %q", loaded.CurrentHost, "ci.atlassian.net")
+	}
+	if host := loaded.GetHost("ci.atlassian.net"); host == nil || host.CloudID != "cloud-ci" {
+		t.Errorf("GetHost() = %+v, want cloud-ci", host)
+	}
+}
+
 // TestNormalizeHostname tests stripping protocol prefixes and trailing slashes.
 func TestNormalizeHostname(t *testing.T) {
 	tests := []struct {
@@ -453,3 +600,90 @@ func TestOAuthConfig(t *testing.T) {
 		t.Errorf("ClientSecret = %q, want %q", oauth.ClientSecret, "test-client-secret")
 	}
 }
+
+// TestSetDefaultRoundTrip tests that values written with Set survive Save
+// and Load.
+func TestSetDefaultRoundTrip(t *testing.T) {
+	t.Setenv(ConfigFileEnv, filepath.Join(t.TempDir(), "config.yaml"))
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	cfg.SetHost("example.atlassian.net", &HostConfig{Hostname: "example.atlassian.net"})
+	for key, value := range map[string]string{
+		"current_host":          "example.atlassian.net",
+		"default_project":       "proj",
+		"default_output_format": "json",
+		"color":                 "never",
+		"oauth.client_secret":   "s3cr3t-value",
+	} {
+		if err := cfg.Set(key, value); err != nil {
+			t.Fatalf("Set(%q) error = %v", key, err)
+		}
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	for key, want := range map[string]string{
+		"default_project":       "PROJ",
+		"default_output_format": "json",
+		"color":                 "never",
+		"oauth.client_secret":   "s3cr3t-value",
+	} {
+		if got := loaded.Get(key); got != want {
+			t.Errorf("Get(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+// TestSetValidation tests that Set rejects invalid values and keys.
+func TestSetValidation(t *testing.T) {
+	cfg := &Config{}
+
+	for _, tc := range []struct{ key, value string }{
+		{"color", "sometimes"},
+		{"default_output_format", "yaml"},
+		{"default_project", "PROJ"}, // no current host
+		{"oauth.token", "x"},
+	} {
+		if err := cfg.Set(tc.key, tc.value); err == nil {
+			t.Errorf("Set(%q, %q) should return error", tc.key, tc.value)
+		}
+	}
+}
+
+// TestLookupSetting tests key validation and the secret flag.
+func TestLookupSetting(t *testing.T) {
+	s, err := LookupSetting("oauth.client_secret")
+	if err != nil {
+		t.Fatalf("LookupSetting() error = %v", err)
+	}
+	if !s.Secret {
+		t.Error("oauth.client_secret should be marked secret")
+	}
+
+	_, err = LookupSetting("colour")
+	if err == nil || !strings.Contains(err.Error(), "Valid keys: current_host") {
+		t.Errorf("LookupSetting(colour) error = %v, want list of valid keys", err)
+	}
+}
+
+// TestMaskSecret tests masking of secret values.
+func TestMaskSecret(t *testing.T) {
+	tests := map[string]string{
+		"":                     "",
+		"short":                "*****",
+		"ATOA-client-secret-9": "****************et-9",
+	}
+	for in, want := range tests {
+		if got := MaskSecret(in); got != want {
+			t.Errorf("MaskSecret(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 //   - Easy testing by substituting real streams with buffers
 //   - Terminal detection for interactive features
 //   - Color output management respecting NO_COLOR environment variable
+//   - Paging long output through $PAGER when writing to a terminal
 //
 // Usage in commands:
 //
@@ -21,9 +22,15 @@
 package iostreams
 
 import (
+	"encoding/json"
+	"fmt"
 	"io"
 	"os"
+	"os/exec"
+	"strconv"
+	"strings"
 
+	"github.com/charmbracelet/x/term"
 	"github.com/mattn/go-isatty"
 )
 
@@ -46,8 +53,31 @@ type IOStreams struct {
 
 	// colorEnabled indicates if colored output should be used
 	colorEnabled bool
+
+	// stdout is the terminal file used to measure the width, if any
+	stdout *os.File
+	// terminalWidth overrides the measured width when non-zero
+	terminalWidth int
+
+	// jsonIndent is the indentation of JSON output once jsonIndentSet is true
+	jsonIndent    int
+	jsonIndentSet bool
+
+	// pagerCommand is the command output is piped through (see StartPager)
+	pagerCommand string
+	// pagerProcess is the running pager, if any
+	pagerProcess *exec.Cmd
+	// origOut is the Out writer the pager replaced
+	origOut io.Writer
 }
 
+// DefaultJSONIndent is the number of spaces JSON output indents with unless
+// SetJSONIndent changes it.
+const DefaultJSONIndent = 2
+
+// DefaultPager is used when neither ATL_PAGER nor PAGER is set.
+const DefaultPager = "less -R"
+
 // System returns IOStreams connected to the system's standard streams.
 func System() *IOStreams {
 	stdoutIsTTY := isTerminal(os.Stdout)
@@ -60,11 +90,20 @@ func System() *IOStreams {
 		IsStdinTTY:  isTerminal(os.Stdin),
 		IsStdoutTTY: stdoutIsTTY,
 		IsStderrTTY: stderrIsTTY,
+		stdout:      os.Stdout,
 	}
 
 	// Enable color by default if stdout is a TTY and NO_COLOR is not set
 	ios.colorEnabled = stdoutIsTTY && os.Getenv("NO_COLOR") == ""
 
+	ios.pagerCommand = DefaultPager
+	if pager := os.Getenv("PAGER"); pager != "" {
+		ios.pagerCommand = pager
+	}
+	if pager, ok := os.LookupEnv("ATL_PAGER"); ok {
+		ios.pagerCommand = pager
+	}
+
 	return ios
 }
 
@@ -91,6 +130,160 @@ func (ios *IOStreams) SetColorEnabled(enabled bool) {
 	ios.colorEnabled = enabled
 }
 
+// TerminalWidth returns the width of the terminal stdout is attached to, or 0
+// when stdout is not a terminal or its width is unknown. COLUMNS overrides
+// the measured width.
+func (ios *IOStreams) TerminalWidth() int {
+	if !ios.IsStdoutTTY {
+		return 0
+	}
+	if ios.terminalWidth > 0 {
+		return ios.terminalWidth
+	}
+	if cols, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && cols > 0 {
+		return cols
+	}
+	if ios.stdout != nil {
+		if width, _, err := term.GetSize(ios.stdout.Fd()); err == nil && width > 0 {
+			return width
+		}
+	}
+	return 0
+}
+
+// SetTerminalWidth fixes the width reported by TerminalWidth.
+func (ios *IOStreams) SetTerminalWidth(width int) {
+	ios.terminalWidth = width
+}
+
+// JSONIndent returns the number of spaces JSON output indents with; 0 means
+// compact, single-line JSON.
+func (ios *IOStreams) JSONIndent() int {
+	if !ios.jsonIndentSet {
+		return DefaultJSONIndent
+	}
+	return ios.jsonIndent
+}
+
+// SetJSONIndent sets the number of spaces JSON output indents with. It is
+// set once at startup from --indent, --compact, or the json_indent config key.
+func (ios *IOStreams) SetJSONIndent(spaces int) {
+	ios.jsonIndent = spaces
+	ios.jsonIndentSet = true
+}
+
+// PagerCommand returns the command output is paged through.
+func (ios *IOStreams) PagerCommand() string {
+	return ios.pagerCommand
+}
+
+// SetPager sets the command output is paged through. An empty string or
+// "cat" disables paging.
+func (ios *IOStreams) SetPager(cmd string) {
+	ios.pagerCommand = cmd
+}
+
+// StartPager pipes Out through the pager until StopPager is called.
+//
+// It does nothing when stdout is not a terminal or no pager is configured.
+// Like git, LESS defaults to FRX so less exits immediately when the output
+// fits on one screen.
+func (ios *IOStreams) StartPager() error {
+	if !ios.IsStdoutTTY || ios.pagerProcess != nil {
+		return nil
+	}
+	args := strings.Fields(ios.pagerCommand)
+	if len(args) == 0 || args[0] == "cat" {
+		return nil
+	}
+
+	env := os.Environ()
+	if _, ok := os.LookupEnv("LESS"); !ok {
+		env = append(env, "LESS=FRX")
+	}
+	if _, ok := os.LookupEnv("LV"); !ok {
+		env = append(env, "LV=-c")
+	}
+
+	pager := exec.Command(args[0], args[1:]...)
+	pager.Env = env
+	pager.Stdout = ios.Out
+	pager.Stderr = ios.ErrOut
+
+	pipe, err := pager.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("failed to start pager: %w", err)
+	}
+	if err := pager.Start(); err != nil {
+		return fmt.Errorf("failed to start pager %q: %w", args[0], err)
+	}
+
+	ios.pagerProcess = pager
+	ios.origOut = ios.Out
+	ios.Out = pipe
+	return nil
+}
+
+// StopPager closes the pager's input and waits for the user to quit it.
+// Commands that prompt on stdin must call this first so the prompt is not
+// held back by the pager. It is safe to call when no pager is running.
+func (ios *IOStreams) StopPager() {
+	if ios.pagerProcess == nil {
+		return
+	}
+
+	if c, ok := ios.Out.(io.Closer); ok {
+		_ = c.Close()
+	}
+	_ = ios.pagerProcess.Wait()
+
+	ios.Out = ios.origOut
+	ios.origOut = nil
+	ios.pagerProcess = nil
+}
+
+// ReadArg returns the contents named by a flag value: the literal value,
+// @file to read a file, or @- to read stdin.
+func (ios *IOStreams) ReadArg(arg string) ([]byte, error) {
+	if !strings.HasPrefix(arg, "@") {
+		return []byte(arg), nil
+	}
+
+	name := strings.TrimPrefix(arg, "@")
+	if name == "-" {
+		b, err := io.ReadAll(ios.In)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+		return b, nil
+	}
+
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return b, nil
+}
+
+// ReadJSONAssignment splits a --set name=value and reads the value as JSON
+// with ReadArg, so it may be inline JSON, @file, or @- for stdin.
+func (ios *IOStreams) ReadJSONAssignment(assignment string) (string, json.RawMessage, error) {
+	key, arg, ok := strings.Cut(assignment, "=")
+	if !ok || key == "" || arg == "" {
+		return "", nil, fmt.Errorf("invalid --set value: %q (expected name=JSON, name=@file, or name=@-)", assignment)
+	}
+
+	value, err := ios.ReadArg(arg)
+	if err != nil {
+		return "", nil, err
+	}
+	if !json.Valid(value) {
+		return "", nil, fmt.Errorf("value for property %s is not valid JSON", key)
+	}
+
+	return key, json.RawMessage(value), nil
+}
+
 // isTerminal checks if a file is a terminal.
 func isTerminal(f *os.File) bool {
 	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
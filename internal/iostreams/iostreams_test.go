@@ -3,6 +3,8 @@ package iostreams
 import (
 	"bytes"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -168,3 +170,138 @@ func TestIOStreamsForTesting(t *testing.T) {
 		t.Error("Expected 'Warning:' in error buffer")
 	}
 }
+
+func TestReadArg(t *testing.T) {
+	ios := Test()
+	ios.In = strings.NewReader(`{"from":"stdin"}`)
+
+	got, err := ios.ReadArg(`{"inline":true}`)
+	if err != nil || string(got) != `{"inline":true}` {
+		t.Errorf("ReadArg(inline) = %q, %v", got, err)
+	}
+
+	got, err = ios.ReadArg("@-")
+	if err != nil || string(got) != `{"from":"stdin"}` {
+		t.Errorf("ReadArg(@-) = %q, %v", got, err)
+	}
+
+	if _, err := ios.ReadArg("@/nonexistent/body.json"); err == nil {
+		t.Error("ReadArg() should fail for a missing file")
+	}
+}
+
+func TestStartPagerSkippedWhenNotTTY(t *testing.T) {
+	var outBuf bytes.Buffer
+	ios := Test()
+	ios.Out = &outBuf
+	ios.SetPager("/nonexistent/pager")
+
+	if err := ios.StartPager(); err != nil {
+		t.Fatalf("StartPager() error = %v, want nil when stdout is not a TTY", err)
+	}
+	if ios.Out != &outBuf {
+		t.Error("StartPager() should leave Out unchanged when stdout is not a TTY")
+	}
+	ios.StopPager()
+}
+
+func TestStartPagerDisabled(t *testing.T) {
+	for _, pager := range []string{"", "cat"} {
+		var outBuf bytes.Buffer
+		ios := Test()
+		ios.Out = &outBuf
+		ios.IsStdoutTTY = true
+		ios.SetPager(pager)
+
+		if err := ios.StartPager(); err != nil {
+			t.Fatalf("StartPager() with pager %q error = %v", pager, err)
+		}
+		if ios.Out != &outBuf {
+			t.Errorf("StartPager() with pager %q should leave Out unchanged", pager)
+		}
+	}
+}
+
+func TestStartPagerPipesOutput(t *testing.T) {
+	var outBuf bytes.Buffer
+	ios := Test()
+	ios.Out = &outBuf
+	ios.IsStdoutTTY = true
+	ios.SetPager("sh -c cat")
+
+	if err := ios.StartPager(); err != nil {
+		t.Skipf("sh not available: %v", err)
+	}
+	if ios.Out == &outBuf {
+		t.Fatal("StartPager() should replace Out with the pager's input")
+	}
+	io.WriteString(ios.Out, "paged\n")
+	ios.StopPager()
+
+	if ios.Out != &outBuf {
+		t.Error("StopPager() should restore Out")
+	}
+	if outBuf.String() != "paged\n" {
+		t.Errorf("pager output = %q, want %q", outBuf.String(), "paged\n")
+	}
+}
+
+func TestTerminalWidth(t *testing.T) {
+	ios := Test()
+	ios.SetTerminalWidth(120)
+	if got := ios.TerminalWidth(); got != 0 {
+		t.Errorf("TerminalWidth() = %d, want 0 when stdout is not a TTY", got)
+	}
+
+	ios.IsStdoutTTY = true
+	if got := ios.TerminalWidth(); got != 120 {
+		t.Errorf("TerminalWidth() = %d, want 120", got)
+	}
+
+	ios.SetTerminalWidth(0)
+	t.Setenv("COLUMNS", "95")
+	if got := ios.TerminalWidth(); got != 95 {
+		t.Errorf("TerminalWidth() = %d, want 95 from COLUMNS", got)
+	}
+}
+
+func TestReadJSONAssignment(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "value.json")
+	if err := os.WriteFile(file, []byte(`{"from":"file"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ios := Test()
+	ios.In = strings.NewReader(`[1,2,3]`)
+
+	tests := []struct {
+		assignment string
+		wantKey    string
+		wantValue  string
+		wantErr    bool
+	}{
+		{assignment: `info={"a":1}`, wantKey: "info", wantValue: `{"a":1}`},
+		{assignment: `count=42`, wantKey: "count", wantValue: `42`},
+		{assignment: "info=@" + file, wantKey: "info", wantValue: `{"from":"file"}`},
+		{assignment: "info=@-", wantKey: "info", wantValue: `[1,2,3]`},
+		{assignment: `info={not json}`, wantErr: true},
+		{assignment: `info`, wantErr: true},
+		{assignment: `={"a":1}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.assignment, func(t *testing.T) {
+			key, value, err := ios.ReadJSONAssignment(tt.assignment)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadJSONAssignment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if key != tt.wantKey || string(value) != tt.wantValue {
+				t.Errorf("ReadJSONAssignment() = %q, %s; want %q, %s", key, value, tt.wantKey, tt.wantValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnifiedDiff returns the changes turning lines a into lines b as unified
+// diff hunks, each with up to context unchanged lines around the changes.
+// Returns nil if nothing changed.
+func UnifiedDiff(a, b []string, context int) []string {
+	return unifiedDiff(diffLines(a, b), context)
+}
+
+// StyleDiffLine colors a unified diff line by its prefix: headers bold, hunk
+// markers cyan, additions green, and removals red.
+func StyleDiffLine(line string) string {
+	switch {
+	case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		return Bold.Render(line)
+	case strings.HasPrefix(line, "@@"):
+		return Cyan.Render(line)
+	case strings.HasPrefix(line, "+"):
+		return Success.Render(line)
+	case strings.HasPrefix(line, "-"):
+		return Error.Render(line)
+	}
+	return line
+}
+
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffDelete
+	diffInsert
+)
+
+type diffLine struct {
+	op   diffOp
+	text string
+}
+
+// maxLCSCells bounds the memory used to align the changed region of two
+// inputs. Beyond it the region is shown as removed and re-added.
+const maxLCSCells = 4_000_000
+
+// diffLines returns an edit script turning a into b, based on the longest
+// common subsequence of lines.
+func diffLines(a, b []string) []diffLine {
+	// Most edits touch a small part of a document, so strip the common prefix
+	// and suffix before aligning the rest.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	out := make([]diffLine, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		out = append(out, diffLine{diffEqual, line})
+	}
+	out = append(out, lcsDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		out = append(out, diffLine{diffEqual, line})
+	}
+	return out
+}
+
+func lcsDiff(a, b []string) []diffLine {
+	n, m := len(a), len(b)
+	var out []diffLine
+
+	if n*m > maxLCSCells {
+		for _, line := range a {
+			out = append(out, diffLine{diffDelete, line})
+		}
+		for _, line := range b {
+			out = append(out, diffLine{diffInsert, line})
+		}
+		return out
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:].
+	lcs := make([][]int32, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, diffLine{diffEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, diffLine{diffDelete, a[i]})
+			i++
+		default:
+			out = append(out, diffLine{diffInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		out = append(out, diffLine{diffDelete, a[i]})
+	}
+	for ; j < m; j++ {
+		out = append(out, diffLine{diffInsert, b[j]})
+	}
+	return out
+}
+
+// unifiedDiff formats an edit script as unified diff hunks, each with up to
+// context unchanged lines around the changes. Returns nil if nothing changed.
+func unifiedDiff(lines []diffLine, context int) []string {
+	// oldNo[k] and newNo[k] count the old and new lines before lines[k].
+	oldNo := make([]int, len(lines)+1)
+	newNo := make([]int, len(lines)+1)
+	var changes []int
+	for k, l := range lines {
+		oldNo[k+1], newNo[k+1] = oldNo[k], newNo[k]
+		if l.op != diffInsert {
+			oldNo[k+1]++
+		}
+		if l.op != diffDelete {
+			newNo[k+1]++
+		}
+		if l.op != diffEqual {
+			changes = append(changes, k)
+		}
+	}
+
+	var out []string
+	for c := 0; c < len(changes); {
+		// Extend the hunk while the next change is close enough that the
+		// context around both would overlap.
+		last := c
+		for last+1 < len(changes) && changes[last+1]-changes[last] <= 2*context+1 {
+			last++
+		}
+		start := max(0, changes[c]-context)
+		end := min(len(lines), changes[last]+context+1)
+
+		oldStart, oldCount := oldNo[start], oldNo[end]-oldNo[start]
+		newStart, newCount := newNo[start], newNo[end]-newNo[start]
+		if oldCount > 0 {
+			oldStart++
+		}
+		if newCount > 0 {
+			newStart++
+		}
+		out = append(out, fmt.Sprintf("@@ -%d,%d +%d,%d @@", oldStart, oldCount, newStart, newCount))
+
+		for _, l := range lines[start:end] {
+			switch l.op {
+			case diffDelete:
+				out = append(out, "-"+l.text)
+			case diffInsert:
+				out = append(out, "+"+l.text)
+			default:
+				out = append(out, " "+l.text)
+			}
+		}
+		c = last + 1
+	}
+	return out
+}
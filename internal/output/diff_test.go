@@ -0,0 +1,64 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	a := []string{"intro", "old step", "shared", "end"}
+	b := []string{"intro", "shared", "new step", "end"}
+
+	var got []string
+	for _, l := range diffLines(a, b) {
+		prefix := map[diffOp]string{diffEqual: " ", diffDelete: "-", diffInsert: "+"}[l.op]
+		got = append(got, prefix+l.text)
+	}
+
+	want := []string{" intro", "-old step", " shared", "+new step", " end"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diffLines() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	var a []string
+	for i := 1; i <= 20; i++ {
+		a = append(a, "line "+string(rune('a'+i-1)))
+	}
+	b := append([]string(nil), a...)
+	b[1] = "changed near top"
+	b[17] = "changed near bottom"
+
+	got := unifiedDiff(diffLines(a, b), 2)
+	want := []string{
+		"@@ -1,4 +1,4 @@",
+		" line a",
+		"-line b",
+		"+changed near top",
+		" line c",
+		" line d",
+		"@@ -16,5 +16,5 @@",
+		" line p",
+		" line q",
+		"-line r",
+		"+changed near bottom",
+		" line s",
+		" line t",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unifiedDiff() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if got := unifiedDiff(diffLines(a, a), 3); got != nil {
+		t.Errorf("unifiedDiff() of identical input = %v, want nil", got)
+	}
+}
+
+func TestUnifiedDiffEmptySides(t *testing.T) {
+	got := unifiedDiff(diffLines(nil, []string{"new"}), 3)
+	want := []string{"@@ -0,0 +1,1 @@", "+new"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unifiedDiff() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -0,0 +1,43 @@
+package output
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Annotations read by the root command when it applies the default output
+// format (ATL_OUTPUT or default_output_format).
+const (
+	// FormatFlagAnnotation marks an --output flag that selects the output
+	// format, as opposed to e.g. an output file or directory.
+	FormatFlagAnnotation = "atl_output_format"
+
+	// ConfirmsAnnotation marks a command whose --json also skips a
+	// confirmation prompt. The default output format is not applied to
+	// such commands, so a setting never removes a prompt.
+	ConfirmsAnnotation = "atl_confirms"
+)
+
+// MarkFormatFlag marks the named flag as an output format flag.
+func MarkFormatFlag(flags *pflag.FlagSet, name string) {
+	_ = flags.SetAnnotation(name, FormatFlagAnnotation, []string{"true"})
+}
+
+// IsFormatFlag reports whether f is marked as an output format flag.
+func IsFormatFlag(f *pflag.Flag) bool {
+	return f != nil && len(f.Annotations[FormatFlagAnnotation]) > 0
+}
+
+// MarkConfirms marks cmd as prompting for confirmation unless --json or
+// --force is given.
+func MarkConfirms(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = make(map[string]string)
+	}
+	cmd.Annotations[ConfirmsAnnotation] = "true"
+}
+
+// Confirms reports whether cmd is marked with MarkConfirms.
+func Confirms(cmd *cobra.Command) bool {
+	return cmd.Annotations[ConfirmsAnnotation] == "true"
+}
@@ -12,16 +12,45 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
+
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
 )
 
+// DefaultJSONIndent is the number of spaces JSON indents with.
+const DefaultJSONIndent = iostreams.DefaultJSONIndent
+
+// MaxJSONIndent is the largest indentation --indent and json_indent accept.
+const MaxJSONIndent = 8
+
+// CheckJSONIndent returns an error unless spaces is a valid JSON indent.
+func CheckJSONIndent(spaces int) error {
+	if spaces < 0 || spaces > MaxJSONIndent {
+		return fmt.Errorf("invalid JSON indent %d: must be between 0 and %d", spaces, MaxJSONIndent)
+	}
+	return nil
+}
+
 // JSON writes data as pretty-printed JSON to the writer with 2-space indentation.
-// This is the standard output format when --json flag is used.
 func JSON(w io.Writer, data interface{}) error {
+	return JSONIndent(w, data, DefaultJSONIndent)
+}
+
+// JSONIndent writes data as JSON indented with the given number of spaces;
+// 0 writes compact, single-line JSON.
+func JSONIndent(w io.Writer, data interface{}, spaces int) error {
 	encoder := json.NewEncoder(w)
-	encoder.SetIndent("", "  ")
+	encoder.SetIndent("", strings.Repeat(" ", spaces))
 	return encoder.Encode(data)
 }
 
+// PrintJSON writes data as JSON to ios.Out, indented as --indent, --compact
+// or the json_indent config key asked for.
+// This is the standard output format when --json flag is used.
+func PrintJSON(ios *iostreams.IOStreams, data interface{}) error {
+	return JSONIndent(ios.Out, data, ios.JSONIndent())
+}
+
 // JSONCompact writes data as compact JSON (no indentation) to the writer.
 // Useful when output size matters more than human readability.
 func JSONCompact(w io.Writer, data interface{}) error {
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+
+	"github.com/enthus-appdev/atl-cli/internal/iostreams"
 )
 
 // TestJSON tests the JSON function outputs properly formatted JSON.
@@ -108,6 +110,58 @@ func TestJSONCompact(t *testing.T) {
 	}
 }
 
+// TestPrintJSONIndent compares the output of the same struct with the
+// default, a custom, and no indentation set on the IOStreams.
+func TestPrintJSONIndent(t *testing.T) {
+	data := struct {
+		Name string   `json:"name"`
+		Tags []string `json:"tags"`
+	}{Name: "Alice", Tags: []string{"a"}}
+
+	tests := []struct {
+		spaces int
+		want   string
+	}{
+		{spaces: 2, want: "{\n  \"name\": \"Alice\",\n  \"tags\": [\n    \"a\"\n  ]\n}\n"},
+		{spaces: 4, want: "{\n    \"name\": \"Alice\",\n    \"tags\": [\n        \"a\"\n    ]\n}\n"},
+		{spaces: 0, want: "{\"name\":\"Alice\",\"tags\":[\"a\"]}\n"},
+	}
+
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		ios := iostreams.Test()
+		ios.Out = buf
+		ios.SetJSONIndent(tt.spaces)
+		if err := PrintJSON(ios, data); err != nil {
+			t.Fatalf("PrintJSON() error = %v", err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("PrintJSON() with indent %d = %q, want %q", tt.spaces, buf.String(), tt.want)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	ios := iostreams.Test()
+	ios.Out = buf
+	if err := PrintJSON(ios, data); err != nil || buf.String() != tests[0].want {
+		t.Errorf("PrintJSON() without an indent = %q, %v, want %q", buf.String(), err, tests[0].want)
+	}
+}
+
+// TestCheckJSONIndent tests that out of range indents are rejected.
+func TestCheckJSONIndent(t *testing.T) {
+	for _, spaces := range []int{0, DefaultJSONIndent, MaxJSONIndent} {
+		if err := CheckJSONIndent(spaces); err != nil {
+			t.Errorf("CheckJSONIndent(%d) error = %v", spaces, err)
+		}
+	}
+	for _, spaces := range []int{-1, MaxJSONIndent + 1} {
+		if err := CheckJSONIndent(spaces); err == nil {
+			t.Errorf("CheckJSONIndent(%d) should return an error", spaces)
+		}
+	}
+}
+
 // TestJSONString tests the JSONString function.
 func TestJSONString(t *testing.T) {
 	data := struct {
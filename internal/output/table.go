@@ -4,6 +4,8 @@ import (
 	"io"
 	"strings"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/olekukonko/tablewriter"
 	"github.com/olekukonko/tablewriter/tw"
 )
@@ -79,3 +81,106 @@ func SimpleTable(w io.Writer, headers []string, rows [][]string) {
 	}
 	t.Render()
 }
+
+const (
+	// columnGap is the space Render leaves between columns.
+	columnGap = 2
+	// minFlexWidth is the narrowest the flexible column is shrunk to.
+	minFlexWidth = 10
+	// minColumnWidth is the narrowest any other column is shrunk to.
+	minColumnWidth = 4
+)
+
+// Truncate shortens s to at most n terminal columns, ending in "..." when
+// cut. ANSI escape sequences such as colors take no space and are kept
+// intact.
+func Truncate(s string, n int) string {
+	if ansi.StringWidth(s) <= n {
+		return s
+	}
+	if n <= 3 {
+		return ansi.Truncate(s, n, "")
+	}
+	return ansi.Truncate(s, n, "...")
+}
+
+// FormatDate trims an ISO 8601 timestamp to its date for table cells.
+func FormatDate(ts string) string {
+	if len(ts) >= 10 {
+		return ts[:10]
+	}
+	return ts
+}
+
+// FitColumns truncates cells so the table fits in width terminal columns.
+// See ColumnWidths for how space is allocated. Rows are modified in place
+// and returned; a width <= 0 leaves them unchanged.
+func FitColumns(headers []string, rows [][]string, width, flex int) [][]string {
+	if width <= 0 {
+		return rows
+	}
+	widths := ColumnWidths(headers, rows, width, flex)
+	for _, row := range rows {
+		for i, cell := range row {
+			if i < len(widths) && lipgloss.Width(cell) > widths[i] {
+				row[i] = Truncate(cell, widths[i])
+			}
+		}
+	}
+	return rows
+}
+
+// ColumnWidths allocates column widths for a table rendered in width
+// terminal columns. Every column starts at its natural width. If the table
+// is too wide, the flex column (typically a summary or title) gives up space
+// first, down to minFlexWidth; only then are the widest other columns
+// narrowed, never below their header.
+func ColumnWidths(headers []string, rows [][]string, width, flex int) []int {
+	widths := make([]int, len(headers))
+	floors := make([]int, len(headers))
+	for i, h := range headers {
+		widths[i] = lipgloss.Width(h)
+		floors[i] = max(widths[i], minColumnWidth)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if i < len(widths) {
+				widths[i] = max(widths[i], lipgloss.Width(cell))
+			}
+		}
+	}
+	if flex >= 0 && flex < len(floors) {
+		floors[flex] = minFlexWidth
+	}
+
+	total := columnGap * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+	over := total - width
+	if over <= 0 {
+		return widths
+	}
+
+	if flex >= 0 && flex < len(widths) && widths[flex] > floors[flex] {
+		shrink := min(over, widths[flex]-floors[flex])
+		widths[flex] -= shrink
+		over -= shrink
+	}
+
+	for over > 0 {
+		widest := -1
+		for i, w := range widths {
+			if i != flex && w > floors[i] && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break // Nothing left to shrink; the table will wrap.
+		}
+		widths[widest]--
+		over--
+	}
+
+	return widths
+}
@@ -0,0 +1,86 @@
+package output
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestColumnWidths(t *testing.T) {
+	headers := []string{"KEY", "STATUS", "SUMMARY"}
+	rows := [][]string{
+		{"PROJ-1", "In Progress", "A fairly long summary that needs forty chars"},
+		{"PROJ-12", "Done", "Short"},
+	}
+	// Natural widths: 7, 11, 44 plus two gaps of 2 = 66.
+
+	tests := []struct {
+		name  string
+		width int
+		want  []int
+	}{
+		{"fits", 80, []int{7, 11, 44}},
+		{"exact fit", 66, []int{7, 11, 44}},
+		{"summary absorbs the shortfall", 50, []int{7, 11, 28}},
+		{"summary stops at its minimum, then the widest column shrinks", 27, []int{6, 7, 10}},
+		{"columns never shrink below their header", 10, []int{4, 6, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ColumnWidths(headers, rows, tt.width, 2); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ColumnWidths(width=%d) = %v, want %v", tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFitColumns(t *testing.T) {
+	headers := []string{"KEY", "SUMMARY"}
+	rows := [][]string{{"PROJ-1", "Summary that is too long for the terminal"}}
+
+	got := FitColumns(headers, rows, 30, 1)
+	want := [][]string{{"PROJ-1", "Summary that is too..."}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FitColumns() = %q, want %q", got, want)
+	}
+
+	unchanged := [][]string{{"PROJ-1", "Summary that is too long for the terminal"}}
+	if got := FitColumns(headers, unchanged, 0, 1); got[0][1] != unchanged[0][1] {
+		t.Errorf("FitColumns(width=0) should not truncate, got %q", got[0][1])
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s    string
+		n    int
+		want string
+	}{
+		{"short", 10, "short"},
+		{"exactly10!", 10, "exactly10!"},
+		{"this is too long", 10, "this is..."},
+		{"äöüäöüäöüäöü", 6, "äöü..."},
+		{"abc", 2, "ab"},
+		{"\x1b[31mred\x1b[0m", 3, "\x1b[31mred\x1b[0m"},
+		{"\x1b[31mthis is too long\x1b[0m", 10, "\x1b[31mthis is...\x1b[0m"},
+	}
+	for _, tt := range tests {
+		if got := Truncate(tt.s, tt.n); got != tt.want {
+			t.Errorf("Truncate(%q, %d) = %q, want %q", tt.s, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	tests := map[string]string{
+		"2026-03-14T09:26:53.589Z": "2026-03-14",
+		"2026-03-14":               "2026-03-14",
+		"":                         "",
+		"soon":                     "soon",
+	}
+	for ts, want := range tests {
+		if got := FormatDate(ts); got != want {
+			t.Errorf("FormatDate(%q) = %q, want %q", ts, got, want)
+		}
+	}
+}